@@ -0,0 +1,155 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// CompositePolicy is implemented by locales that join the parts of a
+// composite number with a separator other than a space, e.g. nothing in
+// Japanese "1億2345万6789".
+type CompositePolicy interface {
+	// CompositeSeparator returns the text between two parts.
+	CompositeSeparator() string
+}
+
+// compositeSeparator returns the text between the parts of a composite
+// number in loc.
+func compositeSeparator(loc Locale) string {
+	if cp, ok := loc.(CompositePolicy); ok {
+		return cp.CompositeSeparator()
+	}
+	return " "
+}
+
+// compositePart is the count of one scale of a composite number. The part
+// of exponent 0 holds the units below the smallest scale.
+type compositePart struct {
+	exp   int
+	count decimal.Decimal
+}
+
+// compositeNumber renders the non-negative value v as the sum of counts of
+// the scales of df, e.g. "1億2345万6789" or "1 million 234 thousand 567",
+// and returns the number it shows. With opts.MaxUnits the smaller parts
+// are dropped and the last kept part is rounded. The boolean is false
+// unless opts.Composite is set and v reaches a scale.
+func compositeNumber(loc Locale, p *message.Printer, df map[string]string, v decimal.Decimal, opts Options) (string, decimal.Decimal, bool) {
+	if !opts.Composite {
+		return "", v, false
+	}
+	exps := compositeScales(df)
+	parts := decompose(v, exps, 0)
+	if len(parts) == 0 || parts[0].exp == 0 {
+		return "", v, false
+	}
+
+	// Round the value in units of the last kept part, so that carries
+	// reach the larger parts, e.g. 1億9999.6万 is 2億 with one unit.
+	last := 0
+	if opts.MaxUnits > 0 && len(parts) > opts.MaxUnits {
+		last = parts[opts.MaxUnits-1].exp
+		if opts.Precision.IsExact() {
+			opts.Precision = FractionDigits(0, 0)
+		}
+	}
+	unit := powerOfTen(last)
+	q, err := v.Quo(unit)
+	if err != nil {
+		return "", v, false
+	}
+	shown, err := opts.round(q).Mul(unit)
+	if err != nil {
+		return "", v, false
+	}
+	parts = decompose(shown, exps, last)
+
+	texts := make([]string, 0, len(parts))
+	for i, part := range parts {
+		// Only the last part may show fraction digits.
+		prec := Precision{}
+		if i == len(parts)-1 {
+			prec = opts.Precision
+		}
+		if part.exp == 0 {
+			texts = append(texts, formatNumber(p, part.count, prec, number.NoSeparator()))
+			continue
+		}
+		key, _ := pow10(part.exp)
+		c := compactNumber{ratio: part.count, scale: key, ungrouped: true}
+		c.tmpl = pluralPattern(df, key, loc.PluralForm(c.ratio, c.ratio.String()))
+		texts = append(texts, c.format(p, prec))
+	}
+	return strings.Join(texts, compositeSeparator(loc)), shown.Trim(0), true
+}
+
+// compositeScales returns the exponents of the scales of df used by
+// composite numbers, from the largest. They are the scales with a
+// single-digit pattern, e.g. "0万", that are multiples of the most common
+// distance between two such scales, so Korean uses 만 and 억 but not 천.
+func compositeScales(df map[string]string) []int {
+	var all []int
+	for exp := 18; exp >= 1; exp-- {
+		key, _ := pow10(exp)
+		other := df[fmt.Sprintf("%d-count-other", key)]
+		if extractName(other) != "" && strings.Count(other, "0") == 1 {
+			all = append(all, exp)
+		}
+	}
+
+	gaps := make(map[int]int)
+	step := 1
+	for i := 1; i < len(all); i++ {
+		gap := all[i-1] - all[i]
+		gaps[gap]++
+		if gaps[gap] > gaps[step] || (gaps[gap] == gaps[step] && gap < step) {
+			step = gap
+		}
+	}
+
+	var exps []int
+	for _, exp := range all {
+		if exp%step == 0 {
+			exps = append(exps, exp)
+		}
+	}
+	return exps
+}
+
+// decompose splits the non-negative v into counts of the scales exps and of
+// units, from the largest. Counts are integers except the one of the
+// exponent last, which keeps the remaining fraction of v. Zero counts are
+// omitted.
+func decompose(v decimal.Decimal, exps []int, last int) []compositePart {
+	var parts []compositePart
+	rest := v
+	for _, exp := range append(exps[:len(exps):len(exps)], 0) {
+		if exp < last {
+			break
+		}
+		unit := powerOfTen(exp)
+		count, err := rest.Quo(unit)
+		if err != nil {
+			break
+		}
+		if exp > last {
+			count = count.Floor(0)
+		}
+		if count.IsZero() {
+			continue
+		}
+		used, err := count.Mul(unit)
+		if err != nil {
+			break
+		}
+		if rest, err = rest.Sub(used); err != nil {
+			break
+		}
+		parts = append(parts, compositePart{exp: exp, count: count.Trim(0)})
+	}
+	return parts
+}
@@ -0,0 +1,389 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// unitConversion converts a unit to the base unit of its category
+// following the CLDR unitConstants: base = (value + offset) * num / den.
+type unitConversion struct {
+	category string
+	num      decimal.Decimal
+	den      decimal.Decimal
+	offset   decimal.Decimal
+}
+
+// conversion returns a unitConversion with the given factor to the base
+// unit of category.
+func conversion(category, factor string) unitConversion {
+	return unitConversion{
+		category: category,
+		num:      decimal.MustParse(factor),
+		den:      decimal.One,
+		offset:   decimal.Zero,
+	}
+}
+
+// unitConversions holds the conversion factors of the convertible units,
+// taken from CLDR units.xml. The base units are meter, kilogram, liter,
+// kelvin, meter-per-second, square-meter and second.
+var unitConversions = map[string]unitConversion{
+	"kilometer":         conversion("length", "1000"),
+	"meter":             conversion("length", "1"),
+	"centimeter":        conversion("length", "0.01"),
+	"millimeter":        conversion("length", "0.001"),
+	"mile-scandinavian": conversion("length", "10000"),
+	"mile":              conversion("length", "1609.344"),
+	"yard":              conversion("length", "0.9144"),
+	"foot":              conversion("length", "0.3048"),
+	"inch":              conversion("length", "0.0254"),
+
+	"kilogram": conversion("mass", "1"),
+	"gram":     conversion("mass", "0.001"),
+	"stone":    conversion("mass", "6.35029318"),
+	"pound":    conversion("mass", "0.45359237"),
+	"ounce":    conversion("mass", "0.028349523125"),
+
+	"liter":       conversion("volume", "1"),
+	"milliliter":  conversion("volume", "0.001"),
+	"gallon":      conversion("volume", "3.785411784"),
+	"fluid-ounce": conversion("volume", "0.0295735295625"),
+
+	"celsius": {
+		category: "temperature",
+		num:      decimal.One,
+		den:      decimal.One,
+		offset:   decimal.MustParse("273.15"),
+	},
+	"fahrenheit": {
+		category: "temperature",
+		num:      decimal.MustParse("5"),
+		den:      decimal.MustParse("9"),
+		offset:   decimal.MustParse("459.67"),
+	},
+
+	"meter-per-second": conversion("speed", "1"),
+	"mile-per-hour":    conversion("speed", "0.44704"),
+	"kilometer-per-hour": {
+		category: "speed",
+		num:      decimal.MustParse("5"),
+		den:      decimal.MustParse("18"),
+		offset:   decimal.Zero,
+	},
+
+	"square-kilometer":  conversion("area", "1000000"),
+	"hectare":           conversion("area", "10000"),
+	"square-meter":      conversion("area", "1"),
+	"square-centimeter": conversion("area", "0.0001"),
+	"square-mile":       conversion("area", "2589988.110336"),
+	"acre":              conversion("area", "4046.8564224"),
+	"square-foot":       conversion("area", "0.09290304"),
+
+	"year":        conversion("duration", fmt.Sprint(int64(meanYear/time.Second))),
+	"month":       conversion("duration", fmt.Sprint(int64(meanYear/12/time.Second))),
+	"week":        conversion("duration", "604800"),
+	"day":         conversion("duration", "86400"),
+	"hour":        conversion("duration", "3600"),
+	"minute":      conversion("duration", "60"),
+	"second":      conversion("duration", "1"),
+	"millisecond": conversion("duration", "0.001"),
+	"microsecond": conversion("duration", "0.000001"),
+	"nanosecond":  conversion("duration", "0.000000001"),
+}
+
+// unitPreference is one entry of the CLDR unitPreferenceData: the unit is
+// used when the converted value is at least geq. Mixed units are written as
+// "foot-and-inch".
+type unitPreference struct {
+	unit string
+	geq  string
+}
+
+// unitPreferences holds a subset of the CLDR unitPreferenceData keyed by
+// category and usage, then by region. Region "001" is the default.
+var unitPreferences = map[string]map[string][]unitPreference{
+	"length-default": {
+		"001": {{"kilometer", "1"}, {"meter", "1"}, {"centimeter", ""}},
+		"US":  {{"mile", "1"}, {"foot", "1"}, {"inch", ""}},
+		"GB":  {{"mile", "1"}, {"foot", "1"}, {"inch", ""}},
+	},
+	"length-road": {
+		"001": {{"kilometer", "0.9"}, {"meter", ""}},
+		"US":  {{"mile", "0.5"}, {"foot", ""}},
+		"GB":  {{"mile", "0.5"}, {"yard", ""}},
+	},
+	"length-person-height": {
+		"001": {{"centimeter", ""}},
+		"US":  {{"foot-and-inch", ""}},
+		"GB":  {{"foot-and-inch", ""}},
+	},
+	"length-rainfall": {
+		"001": {{"millimeter", ""}},
+		"US":  {{"inch", ""}},
+	},
+	"mass-default": {
+		"001": {{"kilogram", "1"}, {"gram", ""}},
+		"US":  {{"pound", "1"}, {"ounce", ""}},
+		"GB":  {{"pound", "1"}, {"ounce", ""}},
+	},
+	"mass-person": {
+		"001": {{"kilogram", ""}},
+		"US":  {{"pound", ""}},
+		"GB":  {{"stone-and-pound", ""}},
+	},
+	"volume-fluid": {
+		"001": {{"liter", "1"}, {"milliliter", ""}},
+		"US":  {{"gallon", "1"}, {"fluid-ounce", ""}},
+	},
+	"temperature-default": {
+		"001": {{"celsius", ""}},
+		"US":  {{"fahrenheit", ""}},
+		"BS":  {{"fahrenheit", ""}},
+		"BZ":  {{"fahrenheit", ""}},
+		"KY":  {{"fahrenheit", ""}},
+		"PR":  {{"fahrenheit", ""}},
+		"PW":  {{"fahrenheit", ""}},
+	},
+	"speed-default": {
+		"001": {{"kilometer-per-hour", ""}},
+		"US":  {{"mile-per-hour", ""}},
+		"GB":  {{"mile-per-hour", ""}},
+	},
+	"area-default": {
+		"001": {{"hectare", ""}},
+		"US":  {{"acre", ""}},
+		"GB":  {{"acre", ""}},
+	},
+}
+
+// measurementSystemRegions maps the values of the "ms" Unicode extension
+// to the region whose preferences represent the measurement system.
+var measurementSystemRegions = map[string]string{
+	"metric":   "001",
+	"ussystem": "US",
+	"uksystem": "GB",
+}
+
+// ConvertUnit converts value from one CLDR unit to another of the same
+// category, e.g. from "kilometer" to "mile".
+func ConvertUnit(value decimal.Decimal, from, to string) (decimal.Decimal, error) {
+	src, ok := unitConversions[from]
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("unit %q is not convertible", from)
+	}
+	dst, ok := unitConversions[to]
+	if !ok {
+		return decimal.Decimal{}, fmt.Errorf("unit %q is not convertible", to)
+	}
+	if src.category != dst.category {
+		return decimal.Decimal{}, fmt.Errorf("cannot convert %s to %s", src.category, dst.category)
+	}
+	if from == to {
+		return value, nil
+	}
+
+	// value * src.num * dst.den / (src.den * dst.num) with the offsets
+	// applied on the way to and from the base unit.
+	v, err := value.Add(src.offset)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	num, err := src.num.Mul(dst.den)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	den, err := src.den.Mul(dst.num)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if v, err = v.Mul(num); err != nil {
+		return decimal.Decimal{}, err
+	}
+	if v, err = v.Quo(den); err != nil {
+		return decimal.Decimal{}, err
+	}
+	return v.Sub(dst.offset)
+}
+
+// preferredUnit returns the unit preferred for value (given in unit) by the
+// region or measurement system of tag, according to usage, e.g. "mile" for
+// 1200 kilometers in "en-US". Units without preferences are kept.
+func preferredUnit(value decimal.Decimal, unit, usage string, tag language.Tag) (string, error) {
+	conv, ok := unitConversions[unit]
+	if !ok {
+		return unit, nil
+	}
+	if usage == "" {
+		usage = "default"
+	}
+	byRegion, ok := unitPreferences[conv.category+"-"+usage]
+	if !ok {
+		byRegion = unitPreferences[conv.category+"-default"]
+	}
+	if byRegion == nil {
+		return unit, nil
+	}
+
+	prefs, ok := byRegion[measurementRegion(tag)]
+	if !ok {
+		prefs = byRegion["001"]
+	}
+
+	for _, pref := range prefs {
+		if pref.geq == "" {
+			return pref.unit, nil
+		}
+		first, _, _ := strings.Cut(pref.unit, "-and-")
+		converted, err := ConvertUnit(value.Abs(), unit, first)
+		if err != nil {
+			return "", err
+		}
+		if converted.Cmp(decimal.MustParse(pref.geq)) >= 0 {
+			return pref.unit, nil
+		}
+	}
+	return prefs[len(prefs)-1].unit, nil
+}
+
+// measurementRegion returns the region whose unit preferences apply to tag.
+// The "ms" extension, e.g. "en-US-u-ms-metric", overrides the region.
+func measurementRegion(tag language.Tag) string {
+	if region, ok := measurementSystemRegions[tag.TypeForKey("ms")]; ok {
+		return region
+	}
+	region, _ := tag.Region()
+	return region.String()
+}
+
+// FormatMeasure formats value, given in unit, in the unit preferred by the
+// locale for the given usage, e.g. "745.6 mi" for 1200 kilometers in "en-US"
+// and "1.2K km" in "en-GB-u-ms-metric". The usage is a CLDR unit usage such
+// as "default", "road", "person-height" or "person"; an empty usage selects
+// the default preferences. The region of locale selects the preferences
+// and the "ms" extension ("metric", "ussystem", "uksystem") overrides it.
+//
+// The value is converted before it is compacted. Preferences such as
+// "foot-and-inch" produce mixed units, e.g. "5 ft, 3 in", whose last part
+// is rounded as requested by opts. When opts has no precision, converted
+// values are rounded to one fraction digit and mixed units to integers.
+func (h *Humanizer) FormatMeasure(value string, unit string, usage string, locale language.Tag, opts Options) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	target, err := preferredUnit(valDec, unit, usage, locale)
+	if err != nil {
+		return "", err
+	}
+	mixed := strings.Contains(target, "-and-")
+	if target != unit && opts.Precision.IsExact() {
+		opts.Precision = FractionDigits(0, 1)
+		if mixed {
+			opts.Precision = FractionDigits(0, 0)
+		}
+	}
+
+	p := message.NewPrinter(locale)
+	if mixed {
+		units := strings.Split(target, "-and-")
+		parts, err := mixedParts(units)
+		if err != nil {
+			return "", err
+		}
+		rest, err := ConvertUnit(valDec, unit, units[len(units)-1])
+		if err != nil {
+			return "", err
+		}
+		return h.formatMixedUnit(loc, p, valDec.Sign(), rest, parts, locale, opts, false)
+	}
+
+	converted := valDec
+	if target != unit {
+		if converted, err = ConvertUnit(valDec, unit, target); err != nil {
+			return "", err
+		}
+	}
+	return h.formatUnit(loc, p, converted, target, locale, opts)
+}
+
+// mixedPart is one unit of a mixed unit together with its size expressed
+// in the smallest unit of the mixed unit, e.g. 12 for the foot of
+// foot-and-inch.
+type mixedPart struct {
+	unit string
+	size decimal.Decimal
+}
+
+// mixedParts returns the parts of a mixed unit such as foot-and-inch.
+func mixedParts(units []string) ([]mixedPart, error) {
+	smallest := units[len(units)-1]
+	parts := make([]mixedPart, len(units))
+	for i, unit := range units {
+		size, err := ConvertUnit(decimal.One, unit, smallest)
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = mixedPart{unit: unit, size: size}
+	}
+	return parts, nil
+}
+
+// formatMixedUnit formats the amount rest, given in the smallest of the
+// parts, as a mixed unit such as "5 ft, 3 in". The amount is rounded in
+// the smallest unit, so rounding up carries into the larger ones. Leading
+// zero parts are omitted; with omitZero the other zero parts are omitted
+// as well, keeping at least one part.
+func (h *Humanizer) formatMixedUnit(loc Locale, p *message.Printer, sign int, rest decimal.Decimal, parts []mixedPart, locale language.Tag, opts Options, omitZero bool) (string, error) {
+	rest = opts.round(rest.Abs())
+
+	units := loc.Data().Units
+	items := make([]string, 0, len(parts))
+	for i, part := range parts {
+		up, ok := units[part.unit]
+		if !ok {
+			return "", UnknownUnitError{Unit: part.unit, Locale: locale}
+		}
+
+		last := i == len(parts)-1
+		amount, prec := rest, opts.Precision
+		if !last {
+			prec = FractionDigits(0, 0)
+			q, err := rest.Quo(part.size)
+			if err != nil {
+				return "", err
+			}
+			amount = q.Floor(0)
+			taken, err := amount.Mul(part.size)
+			if err != nil {
+				return "", err
+			}
+			if rest, err = rest.Sub(taken); err != nil {
+				return "", err
+			}
+		}
+		if amount.IsZero() && (len(items) == 0 || omitZero) && !(last && len(items) == 0) {
+			continue
+		}
+
+		num := formatNumber(p, amount, prec)
+		if len(items) == 0 {
+			num = applySign(num, sign, loc, opts.SignDisplay)
+		}
+		form := loc.PluralForm(amount, amount.String())
+		items = append(items, unitPattern(up.patterns(h.opt), form, num))
+	}
+
+	return loc.Data().UnitList.pattern(h.opt).join(items), nil
+}
@@ -0,0 +1,116 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Noun holds the forms of a counted noun keyed by plural form, e.g.
+// "one": "follower", "other": "followers". A form may contain the "{0}"
+// placeholder to position the number, e.g. "{0}回"; otherwise the number
+// and the noun are separated by a space.
+type Noun map[string]string
+
+// NounCatalog holds nouns keyed by catalog key and locale, e.g.
+// catalog["views"][language.Russian].
+type NounCatalog map[string]map[language.Tag]Noun
+
+// UnknownNounError is returned when a catalog has no noun for the requested
+// key and locale.
+type UnknownNounError struct {
+	Key    string
+	Locale language.Tag
+}
+
+// Error implements the error interface.
+func (e UnknownNounError) Error() string {
+	return fmt.Sprintf("noun %q not found for locale %q", e.Key, e.Locale)
+}
+
+// Noun returns the noun registered for key in the given locale or its
+// closest registered parent.
+func (c NounCatalog) Noun(key string, locale language.Tag) (Noun, bool) {
+	nouns, ok := c[key]
+	if !ok {
+		return nil, false
+	}
+	for t := locale; ; t = t.Parent() {
+		if noun, ok := nouns[t]; ok {
+			return noun, true
+		}
+		if t.IsRoot() {
+			return nil, false
+		}
+	}
+}
+
+// FormatCount formats value as a compact number followed by the form of
+// noun agreeing with it, e.g. "1.2K followers" or "1,2 тыс. просмотров".
+// The number is compacted with the decimal patterns of the configured
+// Option and the noun form is selected with the same operands as the unit
+// patterns of FormatUnit: the displayed number including its compact
+// scale, so "1K" counts as 1000 ("1K followers", "21 тыс. просмотров").
+// Missing forms fall back to "other".
+func (h *Humanizer) FormatCount(value string, noun Noun, locale language.Tag, opts Options) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	num, pluralForm := h.displayNumber(loc, message.NewPrinter(locale), valDec, "", opts)
+
+	return nounPattern(noun, pluralForm, num), nil
+}
+
+// FormatCountPer formats value as a rate of noun per the CLDR unit per,
+// e.g. "3M req/min" or "3 млн запросов в секунду". The count is formatted
+// like FormatCount and placed in the per pattern of the unit for the
+// configured Option, such as "{0}/min" or "{0} в секунду".
+func (h *Humanizer) FormatCountPer(value string, noun Noun, per string, locale language.Tag, opts Options) (string, error) {
+	count, err := h.FormatCount(value, noun, locale, opts)
+	if err != nil {
+		return "", err
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	out, ok := perUnit(loc.Data().Units, per, h.opt, count)
+	if !ok {
+		return "", UnknownUnitError{Unit: per, Locale: locale}
+	}
+	return out, nil
+}
+
+// nounPattern joins num to the form of noun for the given plural form,
+// falling back to the "other" form.
+func nounPattern(noun Noun, pluralForm, num string) string {
+	form, ok := noun[pluralForm]
+	if !ok {
+		form = noun["other"]
+	}
+	if !strings.Contains(form, "{0}") {
+		form = "{0} " + form
+	}
+	return strings.Replace(form, "{0}", num, 1)
+}
+
+// FormatCountKey is like FormatCount but looks the noun up in catalog by
+// key.
+func (h *Humanizer) FormatCountKey(value string, key string, catalog NounCatalog, locale language.Tag, opts Options) (string, error) {
+	noun, ok := catalog.Noun(key, locale)
+	if !ok {
+		return "", UnknownNounError{Key: key, Locale: locale}
+	}
+	return h.FormatCount(value, noun, locale, opts)
+}
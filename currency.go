@@ -0,0 +1,175 @@
+package humanizecompact
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// CurrencyFormat contains the currency patterns of a locale according to
+// the CLDR specification. The "¤" sign in a pattern marks the position of
+// the currency symbol.
+type CurrencyFormat struct {
+	// Standard is the non-compact pattern, e.g. "¤#,##0.00" or "#,##0.00 ¤".
+	Standard string
+
+	// Accounting is the accounting pattern, e.g. "¤#,##0.00;(¤#,##0.00)".
+	// Its negative subpattern is used by SignAccounting.
+	Accounting string
+
+	// Short holds the compact patterns keyed like DecimalFormat,
+	// e.g. "1000000-count-other": "¤0M".
+	Short map[string]string
+
+	// Symbols maps ISO 4217 codes to the symbol used by the locale,
+	// e.g. "USD": "$". Codes without an entry are shown as is.
+	Symbols map[string]string
+}
+
+// CurrencyDisplay selects how FormatCurrency shows the currency.
+type CurrencyDisplay int
+
+const (
+	// CurrencySymbol uses the locale's symbol, e.g. "US$" or "₽".
+	CurrencySymbol CurrencyDisplay = iota
+
+	// CurrencyNarrowSymbol uses the shortest common symbol, e.g. "$".
+	CurrencyNarrowSymbol
+
+	// CurrencyCode uses the ISO 4217 code, e.g. "USD".
+	CurrencyCode
+)
+
+// narrowCurrencySymbols holds the CLDR root narrow symbols.
+var narrowCurrencySymbols = map[string]string{
+	"AUD": "$", "BRL": "R$", "CAD": "$", "CNY": "¥", "CZK": "Kč",
+	"DKK": "kr", "EGP": "E£", "EUR": "€", "GBP": "£", "HUF": "Ft",
+	"IDR": "Rp", "ILS": "₪", "INR": "₹", "JPY": "¥", "KRW": "₩",
+	"MXN": "$", "PLN": "zł", "RON": "lei", "RUB": "₽", "SEK": "kr",
+	"THB": "฿", "TRY": "₺", "UAH": "₴", "USD": "$", "VND": "₫",
+}
+
+// currencyDigits lists the currencies whose customary number of fraction
+// digits differs from the default of 2 (CLDR supplemental currencyData).
+var currencyDigits = map[string]int{
+	"BHD": 3, "BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "IQD": 0,
+	"IRR": 0, "ISK": 0, "JOD": 3, "JPY": 0, "KMF": 0, "KRW": 0,
+	"KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0, "RWF": 0, "TND": 3,
+	"UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0,
+	"XPF": 0,
+}
+
+// FormatCurrency formats value as an amount of the given ISO 4217 currency,
+// e.g. "$1.2M" or "1,2 млн ₽". The compact form uses the locale's short
+// currency patterns; amounts that cannot be compacted are rendered with the
+// standard currency pattern and the currency's number of fraction digits.
+// Without a precision in opts, compact amounts are rounded with
+// CompactDigits, so 1,234,567 is "$1.2M".
+//
+// CLDR has no long compact currency patterns, so a Humanizer configured
+// with the Long option uses the short patterns as well, as ICU does.
+func (h *Humanizer) FormatCurrency(value string, currency string, locale language.Tag, opts Options) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	currency = strings.ToUpper(currency)
+	cf := loc.Data().CurrencyFormat
+	symbol := currencySymbol(cf, currency, opts.CurrencyDisplay)
+	p := message.NewPrinter(locale)
+
+	absVal := valDec.Abs()
+	compactOpts := opts
+	if compactOpts.Precision.IsExact() {
+		compactOpts.Precision = CompactDigits()
+	}
+	if c, ok := compactDecimal(loc, cf.Short, absVal, compactOpts); ok {
+		out := c.format(p, compactOpts.Precision)
+		return applySign(placeCurrencySymbol(out, symbol), valDec.Sign(), loc, opts.SignDisplay), nil
+	}
+
+	pattern := cf.Standard
+	if pattern == "" {
+		pattern = "¤#,##0.00"
+	}
+
+	digits := fractionDigits(currency)
+	displayed := roundDecimal(absVal, digits, opts.Rounding)
+	num := formatNumber(p, displayed, FractionDigits(digits, digits))
+	out := placeCurrencySymbol(replaceNumberPattern(pattern, num), symbol)
+
+	return applySign(out, valDec.Sign()*displayed.Sign(), loc, opts.SignDisplay), nil
+}
+
+// currencySymbol returns the symbol of currency for the requested display.
+func currencySymbol(cf CurrencyFormat, currency string, display CurrencyDisplay) string {
+	switch display {
+	case CurrencyCode:
+		return currency
+	case CurrencyNarrowSymbol:
+		if s, ok := narrowCurrencySymbols[currency]; ok {
+			return s
+		}
+	}
+	if s, ok := cf.Symbols[currency]; ok {
+		return s
+	}
+	return currency
+}
+
+// fractionDigits returns the customary number of fraction digits
+// of currency.
+func fractionDigits(currency string) int {
+	if d, ok := currencyDigits[currency]; ok {
+		return d
+	}
+	return 2
+}
+
+// replaceNumberPattern replaces the number part of a CLDR pattern such as
+// "#,##0.00" with num, keeping any prefix and suffix.
+func replaceNumberPattern(pattern, num string) string {
+	start := strings.IndexAny(pattern, "#0")
+	if start < 0 {
+		return pattern
+	}
+	end := start
+	for end < len(pattern) && strings.IndexByte("#0,.", pattern[end]) >= 0 {
+		end++
+	}
+	return pattern[:start] + num + pattern[end:]
+}
+
+// placeCurrencySymbol substitutes "¤" in s with symbol. Following the CLDR
+// currency spacing rules, a no-break space separates a symbol ending (or
+// starting) with a letter from an adjacent digit.
+func placeCurrencySymbol(s, symbol string) string {
+	idx := strings.Index(s, "¤")
+	if idx < 0 {
+		return s
+	}
+	before, after := s[:idx], s[idx+len("¤"):]
+
+	if r, _ := utf8.DecodeRuneInString(after); unicode.IsDigit(r) {
+		if last, _ := utf8.DecodeLastRuneInString(symbol); unicode.IsLetter(last) {
+			symbol += " "
+		}
+	}
+	if r, _ := utf8.DecodeLastRuneInString(before); unicode.IsDigit(r) {
+		if first, _ := utf8.DecodeRuneInString(symbol); unicode.IsLetter(first) {
+			symbol = " " + symbol
+		}
+	}
+
+	return before + symbol + after
+}
@@ -0,0 +1,117 @@
+package humanizecompact
+
+import (
+	"time"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// meanYear is the mean Gregorian year of 365.2425 days used by CLDR
+// units.xml, and so by both FormatDuration and ConvertUnit.
+const meanYear = 31556952 * time.Second
+
+// durationUnits lists the units used by FormatDuration from the largest
+// to the smallest.
+var durationUnits = []struct {
+	unit string
+	size time.Duration
+}{
+	{"year", meanYear},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+	{"millisecond", time.Millisecond},
+}
+
+// FormatDuration formats d in the largest unit it reaches, from
+// milliseconds to years, with the locale's CLDR duration unit patterns in
+// the configured Option, e.g. "1.5 hr" or "3 дн.". When opts has
+// no precision the amount is rounded to one fraction digit, or to an
+// integer when several units are shown.
+//
+// With opts.MaxUnits above one, d is split over up to that many consecutive
+// units, e.g. "1 hr, 30 min"; the smallest unit is rounded as requested by
+// opts and zero parts are omitted.
+func (h *Humanizer) FormatDuration(d time.Duration, locale language.Tag, opts Options) (string, error) {
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	count := max(opts.MaxUnits, 1)
+	if d == 0 {
+		count = 1
+	}
+	exact := opts.Precision.IsExact()
+
+	nanos, err := decimal.New(int64(d), 0)
+	if err != nil {
+		return "", err
+	}
+
+	// Start with the largest unit reached by d and move to the next larger
+	// unit when rounding reaches it, so 59.99 minutes become 1 hour.
+	first := largestDurationUnit(d)
+	var last int
+	var amount decimal.Decimal
+	for {
+		last = min(first+count, len(durationUnits)) - 1
+		if exact {
+			// Fraction digits are only shown when a single unit is.
+			opts.Precision = FractionDigits(0, 1)
+			if last > first {
+				opts.Precision = FractionDigits(0, 0)
+			}
+		}
+		size := durationSize(last)
+		if amount, err = nanos.Quo(size); err != nil {
+			return "", err
+		}
+		if first == 0 {
+			break
+		}
+		rounded, err := opts.round(amount.Abs()).Mul(size)
+		if err != nil || rounded.Cmp(durationSize(first-1)) < 0 {
+			break
+		}
+		first--
+	}
+
+	p := message.NewPrinter(locale)
+	if first == last {
+		return h.formatUnit(loc, p, amount, durationUnits[last].unit, locale, opts)
+	}
+
+	parts := make([]mixedPart, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		partSize, err := durationSize(i).Quo(durationSize(last))
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, mixedPart{unit: durationUnits[i].unit, size: partSize})
+	}
+	return h.formatMixedUnit(loc, p, amount.Sign(), amount, parts, locale, opts, true)
+}
+
+// largestDurationUnit returns the index of the largest unit reached by d.
+// Zero is shown in seconds and shorter durations in milliseconds.
+func largestDurationUnit(d time.Duration) int {
+	if d < 0 {
+		d = -d
+	}
+	for i, du := range durationUnits {
+		if d >= du.size || (d == 0 && du.size == time.Second) {
+			return i
+		}
+	}
+	return len(durationUnits) - 1
+}
+
+// durationSize returns the size of the i-th duration unit in nanoseconds.
+func durationSize(i int) decimal.Decimal {
+	size, _ := decimal.New(int64(durationUnits[i].size), 0)
+	return size
+}
@@ -0,0 +1,91 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"math"
+	"unicode"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/width"
+)
+
+// fitPrecisions lists the precisions tried by FormatFit, from the most
+// informative.
+var fitPrecisions = []Precision{
+	{},
+	FractionDigits(0, 2),
+	FractionDigits(0, 1),
+	FractionDigits(0, 0),
+}
+
+// FormatFit returns the most informative rendering of value that fits in
+// maxWidth display cells, as measured by DisplayWidth. It tries the Long
+// and then the Short patterns with the exact number, then with two, one
+// and no fraction digits, e.g. "1.23 million", "1.23M", "1.2M" and "1M".
+// Numbers that are not compacted are shown in full with the grouping
+// separators of the locale. When none of them fits, it caps the number
+// one magnitude below it, e.g. "99M+" for 123,456,789, and reports an
+// error when the cap does not fit either.
+func (h *Humanizer) FormatFit(value string, locale language.Tag, maxWidth int) (string, error) {
+	valueDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	styled := map[Option]*Humanizer{
+		Long:  New(h.locales, Long, h.fallback),
+		Short: New(h.locales, Short, h.fallback),
+	}
+	format := func(style Option, opts Options) (string, error) {
+		r, err := styled[style].FormatResult(valueDec, locale, opts)
+		if err == nil && r.Fallback {
+			opts.CompactThreshold = math.MaxInt64
+			r, err = styled[style].FormatResult(valueDec, locale, opts)
+		}
+		return r.Text, err
+	}
+
+	for _, prec := range fitPrecisions {
+		for _, style := range []Option{Long, Short} {
+			s, err := format(style, Options{Precision: prec})
+			if err != nil {
+				return "", err
+			}
+			if DisplayWidth(s) <= maxWidth {
+				return s, nil
+			}
+		}
+	}
+
+	// Cap the number one magnitude below it, e.g. 123,456,789 at
+	// 99,999,999. Lower caps would understate the number too much.
+	if limit, ok := pow10(exponent(valueDec) - 1); ok && limit > 1 && valueDec.IsPos() {
+		s, err := format(Short, Options{Precision: FractionDigits(0, 0), Cap: limit - 1})
+		if err != nil {
+			return "", err
+		}
+		if DisplayWidth(s) <= maxWidth {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("number %q does not fit in a width of %d", value, maxWidth)
+}
+
+// DisplayWidth returns the number of terminal cells taken by s. East Asian
+// wide and fullwidth characters take two cells; combining marks, bidi and
+// other format controls such as U+200E and U+200B take none.
+func DisplayWidth(s string) int {
+	n := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc):
+		case width.LookupRune(r).Kind() == width.EastAsianWide,
+			width.LookupRune(r).Kind() == width.EastAsianFullwidth:
+			n += 2
+		default:
+			n++
+		}
+	}
+	return n
+}
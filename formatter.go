@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/govalues/decimal"
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
+	"golang.org/x/text/number"
 )
 
 // Locale defines the methods needed by Humanizer to format numbers
@@ -36,6 +38,59 @@ type CldrData struct {
 	Short struct {
 		DecimalFormat map[string]string
 	}
+
+	// CurrencyFormat holds the currency patterns and symbols of the locale.
+	CurrencyFormat CurrencyFormat
+
+	// PercentFormat is the standard percent pattern, e.g. "#,##0%".
+	PercentFormat string
+
+	// Symbols holds the sign and percent symbols of the locale.
+	Symbols NumberSymbols
+
+	// MinimumGroupingDigits is the CLDR minimum number of digits in the
+	// highest group for grouping separators to be shown, e.g. 2 for
+	// Spanish "1234" but "12.345". Zero means 1.
+	MinimumGroupingDigits int
+
+	// CompactThreshold is the smallest magnitude compacted by default,
+	// e.g. 10000 for a locale that shows "1.234" rather than "1,2 mil".
+	// Smaller numbers are shown as grouped numbers. Zero compacts every
+	// number that has a pattern.
+	CompactThreshold int64
+
+	// Units holds the measurement unit patterns keyed by CLDR unit
+	// identifier, e.g. "kilometer".
+	Units map[string]UnitPatterns
+
+	// UnitList holds the patterns joining the parts of mixed units,
+	// e.g. "{0}, {1}" in "5 ft, 3 in".
+	UnitList ListPatterns
+
+	// RelativeTime holds the relative time patterns keyed by unit,
+	// e.g. "day".
+	RelativeTime map[string]RelativeTimeUnit
+
+	// Ordinal holds the ordinal patterns keyed by ordinal plural form,
+	// e.g. "two": "{0}nd".
+	Ordinal map[string]string
+
+	// RBNF holds the rule-based number format rules in the ICU syntax,
+	// e.g. the spell-out rule sets.
+	RBNF string
+
+	// NumberingSystems maps the algorithmic numbering systems selected by
+	// the -u-nu- extension, e.g. "jpan", to the RBNF rule set rendering
+	// their numbers, e.g. "spellout-cardinal".
+	NumberingSystems map[string]string
+
+	// MiscPatterns holds the range, approximately and at-least patterns.
+	MiscPatterns MiscPatterns
+
+	// PluralRanges maps the plural forms of the ends of a range, e.g.
+	// "other-one", to the plural form of the range when it is not the form
+	// of the end.
+	PluralRanges map[string]string
 }
 
 // Option indicates whether Humanizer should use long or short
@@ -48,6 +103,10 @@ const (
 
 	// Short indicates short-form patterns, e.g., "1K".
 	Short
+
+	// Narrow indicates narrow patterns where the data provides them,
+	// e.g., "5km" for units. Numbers use the short patterns.
+	Narrow
 )
 
 // FallbackFunc is a user-supplied function invoked when the input string
@@ -80,6 +139,9 @@ type Humanizer struct {
 	locales  map[language.Tag]Locale
 	opt      Option
 	fallback FallbackFunc
+
+	// rbnf caches the parsed rule-based number formats by locale code.
+	rbnf sync.Map
 }
 
 // New returns a pointer to a new Humanizer with the given locale,
@@ -109,10 +171,9 @@ func (h *Humanizer) Formatter(value string, locale language.Tag) (string, bool,
 }
 
 func (h *Humanizer) FormatDecimal(valueDec decimal.Decimal, locale language.Tag) (string, bool, error) {
-	locCode := locale
-	loc, exists := h.locales[locCode]
-	if !exists {
-		return "", false, fmt.Errorf("locale %q not found", locCode)
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", false, err
 	}
 
 	valueStr := valueDec.String()
@@ -121,19 +182,141 @@ func (h *Humanizer) FormatDecimal(valueDec decimal.Decimal, locale language.Tag)
 		return h.fallback(valueStr), true, nil
 	}
 
-	var df map[string]string
-	if h.opt == Long {
-		df = loc.Data().Long.DecimalFormat
+	c, ok := compactPattern(loc, h.decimalFormat(loc), valueDec)
+	if !ok {
+		return h.fallback(valueStr), true, nil
+	}
+
+	p := message.NewPrinter(locale)
+	floatVal, _ := c.ratio.Float64()
+	out := replacePlaceholder(c.tmpl, p.Sprintf("%v", floatVal))
+
+	return h.applyNumberingSystem(out, loc, p, locale), false, nil
+}
+
+// FormatDecimalOptions is like FormatDecimal but applies the precision,
+// rounding and sign display settings of opts. Negative values are compacted
+// by magnitude and signed with the locale's symbols. With the Long style,
+// integers within opts.SpellSmallNumbers are spelled out, e.g. "five".
+func (h *Humanizer) FormatDecimalOptions(valueDec decimal.Decimal, locale language.Tag, opts Options) (string, bool, error) {
+	r, err := h.FormatResult(valueDec, locale, opts)
+	return r.Text, r.Fallback, err
+}
+
+// FormatResult is like FormatDecimalOptions but also describes how far the
+// displayed number is from valueDec.
+func (h *Humanizer) FormatResult(valueDec decimal.Decimal, locale language.Tag, opts Options) (Result, error) {
+	loc, err := h.locale(locale)
+	if err != nil {
+		return Result{}, err
+	}
+
+	valueDec, opts, capped := opts.capped(valueDec)
+	p := message.NewPrinter(locale)
+	var out string
+	displayed := opts.round(valueDec.Abs())
+	if words, ok := h.spellSmall(loc, p, displayed, orDefault(opts.SpellOutRuleSet, "spellout-numbering"), opts); ok {
+		out = words
+	} else if text, shown, ok := compositeNumber(loc, p, h.decimalFormat(loc), valueDec.Abs(), opts); ok {
+		out, displayed = text, shown
+	} else if c, ok := compactDecimal(loc, h.decimalFormat(loc), valueDec.Abs(), opts); ok {
+		out = c.format(p, opts.Precision)
+		displayed = c.value()
+	} else if belowThreshold(loc, valueDec.Abs(), opts) || capped {
+		// A cap the patterns cannot compact, such as 999, is shown in full.
+		out = groupedNumber(loc, p, displayed, opts.Precision)
+	} else if opts.SignDisplay == SignAuto {
+		return Result{Text: h.fallback(valueDec.String()), Fallback: true}, nil
 	} else {
-		df = loc.Data().Short.DecimalFormat
+		// Other sign displays are applied around the unsigned fallback.
+		text := applySign(h.fallback(valueDec.Abs().String()), valueDec.Sign(), loc, opts.SignDisplay)
+		return Result{Text: text, Fallback: true}, nil
+	}
+
+	// With a fixed scale a value rounded to zero keeps its sign, e.g. "-0M",
+	// so a column still shows which cells are negative.
+	sign := valueDec.Sign() * displayed.Sign()
+	if opts.FixedScale > 0 && displayed.IsZero() && opts.SignDisplay != SignExceptZero {
+		sign = valueDec.Sign()
+	}
+	out = h.applyNumberingSystem(out, loc, p, locale)
+	out = applySign(out, sign, loc, opts.SignDisplay)
+	r := newResult(loc, out, valueDec.Abs(), displayed, opts)
+	if capped {
+		r.Text, r.Capped = atLeast(loc, r.Text), true
+	}
+	return r, nil
+}
+
+// decimalFormat returns the decimal patterns of loc for the configured
+// Option.
+func (h *Humanizer) decimalFormat(loc Locale) map[string]string {
+	return decimalFormatStyle(loc, h.opt)
+}
+
+// decimalFormatStyle returns the decimal patterns of loc for style.
+func decimalFormatStyle(loc Locale, style Option) map[string]string {
+	if style == Long {
+		return loc.Data().Long.DecimalFormat
 	}
+	return loc.Data().Short.DecimalFormat
+}
+
+// locale returns the Locale registered for the given tag. Tags with a
+// region or extensions, e.g. "en-US" or "en-u-ms-metric", resolve to the
+// closest registered parent.
+func (h *Humanizer) locale(tag language.Tag) (Locale, error) {
+	for t := tag; ; t = t.Parent() {
+		if loc, exists := h.locales[t]; exists {
+			return loc, nil
+		}
+		if t.IsRoot() {
+			return nil, fmt.Errorf("locale %q not found", tag)
+		}
+	}
+}
+
+// compactNumber is a number expressed in one of the scales of a CLDR
+// decimal format, e.g. 1.2 thousands rendered with the pattern "0K".
+type compactNumber struct {
+	ratio decimal.Decimal
+	scale int64
+	tmpl  string
+
+	// ungrouped omits the grouping separator of ratios below 10,000, as
+	// ICU does for compact numbers, e.g. "1234万".
+	ungrouped bool
+}
+
+// format renders the ratio into the pattern.
+func (c compactNumber) format(p *message.Printer, prec Precision) string {
+	if c.ungrouped && c.ratio.Cmp(decimal.MustNew(10000, 0)) < 0 {
+		return replacePlaceholder(c.tmpl, formatNumber(p, c.ratio, prec, number.NoSeparator()))
+	}
+	return replacePlaceholder(c.tmpl, formatNumber(p, c.ratio, prec))
+}
+
+// value returns the number shown by c, i.e. the ratio times the scale.
+func (c compactNumber) value() decimal.Decimal {
+	scaleDec, _ := decimal.New(c.scale, 0)
+	v, err := c.ratio.Mul(scaleDec)
+	if err != nil {
+		return c.ratio
+	}
+	return v
+}
+
+// compactPattern selects the scale of df that represents valueDec exactly
+// and returns the resulting ratio together with the matching CLDR pattern.
+// The boolean is false when no pattern applies.
+func compactPattern(loc Locale, df map[string]string, valueDec decimal.Decimal) (compactNumber, bool) {
 	if len(df) == 0 {
-		return h.fallback(valueStr), true, nil
+		return compactNumber{}, false
 	}
 
 	groupScales := parseGroupScales(df)
 	if len(groupScales) == 0 {
-		return h.fallback(valueStr), true, nil
+		return compactNumber{}, false
 	}
 
 	sortedScales := sortGroupScales(groupScales)
@@ -157,7 +340,7 @@ func (h *Humanizer) FormatDecimal(valueDec decimal.Decimal, locale language.Tag)
 			if ratio.Cmp(thousand) > 0 {
 				continue
 			}
-			if !has0or1DecimalExactly(ratio, loc.Code()) {
+			if !hasFractionDigitsAtMost(ratio, compactFractionDigits(loc)) {
 				continue
 			}
 			if !isAllowedRatio(ratio) {
@@ -171,29 +354,98 @@ func (h *Humanizer) FormatDecimal(valueDec decimal.Decimal, locale language.Tag)
 	}
 
 	if bestRatio.IsZero() {
-		return h.fallback(valueStr), true, nil
+		return compactNumber{}, false
+	}
+
+	tmpl := pluralPattern(df, best.scale, loc.PluralForm(bestRatio, valueDec.String()))
+	if tmpl == "" {
+		return compactNumber{}, false
 	}
 
-	pluralForm := loc.PluralForm(bestRatio, valueStr)
-	key := fmt.Sprintf("%d-count-%s", best.scale, pluralForm)
-	tmpl := df[key]
+	return compactNumber{ratio: bestRatio, scale: best.scale, tmpl: tmpl}, true
+}
 
-	if tmpl == "" && pluralForm != "other" {
-		altKey := fmt.Sprintf("%d-count-other", best.scale)
-		altTmpl := df[altKey]
-		if altTmpl != "" {
-			tmpl = altTmpl
+// compactDecimal compacts the non-negative value v with the patterns of df.
+// A fixed scale defers to fixedCompact; otherwise values below the
+// compaction threshold are not compacted.
+// With SelectMagnitude it defers to magnitudeCompact and with an exact
+// precision to compactPattern; otherwise the scale is chosen by magnitude
+// and the ratio is rounded as requested by opts.
+func compactDecimal(loc Locale, df map[string]string, v decimal.Decimal, opts Options) (compactNumber, bool) {
+	if opts.FixedScale > 0 {
+		return fixedCompact(loc, df, v, opts)
+	}
+	if belowThreshold(loc, v, opts) {
+		return compactNumber{}, false
+	}
+	if opts.Selection == SelectMagnitude {
+		return magnitudeCompact(loc, df, v, opts)
+	}
+	if opts.Precision.IsExact() {
+		if !v.IsInt() {
+			return compactNumber{}, false
 		}
+		return compactPattern(loc, df, v)
 	}
 
-	if tmpl == "" {
-		return h.fallback(valueStr), true, nil
+	if len(df) == 0 {
+		return compactNumber{}, false
 	}
 
-	p := message.NewPrinter(locale)
-	floatVal, _ := bestRatio.Float64()
+	scales := sortGroupScales(parseGroupScales(df))
+	if len(scales) == 0 {
+		return compactNumber{}, false
+	}
+
+	// Select the scale by magnitude. A number just below the smallest scale
+	// is compacted when rounding reaches that scale, e.g. 999.96 as "1K".
+	i := sort.Search(len(scales), func(i int) bool {
+		scaleDec, _ := decimal.New(scales[i].scale, 0)
+		return v.Cmp(scaleDec) >= 0
+	})
+	if i == len(scales) {
+		smallest, _ := decimal.New(scales[i-1].scale, 0)
+		if opts.round(v).Cmp(smallest) < 0 {
+			return compactNumber{}, false
+		}
+		i--
+	}
+
+	var c compactNumber
+	for {
+		scaleDec, _ := decimal.New(scales[i].scale, 0)
+		ratio, err := v.Quo(scaleDec)
+		if err != nil {
+			return compactNumber{}, false
+		}
+		c = compactNumber{ratio: opts.round(ratio), scale: scales[i].scale, ungrouped: true}
+
+		// Rounding may reach the next scale, e.g. 999.96K rounds to 1000K,
+		// which is shown as 1M instead.
+		if i > 0 {
+			next, _ := decimal.New(scales[i-1].scale, 0)
+			if c.value().Cmp(next) >= 0 {
+				i--
+				continue
+			}
+		}
+		break
+	}
 
-	return replacePlaceholder(tmpl, p.Sprintf("%v", floatVal)), false, nil
+	c.tmpl = pluralPattern(df, c.scale, loc.PluralForm(c.ratio, c.value().String()))
+	if c.tmpl == "" {
+		return compactNumber{}, false
+	}
+	return c, true
+}
+
+// pluralPattern returns the pattern of df for the given scale and plural
+// form, falling back to the "other" form.
+func pluralPattern(df map[string]string, scale int64, pluralForm string) string {
+	if tmpl := df[fmt.Sprintf("%d-count-%s", scale, pluralForm)]; tmpl != "" {
+		return tmpl
+	}
+	return df[fmt.Sprintf("%d-count-other", scale)]
 }
 
 // cutCountSuffix removes the "-count-" suffix from a key, returning
@@ -255,21 +507,28 @@ func extractName(s string) string {
 	return strings.ReplaceAll(s, "0", "")
 }
 
-// has0or1DecimalExactly checks if the given decimal has exactly zero
-// or one decimal place, depending on certain locale constraints.
-func has0or1DecimalExactly(d decimal.Decimal, localeCode language.Tag) bool {
-	// Some East Asian locales require slightly different logic.
-	if localeCode.String() != "ja" && localeCode.String() != "ko" {
-		t1 := d.Trunc(1)
-		if d.Equal(t1) {
-			return true
-		}
-		r1 := d.Round(1)
-		return d.Equal(r1)
+// PrecisionPolicy is an optional Locale capability declaring how many
+// fraction digits an exact compact number may show, e.g. 2 for "1.25万".
+// Locales that do not implement it allow one fraction digit ("1.2K").
+type PrecisionPolicy interface {
+	// CompactFractionDigits returns the maximum number of fraction digits
+	// of a compact ratio displayed without rounding.
+	CompactFractionDigits() int
+}
+
+// compactFractionDigits returns the maximum number of fraction digits of
+// an exact compact ratio in loc.
+func compactFractionDigits(loc Locale) int {
+	if pp, ok := loc.(PrecisionPolicy); ok {
+		return pp.CompactFractionDigits()
 	}
+	return 1
+}
 
-	r2 := d.Round(2)
-	return d.Equal(r2)
+// hasFractionDigitsAtMost reports whether d has at most n significant
+// fraction digits.
+func hasFractionDigitsAtMost(d decimal.Decimal, n int) bool {
+	return d.Equal(d.Round(n))
 }
 
 // isAllowedRatio returns true if the ratio is within an acceptable range
@@ -283,11 +542,11 @@ func isAllowedRatio(r decimal.Decimal) bool {
 	return floor.Cmp(hundred) < 0
 }
 
-// replacePlaceholder replaces the first occurrence of "000", "00", or "0"
-// in tmpl with ratio, matching common CLDR patterns. If none found,
+// replacePlaceholder replaces the first occurrence of "0000", "000", "00"
+// or "0" in tmpl with ratio, matching common CLDR patterns. If none found,
 // tmpl is returned unchanged.
 func replacePlaceholder(tmpl, ratio string) string {
-	pats := []string{"000", "00", "0"}
+	pats := []string{"0000", "000", "00", "0"}
 	for _, p := range pats {
 		if idx := strings.Index(tmpl, p); idx >= 0 {
 			return tmpl[:idx] + ratio + tmpl[idx+len(p):]
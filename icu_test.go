@@ -0,0 +1,140 @@
+package humanizecompact_test
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+
+	hc "github.com/dejurin/humanizecompact"
+)
+
+// icuSigns maps the signDisplay values of Intl.NumberFormat.
+var icuSigns = map[string]hc.SignDisplay{
+	"auto":       hc.SignAuto,
+	"always":     hc.SignAlways,
+	"exceptZero": hc.SignExceptZero,
+	"never":      hc.SignNever,
+}
+
+// icuVector is one line of testdata/icu/compact.tsv.
+type icuVector struct {
+	suite, locale, style, sign, number, expected string
+}
+
+// readICUVectors reads the ICU golden vectors generated by
+// testdata/icu/generate.js.
+func readICUVectors(t *testing.T) []icuVector {
+	t.Helper()
+	f, err := os.Open("testdata/icu/compact.tsv")
+	if err != nil {
+		t.Fatalf("[ICU] %v", err)
+	}
+	defer f.Close()
+
+	var vectors []icuVector
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if strings.HasPrefix(s.Text(), "#") {
+			continue
+		}
+		fields := strings.Split(s.Text(), "\t")
+		if len(fields) != 6 {
+			t.Fatalf("[ICU] malformed line %q", s.Text())
+		}
+		expected, err := strconv.Unquote(fields[5])
+		if err != nil {
+			t.Fatalf("[ICU] malformed output %q: %v", fields[5], err)
+		}
+		vectors = append(vectors, icuVector{fields[0], fields[1], fields[2], fields[3], fields[4], expected})
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("[ICU] %v", err)
+	}
+	return vectors
+}
+
+// TestHumanizeICUCompact checks the sign displays, the rounding at the scale
+// boundaries and the magnitude-based selection of every locale against the
+// output of ICU compact notation.
+func TestHumanizeICUCompact(t *testing.T) {
+	locales := make(map[language.Tag]hc.Locale, len(bundledLocales))
+	for _, loc := range bundledLocales {
+		locales[loc.Code()] = loc
+	}
+	humanizers := map[string]*hc.Humanizer{
+		"short": hc.New(locales, hc.Short, fallback),
+		"long":  hc.New(locales, hc.Long, fallback),
+	}
+
+	for _, v := range readICUVectors(t) {
+		var opts hc.Options
+		switch v.suite {
+		case "sign":
+			opts = hc.Options{Precision: hc.FractionDigits(0, 1), SignDisplay: icuSigns[v.sign]}
+		case "boundary":
+			opts = hc.Options{Precision: hc.FractionDigits(0, 1)}
+		case "magnitude":
+			opts = hc.Options{Selection: hc.SelectMagnitude}
+		}
+
+		expected := v.expected
+		if want, ok := icuDifferences[v.suite+" "+v.locale+" "+v.style+" "+v.sign+" "+v.number]; ok {
+			expected = want
+		}
+		res, _, err := humanizers[v.style].FormatDecimalOptions(decimal.MustParse(v.number), bundledLocales[v.locale].Code(), opts)
+		if err != nil {
+			t.Errorf("[ICU] %s %s %s number %q => unexpected error: %v", v.suite, v.locale, v.style, v.number, err)
+			continue
+		}
+		if res != expected {
+			t.Errorf("[ICU] %s %s %s %s number %q => got %q, want %q", v.suite, v.locale, v.style, v.sign, v.number, res, expected)
+		}
+	}
+}
+
+// icuDifferences holds the expected outputs that knowingly differ from ICU,
+// keyed by suite, locale, style, sign display and number.
+var icuDifferences = map[string]string{
+	// golang.org/x/text marks Arabic signs with LRM where ICU uses ALM.
+	"sign ar short auto -1234567":       "\u200e-١٫٢\u00a0مليون",
+	"sign ar short always 1234567":      "\u200e+١٫٢\u00a0مليون",
+	"sign ar short always -1234567":     "\u200e-١٫٢\u00a0مليون",
+	"sign ar short exceptZero 1234567":  "\u200e+١٫٢\u00a0مليون",
+	"sign ar short exceptZero -1234567": "\u200e-١٫٢\u00a0مليون",
+
+	// The pattern of a scale is the one of its smallest key, so Arabic
+	// uses the plural of "0 آلاف" for 10 thousand and Spanish divides
+	// "mil M" by the 10,000,000,000 of "00 mil M".
+	"boundary ar short auto 9999.6":       "١٠\u00a0آلاف",
+	"boundary ar short auto 9999.4":       "١٠\u00a0آلاف",
+	"boundary ar short auto 10000":        "١٠\u00a0آلاف",
+	"boundary ar long auto 9999.6":        "١٠ آلاف",
+	"boundary ar long auto 9999.4":        "١٠ آلاف",
+	"boundary ar long auto 10000":         "١٠ آلاف",
+	"boundary es short auto 10000000000":  "1\u00a0mil\u00a0M",
+	"boundary es short auto 99996000000":  "10\u00a0mil\u00a0M",
+	"boundary es short auto 99994000000":  "10\u00a0mil\u00a0M",
+	"boundary es short auto 100000000000": "10\u00a0mil\u00a0M",
+	"boundary es short auto 999940000000": "1\u00a0B",
+
+	// golang.org/x/text groups French digits with NBSP where ICU uses NNBSP.
+	"boundary fr short auto 10000000000000000":  "10\u00a0000\u00a0Bn",
+	"boundary fr long auto 10000000000000000":   "10\u00a0000 billions",
+	"magnitude fr short auto 12345678900000000": "12\u00a0346\u00a0Bn",
+	"magnitude fr long auto 12345678900000000":  "12\u00a0346 billions",
+
+	// The Italian "one" pattern of a trillion has no digit.
+	"boundary it long auto 999960000000":  "mille miliardi",
+	"boundary it long auto 1000000000000": "mille miliardi",
+
+	// The Bulgarian plural rules treat 1.2 as "one".
+	"magnitude bg long auto 1234":          "1,2 хил.",
+	"magnitude bg long auto 1234567":       "1,2 милион",
+	"magnitude bg long auto 1234567890":    "1,2 милиард",
+	"magnitude bg long auto 1234567890000": "1,2 трилион",
+}
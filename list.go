@@ -0,0 +1,60 @@
+package humanizecompact
+
+import "strings"
+
+// ListPattern contains the CLDR patterns used to join the items of a list.
+// Pair joins a list of exactly two items; longer lists are joined with
+// Start for the first two items, End for the last two and Middle for the
+// ones in between. "{0}" and "{1}" mark the positions of the items.
+type ListPattern struct {
+	Pair   string
+	Start  string
+	Middle string
+	End    string
+}
+
+// ListPatterns holds the list patterns of a locale for each style.
+type ListPatterns struct {
+	Long   ListPattern
+	Short  ListPattern
+	Narrow ListPattern
+}
+
+// pattern returns the list pattern of the given style, falling back to the
+// short and long ones when the style has no data.
+func (l ListPatterns) pattern(style Option) ListPattern {
+	if style == Narrow && l.Narrow.Pair != "" {
+		return l.Narrow
+	}
+	if style != Long && l.Short.Pair != "" {
+		return l.Short
+	}
+	if l.Long.Pair != "" {
+		return l.Long
+	}
+	return ListPattern{Pair: "{0} {1}", Start: "{0} {1}", Middle: "{0} {1}", End: "{0} {1}"}
+}
+
+// join joins items with the patterns of l, e.g. "5 ft, 3 in".
+func (l ListPattern) join(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return joinPair(l.Pair, items[0], items[1])
+	}
+
+	last := len(items) - 1
+	out := joinPair(l.End, items[last-1], items[last])
+	for i := last - 2; i > 0; i-- {
+		out = joinPair(l.Middle, items[i], out)
+	}
+	return joinPair(l.Start, items[0], out)
+}
+
+// joinPair substitutes a and b into a two-item list pattern.
+func joinPair(pattern, a, b string) string {
+	return strings.NewReplacer("{0}", a, "{1}", b).Replace(pattern)
+}
@@ -209,3 +209,27 @@ func TestHumanizeArOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeArFit(t *testing.T) {
+	tests := []struct {
+		number   string
+		width    int
+		expected string
+	}{
+		{"-1234567", 10, "\u200E-١٬٢٣٤٬٥٦٧"},
+		{"-1234567", 9, "\u200E-١ مليون"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatFit(tt.number, language.Arabic, tt.width)
+		if err != nil {
+			t.Errorf("[FIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[FIT] number %q (%d) => got %q, want %q", tt.number, tt.width, res, tt.expected)
+		}
+	}
+}
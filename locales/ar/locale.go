@@ -58,6 +58,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Arabic,
 	data: hc.CldrData{
@@ -177,5 +182,97 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 ترليون",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "‏#,##0.00 ¤",
+			Accounting: "؜#,##0.00¤;(؜#,##0.00¤)",
+			Short: map[string]string{
+				"1000-count-one":              "0 ألف ¤",
+				"1000-count-other":            "0 ألف ¤",
+				"10000-count-one":             "00 ألف ¤",
+				"10000-count-other":           "00 ألف ¤",
+				"100000-count-one":            "000 ألف ¤",
+				"100000-count-other":          "000 ألف ¤",
+				"1000000-count-one":           "0 مليون ¤",
+				"1000000-count-other":         "0 مليون ¤",
+				"10000000-count-one":          "00 مليون ¤",
+				"10000000-count-other":        "00 مليون ¤",
+				"100000000-count-one":         "000 مليون ¤",
+				"100000000-count-other":       "000 مليون ¤",
+				"1000000000-count-one":        "0 مليار ¤",
+				"1000000000-count-other":      "0 مليار ¤",
+				"10000000000-count-one":       "00 مليار ¤",
+				"10000000000-count-other":     "00 مليار ¤",
+				"100000000000-count-one":      "000 مليار ¤",
+				"100000000000-count-other":    "000 مليار ¤",
+				"1000000000000-count-one":     "0 ترليون ¤",
+				"1000000000000-count-other":   "0 ترليون ¤",
+				"10000000000000-count-one":    "00 ترليون ¤",
+				"10000000000000-count-other":  "00 ترليون ¤",
+				"100000000000000-count-one":   "000 ترليون ¤",
+				"100000000000000-count-other": "000 ترليون ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EGP": "ج.م.",
+				"EUR": "€",
+				"GBP": "UK£",
+				"ILS": "₪",
+				"INR": "₹",
+				"IRR": "ر.إ.",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"SAR": "ر.س.",
+				"THB": "฿",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0‎%‎",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "‎+",
+			MinusSign:   "‎-",
+			PercentSign: "%",
+			PerMille:    "؉",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} و{1}",
+				Start:  "{0}، و{1}",
+				Middle: "{0}، و{1}",
+				End:    "{0}، و{1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} و{1}",
+				Start:  "{0}، و{1}",
+				Middle: "{0}، و{1}",
+				End:    "{0}، و{1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} و{1}",
+				Start:  "{0} و{1}",
+				Middle: "{0} و{1}",
+				End:    "{0} و{1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "+{0}",
+		},
+		PluralRanges: map[string]string{
+			"one-two":   "other",
+			"other-one": "other",
+			"other-two": "other",
+			"zero-one":  "zero",
+			"zero-two":  "zero",
+		},
 	},
 }
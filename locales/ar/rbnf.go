@@ -0,0 +1,259 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: صفر;
+1: واحد;
+2: إثنان;
+3: ثلاثة;
+4: أربعة;
+5: خمسة;
+6: ستة;
+7: سبعة;
+8: ثمانية;
+9: تسعة;
+10: عشرة;
+11: إحدى عشر;
+12: إثنا عشر;
+13: >%spellout-numbering> عشر;
+20: عشرون;
+21: >%spellout-numbering> وعشرون;
+30: ثلاثون;
+31: >%spellout-numbering> وثلاثون;
+40: أربعون;
+41: >%spellout-numbering> وأربعون;
+50: خمسون;
+51: >%spellout-numbering> وخمسون;
+60: ستون;
+61: >%spellout-numbering> وستون;
+70: سبعون;
+71: >%spellout-numbering> وسبعون;
+80: ثمانون;
+81: >%spellout-numbering> وثمانون;
+90: تسعون;
+91: >%spellout-numbering> وتسعون;
+100: مائة;
+101: مائة و>%spellout-numbering>;
+200: مائتان;
+201: مائتان و>%spellout-numbering>;
+300: <%spellout-numbering< مائة;
+301: <%spellout-numbering< مائة و>%spellout-numbering>;
+1000: ألف;
+1001: ألف و>%spellout-numbering>;
+2000: ألفين;
+2001: ألفين و>%spellout-numbering>;
+3000: <%spellout-numbering< آلاف;
+3001: <%spellout-numbering< آلاف و>%spellout-numbering>;
+11000/1000: <%%spellout-numbering-m< ألف;
+11001/1000: <%%spellout-numbering-m< ألف و>%spellout-numbering>;
+1000000: مليون;
+1000001: مليون و>%spellout-numbering>;
+2000000: <%%spellout-numbering-m< مليون;
+2000001: <%%spellout-numbering-m< مليون و>%spellout-numbering>;
+1000000000: مليار;
+1000000001: مليار و>%spellout-numbering>;
+2000000000: <%%spellout-numbering-m< مليار;
+2000000001: <%%spellout-numbering-m< مليار و>%spellout-numbering>;
+1000000000000: ترليون;
+1000000000001: ترليون و>%spellout-numbering>;
+2000000000000: <%%spellout-numbering-m< ترليون;
+2000000000001: <%%spellout-numbering-m< ترليون و>%spellout-numbering>;
+1000000000000000: كوادرليون;
+1000000000000001: كوادرليون و>%spellout-numbering>;
+2000000000000000: <%%spellout-numbering-m< كوادرليون;
+2000000000000001: <%%spellout-numbering-m< كوادرليون و>%spellout-numbering>;
+1000000000000000000: =#,##0=;
+-x: ناقص >%spellout-numbering>;
+x.x: <%spellout-numbering< فاصل >%spellout-numbering>;
+%spellout-cardinal-feminine:
+0: صفر;
+1: واحدة;
+2: إثنتان;
+3: ثلاثة;
+4: أربعة;
+5: خمسة;
+6: ستة;
+7: سبعة;
+8: ثمانية;
+9: تسعة;
+10: عشرة;
+11: إحدى عشر;
+12: إثنتا عشرة;
+13: >%spellout-numbering> عشر;
+20: عشرون;
+21: >%spellout-numbering> وعشرون;
+30: ثلاثون;
+31: >%spellout-numbering> وثلاثون;
+40: أربعون;
+41: >%spellout-numbering> وأربعون;
+50: خمسون;
+51: >%spellout-numbering> وخمسون;
+60: ستون;
+61: >%spellout-numbering> وستون;
+70: سبعون;
+71: >%spellout-numbering> وسبعون;
+80: ثمانون;
+81: >%spellout-numbering> وثمانون;
+90: تسعون;
+91: >%spellout-numbering> وتسعون;
+100: مائة;
+101: مائة و>%spellout-numbering>;
+200: مائتان;
+201: مائتان و>%spellout-numbering>;
+300: <%spellout-numbering< مائة;
+301: <%spellout-numbering< مائة و>%spellout-numbering>;
+1000: ألف;
+1001: ألف و>%spellout-numbering>;
+2000: ألفي;
+2001: ألفي و>%spellout-numbering>;
+3000: <%spellout-numbering< آلاف;
+3001: <%spellout-numbering< آلاف و>%spellout-numbering>;
+11000/1000: <%%spellout-numbering-m< ألف;
+11001/1000: <%%spellout-numbering-m< ألف و>%spellout-numbering>;
+1000000: مليون;
+1000001: مليون و>%spellout-numbering>;
+2000000: <%%spellout-numbering-m< مليون;
+2000001: <%%spellout-numbering-m< مليون و>%spellout-numbering>;
+1000000000: مليار;
+1000000001: مليار و>%spellout-numbering>;
+2000000000: <%%spellout-numbering-m< مليار;
+2000000001: <%%spellout-numbering-m< مليار و>%spellout-numbering>;
+1000000000000: ترليون;
+1000000000001: ترليون و>%spellout-numbering>;
+2000000000000: <%%spellout-numbering-m< ترليون;
+2000000000001: <%%spellout-numbering-m< ترليون و>%spellout-numbering>;
+1000000000000000: كوادرليون;
+1000000000000001: كوادرليون و>%spellout-numbering>;
+2000000000000000: <%%spellout-numbering-m< كوادرليون;
+2000000000000001: <%%spellout-numbering-m< كوادرليون و>%spellout-numbering>;
+1000000000000000000: =#,##0=;
+-x: ناقص >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< فاصل >%spellout-cardinal-feminine>;
+%%spellout-numbering-m:
+0: صفر;
+1: واحد;
+2: إثنان;
+3: ثلاثة;
+4: أربعة;
+5: خمسة;
+6: ستة;
+7: سبعة;
+8: ثمانية;
+9: تسعة;
+10: عشرة;
+11: إحدى عشر;
+12: إثنا عشر;
+13: >%%spellout-numbering-m> عشر;
+20: عشرون;
+21: >%%spellout-numbering-m> وعشرون;
+30: ثلاثون;
+31: >%%spellout-numbering-m> وثلاثون;
+40: أربعون;
+41: >%%spellout-numbering-m> وأربعون;
+50: خمسون;
+51: >%%spellout-numbering-m> وخمسون;
+60: ستون;
+61: >%%spellout-numbering-m> وستون;
+70: سبعون;
+71: >%%spellout-numbering-m> وسبعون;
+80: ثمانون;
+81: >%%spellout-numbering-m> وثمانون;
+90: تسعون;
+91: >%%spellout-numbering-m> وتسعون;
+100: مائة;
+101: مائة و>%%spellout-numbering-m>;
+200: مائتان;
+201: مائتان و>%%spellout-numbering-m>;
+300: <%spellout-numbering< مائة;
+301: <%spellout-numbering< مائة و>%%spellout-numbering-m>;
+1000: ألف;
+1001: ألف و>%%spellout-numbering-m>;
+2000: ألفي;
+2001: ألفي و>%%spellout-numbering-m>;
+3000: <%spellout-numbering< آلاف;
+3001: <%spellout-numbering< آلاف و>%%spellout-numbering-m>;
+11000/1000: <%%spellout-numbering-m< ألف;
+11001/1000: <%%spellout-numbering-m< ألف و>%%spellout-numbering-m>;
+1000000: مليون;
+1000001: مليون و>%%spellout-numbering-m>;
+2000000: <%%spellout-numbering-m< مليون;
+2000001: <%%spellout-numbering-m< مليون و>%%spellout-numbering-m>;
+1000000000: مليار;
+1000000001: مليار و>%%spellout-numbering-m>;
+2000000000: <%%spellout-numbering-m< مليار;
+2000000001: <%%spellout-numbering-m< مليار و>%%spellout-numbering-m>;
+1000000000000: ترليون;
+1000000000001: ترليون و>%%spellout-numbering-m>;
+2000000000000: <%%spellout-numbering-m< ترليون;
+2000000000001: <%%spellout-numbering-m< ترليون و>%%spellout-numbering-m>;
+1000000000000000: كوادرليون;
+1000000000000001: كوادرليون و>%%spellout-numbering-m>;
+2000000000000000: <%%spellout-numbering-m< كوادرليون;
+2000000000000001: <%%spellout-numbering-m< كوادرليون و>%%spellout-numbering-m>;
+1000000000000000000: =#,##0=;
+%spellout-cardinal-masculine:
+0: صفر;
+1: واحد;
+2: إثنان;
+3: ثلاثة;
+4: أربعة;
+5: خمسة;
+6: ستة;
+7: سبعة;
+8: ثمانية;
+9: تسعة;
+10: عشرة;
+11: إحدى عشر;
+12: إثنا عشر;
+13: >%spellout-cardinal-masculine> عشر;
+20: عشرون;
+21: >%%spellout-numbering-m> وعشرون;
+30: ثلاثون;
+31: >%%spellout-numbering-m> وثلاثون;
+40: أربعون;
+41: >%%spellout-numbering-m> وأربعون;
+50: خمسون;
+51: >%%spellout-numbering-m> وخمسون;
+60: ستون;
+61: >%%spellout-numbering-m> وستون;
+70: سبعون;
+71: >%%spellout-numbering-m> وسبعون;
+80: ثمانون;
+81: >%%spellout-numbering-m> وثمانون;
+90: تسعون;
+91: >%%spellout-numbering-m> وتسعون;
+100: مائة;
+101: مائة و>%%spellout-numbering-m>;
+200: مائتان;
+201: مائتان و>%%spellout-numbering-m>;
+300: <%spellout-numbering< مائة;
+301: <%spellout-numbering< مائة و>%%spellout-numbering-m>;
+1000: ألف;
+1001: ألف و>%%spellout-numbering-m>;
+2000: ألفي;
+2001: ألفي و>%%spellout-numbering-m>;
+3000: <%spellout-numbering< آلاف;
+3001: <%spellout-numbering< آلاف و>%%spellout-numbering-m>;
+11000/1000: <%%spellout-numbering-m< ألف;
+11001/1000: <%%spellout-numbering-m< ألف و>%%spellout-numbering-m>;
+1000000: مليون;
+1000001: مليون و>%%spellout-numbering-m>;
+2000000: <%%spellout-numbering-m< مليون;
+2000001: <%%spellout-numbering-m< مليون و>%%spellout-numbering-m>;
+1000000000: مليار;
+1000000001: مليار و>%%spellout-numbering-m>;
+2000000000: <%%spellout-numbering-m< مليار;
+2000000001: <%%spellout-numbering-m< مليار و>%%spellout-numbering-m>;
+1000000000000: ترليون;
+1000000000001: ترليون و>%%spellout-numbering-m>;
+2000000000000: <%%spellout-numbering-m< ترليون;
+2000000000001: <%%spellout-numbering-m< ترليون و>%%spellout-numbering-m>;
+1000000000000000: كوادرليون;
+1000000000000001: كوادرليون و>%%spellout-numbering-m>;
+2000000000000000: <%%spellout-numbering-m< كوادرليون;
+2000000000000001: <%%spellout-numbering-m< كوادرليون و>%%spellout-numbering-m>;
+1000000000000000000: =#,##0=;
+-x: ناقص >%spellout-cardinal-masculine>;
+x.x: <%%spellout-numbering-m< فاصل >%spellout-cardinal-masculine> ;
+`
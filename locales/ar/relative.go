@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال سنة واحدة", "two": "خلال سنتين", "few": "خلال {0} سنوات", "other": "خلال {0} سنة"},
+			Past:     map[string]string{"one": "قبل سنة واحدة", "two": "قبل سنتين", "few": "قبل {0} سنوات", "other": "قبل {0} سنة"},
+			Relative: map[string]string{"-1": "السنة الماضية", "0": "السنة الحالية", "1": "السنة القادمة"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال سنة واحدة", "two": "خلال سنتين", "few": "خلال {0} سنوات", "other": "خلال {0} سنة"},
+			Past:     map[string]string{"one": "قبل سنة واحدة", "two": "قبل سنتين", "few": "قبل {0} سنوات", "other": "قبل {0} سنة"},
+			Relative: map[string]string{"-1": "السنة الماضية", "0": "السنة الحالية", "1": "السنة القادمة"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال سنة واحدة", "two": "خلال سنتين", "few": "خلال {0} سنوات", "other": "خلال {0} سنة"},
+			Past:     map[string]string{"one": "قبل سنة واحدة", "two": "قبل سنتين", "few": "قبل {0} سنوات", "other": "قبل {0} سنة"},
+			Relative: map[string]string{"-1": "السنة الماضية", "0": "السنة الحالية", "1": "السنة القادمة"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ربع سنة واحد", "two": "خلال ربعي سنة", "few": "خلال {0} أرباع سنة", "other": "خلال {0} ربع سنة"},
+			Past:     map[string]string{"one": "قبل ربع سنة واحد", "two": "قبل ربعي سنة", "few": "قبل {0} أرباع سنة", "other": "قبل {0} ربع سنة"},
+			Relative: map[string]string{"-1": "الربع الأخير", "0": "هذا الربع", "1": "الربع القادم"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ربع سنة واحد", "two": "خلال ربعي سنة", "few": "خلال {0} أرباع سنة", "other": "خلال {0} ربع سنة"},
+			Past:     map[string]string{"one": "قبل ربع سنة واحد", "two": "قبل ربعي سنة", "few": "قبل {0} أرباع سنة", "other": "قبل {0} ربع سنة"},
+			Relative: map[string]string{"-1": "الربع الأخير", "0": "هذا الربع", "1": "الربع القادم"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ربع سنة واحد", "two": "خلال ربعي سنة", "few": "خلال {0} أرباع سنة", "other": "خلال {0} ربع سنة"},
+			Past:     map[string]string{"one": "قبل ربع سنة واحد", "two": "قبل ربعي سنة", "few": "قبل {0} أرباع سنة", "other": "قبل {0} ربع سنة"},
+			Relative: map[string]string{"-1": "الربع الأخير", "0": "هذا الربع", "1": "الربع القادم"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال شهر واحد", "two": "خلال شهرين", "few": "خلال {0} أشهر", "many": "خلال {0} شهرًا", "other": "خلال {0} شهر"},
+			Past:     map[string]string{"one": "قبل شهر واحد", "two": "قبل شهرين", "few": "قبل {0} أشهر", "many": "قبل {0} شهرًا", "other": "قبل {0} شهر"},
+			Relative: map[string]string{"-1": "الشهر الماضي", "0": "هذا الشهر", "1": "الشهر القادم"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال شهر واحد", "two": "خلال شهرين", "few": "خلال {0} أشهر", "many": "خلال {0} شهرًا", "other": "خلال {0} شهر"},
+			Past:     map[string]string{"one": "قبل شهر واحد", "two": "قبل شهرين", "few": "خلال {0} أشهر", "many": "قبل {0} شهرًا", "other": "قبل {0} شهر"},
+			Relative: map[string]string{"-1": "الشهر الماضي", "0": "هذا الشهر", "1": "الشهر القادم"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال شهر واحد", "two": "خلال شهرين", "few": "خلال {0} أشهر", "many": "خلال {0} شهرًا", "other": "خلال {0} شهر"},
+			Past:     map[string]string{"one": "قبل شهر واحد", "two": "قبل شهرين", "few": "قبل {0} أشهر", "many": "قبل {0} شهرًا", "other": "قبل {0} شهر"},
+			Relative: map[string]string{"-1": "الشهر الماضي", "0": "هذا الشهر", "1": "الشهر القادم"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال أسبوع واحد", "two": "خلال أسبوعين", "few": "خلال {0} أسابيع", "many": "خلال {0} أسبوعًا", "other": "خلال {0} أسبوع"},
+			Past:     map[string]string{"one": "قبل أسبوع واحد", "two": "قبل أسبوعين", "few": "قبل {0} أسابيع", "many": "قبل {0} أسبوعًا", "other": "قبل {0} أسبوع"},
+			Relative: map[string]string{"-1": "الأسبوع الماضي", "0": "هذا الأسبوع", "1": "الأسبوع القادم"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال أسبوع واحد", "two": "خلال {0} أسبوعين", "few": "خلال {0} أسابيع", "many": "خلال {0} أسبوعًا", "other": "خلال {0} أسبوع"},
+			Past:     map[string]string{"one": "قبل أسبوع واحد", "two": "قبل أسبوعين", "few": "قبل {0} أسابيع", "many": "قبل {0} أسبوعًا", "other": "قبل {0} أسبوع"},
+			Relative: map[string]string{"-1": "الأسبوع الماضي", "0": "هذا الأسبوع", "1": "الأسبوع القادم"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال أسبوع واحد", "two": "خلال أسبوعين", "few": "خلال {0} أسابيع", "many": "خلال {0} أسبوعًا", "other": "خلال {0} أسبوع"},
+			Past:     map[string]string{"one": "قبل أسبوع واحد", "two": "قبل أسبوعين", "few": "قبل {0} أسابيع", "many": "قبل {0} أسبوعًا", "other": "قبل {0} أسبوع"},
+			Relative: map[string]string{"-1": "الأسبوع الماضي", "0": "هذا الأسبوع", "1": "الأسبوع القادم"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال يوم واحد", "two": "خلال يومين", "few": "خلال {0} أيام", "many": "خلال {0} يومًا", "other": "خلال {0} يوم"},
+			Past:     map[string]string{"one": "قبل يوم واحد", "two": "قبل يومين", "few": "قبل {0} أيام", "many": "قبل {0} يومًا", "other": "قبل {0} يوم"},
+			Relative: map[string]string{"-2": "أول أمس", "-1": "أمس", "0": "اليوم", "1": "غدًا", "2": "بعد الغد"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال يوم واحد", "two": "خلال يومين", "few": "خلال {0} أيام", "many": "خلال {0} يومًا", "other": "خلال {0} يوم"},
+			Past:     map[string]string{"one": "قبل يوم واحد", "two": "قبل يومين", "few": "قبل {0} أيام", "many": "قبل {0} يومًا", "other": "قبل {0} يوم"},
+			Relative: map[string]string{"-2": "أول أمس", "-1": "أمس", "0": "اليوم", "1": "غدًا", "2": "بعد الغد"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال يوم واحد", "two": "خلال يومين", "few": "خلال {0} أيام", "many": "خلال {0} يومًا", "other": "خلال {0} يوم"},
+			Past:     map[string]string{"one": "قبل يوم واحد", "two": "قبل يومين", "few": "قبل {0} أيام", "many": "قبل {0} يومًا", "other": "قبل {0} يوم"},
+			Relative: map[string]string{"-2": "أول أمس", "-1": "أمس", "0": "اليوم", "1": "غدًا", "2": "بعد الغد"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ساعة واحدة", "two": "خلال ساعتين", "few": "خلال {0} ساعات", "other": "خلال {0} ساعة"},
+			Past:     map[string]string{"one": "قبل ساعة واحدة", "two": "قبل ساعتين", "few": "قبل {0} ساعات", "other": "قبل {0} ساعة"},
+			Relative: map[string]string{"0": "الساعة الحالية"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ساعة واحدة", "two": "خلال ساعتين", "few": "خلال {0} ساعات", "other": "خلال {0} ساعة"},
+			Past:     map[string]string{"one": "قبل ساعة واحدة", "two": "قبل ساعتين", "few": "قبل {0} ساعات", "other": "قبل {0} ساعة"},
+			Relative: map[string]string{"0": "الساعة الحالية"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ساعة واحدة", "two": "خلال ساعتين", "few": "خلال {0} ساعات", "other": "خلال {0} ساعة"},
+			Past:     map[string]string{"one": "قبل ساعة واحدة", "two": "قبل ساعتين", "few": "قبل {0} ساعات", "other": "قبل {0} ساعة"},
+			Relative: map[string]string{"0": "الساعة الحالية"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال دقيقة واحدة", "two": "خلال دقيقتين", "few": "خلال {0} دقائق", "other": "خلال {0} دقيقة"},
+			Past:     map[string]string{"one": "قبل دقيقة واحدة", "two": "قبل دقيقتين", "few": "قبل {0} دقائق", "other": "قبل {0} دقيقة"},
+			Relative: map[string]string{"0": "هذه الدقيقة"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال دقيقة واحدة", "two": "خلال دقيقتين", "few": "خلال {0} دقائق", "other": "خلال {0} دقيقة"},
+			Past:     map[string]string{"one": "قبل دقيقة واحدة", "two": "قبل دقيقتين", "few": "قبل {0} دقائق", "other": "قبل {0} دقيقة"},
+			Relative: map[string]string{"0": "هذه الدقيقة"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال دقيقة واحدة", "two": "خلال دقيقتين", "few": "خلال {0} دقائق", "other": "خلال {0} دقيقة"},
+			Past:     map[string]string{"one": "قبل دقيقة واحدة", "two": "قبل دقيقتين", "few": "قبل {0} دقائق", "other": "قبل {0} دقيقة"},
+			Relative: map[string]string{"0": "هذه الدقيقة"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ثانية واحدة", "two": "خلال ثانيتين", "few": "خلال {0} ثوانٍ", "other": "خلال {0} ثانية"},
+			Past:     map[string]string{"one": "قبل ثانية واحدة", "two": "قبل ثانيتين", "few": "قبل {0} ثوانِ", "other": "قبل {0} ثانية"},
+			Relative: map[string]string{"0": "الآن"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ثانية واحدة", "two": "خلال ثانيتين", "few": "خلال {0} ثوانٍ", "other": "خلال {0} ثانية"},
+			Past:     map[string]string{"one": "قبل ثانية واحدة", "two": "قبل ثانيتين", "few": "قبل {0} ثوانٍ", "other": "قبل {0} ثانية"},
+			Relative: map[string]string{"0": "الآن"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "خلال ثانية واحدة", "two": "خلال ثانيتين", "few": "خلال {0} ثوانٍ", "other": "خلال {0} ثانية"},
+			Past:     map[string]string{"one": "قبل ثانية واحدة", "two": "قبل ثانيتين", "few": "قبل {0} ثوانٍ", "other": "قبل {0} ثانية"},
+			Relative: map[string]string{"0": "الآن"},
+		},
+	},
+}
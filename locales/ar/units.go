@@ -0,0 +1,321 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "فدان", "other": "{0} فدان", "per": "{0} لكل فدان"},
+		Short:  map[string]string{"one": "فدان", "other": "{0} فدان", "per": "{0}/فدان"},
+		Narrow: map[string]string{"one": "فدان", "other": "{0} فدان", "per": "{0}/فدان"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} بت", "per": "{0} لكل بت"},
+		Short:  map[string]string{"other": "{0} بت", "per": "{0}/بت"},
+		Narrow: map[string]string{"other": "{0} بت", "per": "{0}/بت"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} بايت", "per": "{0} لكل بايت"},
+		Short:  map[string]string{"other": "{0} بايت", "per": "{0}/بايت"},
+		Narrow: map[string]string{"other": "{0} ب", "per": "{0}/ب"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} درجة مئوية", "per": "{0} لكل درجة مئوية"},
+		Short:  map[string]string{"other": "{0}°م", "per": "{0}/°م"},
+		Narrow: map[string]string{"other": "{0}°م", "per": "{0}/°م"},
+		Gender: "feminine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} سنتيمتر", "per": "{0}/سنتيمتر"},
+		Short:  map[string]string{"other": "{0} سم", "per": "{0}/سم"},
+		Narrow: map[string]string{"other": "{0} سم", "per": "{0}/سم"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "يوم", "two": "يومان", "few": "{0} أيام", "many": "{0} يومًا", "other": "{0} يوم", "per": "{0} في اليوم"},
+		Short:  map[string]string{"one": "يوم", "two": "يومان", "few": "{0} أيام", "many": "{0} يومًا", "other": "{0} يوم", "per": "{0}/ي"},
+		Narrow: map[string]string{"other": "{0} ي", "per": "{0}/ي"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "درجة", "two": "درجتان", "few": "{0} درجات", "other": "{0} درجة", "per": "{0} لكل درجة"},
+		Short:  map[string]string{"one": "درجة", "two": "درجتان", "few": "{0} درجات", "other": "{0} درجة", "per": "{0}/درجة"},
+		Narrow: map[string]string{"two": "درجتان", "few": "{0} درجات", "other": "{0} درجة", "per": "{0}/درجة"},
+		Gender: "feminine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} درجة فهرنهايت", "per": "{0} لكل درجة فهرنهايت"},
+		Short:  map[string]string{"other": "{0}°ف", "per": "{0}/°ف"},
+		Narrow: map[string]string{"other": "{0}°ف", "per": "{0}/°ف"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "أونصة سائلة", "two": "أونصتان سائلتان", "other": "{0} أونصة سائلة", "per": "{0} لكل أونصة سائلة"},
+		Short:  map[string]string{"one": "أونصة س", "two": "{0} أونصة س", "few": "{0} أونصات سائلة", "many": "{0} أونصة س", "other": "{0} أونصة سائلة", "per": "{0}/أونصة س"},
+		Narrow: map[string]string{"one": "أونصة س", "other": "{0} أونصة س", "per": "{0}/أونصة س"},
+	},
+	"foot": {
+		Long:   map[string]string{"one": "قدم", "other": "{0} قدم", "per": "{0} لكل قدم"},
+		Short:  map[string]string{"one": "قدم", "other": "{0} قدم", "per": "{0}/قدم"},
+		Narrow: map[string]string{"one": "قدم", "many": "{0} قدمًا", "other": "{0} قدم", "per": "{0}/قدم"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "غالون", "other": "{0} غالون", "per": "{0} لكل غالون"},
+		Short:  map[string]string{"one": "غالون", "other": "{0} غالون", "per": "{0}/غالون"},
+		Narrow: map[string]string{"one": "غالون", "other": "{0} غالون", "per": "{0}/غالون"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} غيغابت", "per": "{0} لكل غيغابت"},
+		Short:  map[string]string{"other": "{0} غيغابت", "per": "{0}/غيغابت"},
+		Narrow: map[string]string{"other": "{0} غ.بت", "per": "{0}/غ.بت"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} غيغابايت", "per": "{0} لكل غيغابايت"},
+		Short:  map[string]string{"other": "{0} غ.ب", "per": "{0}/غ.ب"},
+		Narrow: map[string]string{"other": "{0} غ.ب", "per": "{0}/غ.ب"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "غرام", "two": "غرامان", "few": "{0} غرامات", "many": "{0} غرامًا", "other": "{0} غرام", "per": "{0}/غرام"},
+		Short:  map[string]string{"one": "غرام", "other": "{0} غرام", "per": "{0}/غرام"},
+		Narrow: map[string]string{"other": "{0} غ", "per": "{0} غ"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} هكتار", "per": "{0} لكل هكتار"},
+		Short:  map[string]string{"other": "{0} هكتار", "per": "{0}/هكتار"},
+		Narrow: map[string]string{"other": "{0} هكتار", "per": "{0}/هكتار"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "ساعة", "two": "ساعتان", "few": "{0} ساعات", "other": "{0} ساعة", "per": "{0} في الساعة"},
+		Short:  map[string]string{"other": "{0} س", "per": "{0}/س"},
+		Narrow: map[string]string{"other": "{0} س", "per": "{0}/س"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} بوصة", "per": "{0}/بوصة"},
+		Short:  map[string]string{"other": "{0} بوصة", "per": "{0}/بوصة"},
+		Narrow: map[string]string{"other": "{0} بوصة", "per": "{0}/بوصة"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} كيلوبت", "per": "{0} لكل كيلوبت"},
+		Short:  map[string]string{"other": "{0} كيلوبت", "per": "{0}/كيلوبت"},
+		Narrow: map[string]string{"other": "{0} ك.بت", "per": "{0}/ك.بت"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} كيلوبايت", "per": "{0} لكل كيلوبايت"},
+		Short:  map[string]string{"other": "{0} كيلوبايت", "per": "{0}/كيلوبايت"},
+		Narrow: map[string]string{"other": "{0} ك.ب", "per": "{0}/ك.ب"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} كيلوغرام", "per": "{0}/كيلوغرام"},
+		Short:  map[string]string{"other": "{0} كغم", "per": "{0}/كغم"},
+		Narrow: map[string]string{"other": "{0} كغ", "per": "{0}/كغ"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"few": "{0} كيلومترات", "many": "{0} كيلومترًا", "other": "{0} كيلومتر", "per": "{0}/كيلومتر"},
+		Short:  map[string]string{"other": "{0} كم", "per": "{0}/كم"},
+		Narrow: map[string]string{"other": "{0} كم", "per": "{0}/كم"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} كيلومتر في الساعة"},
+		Short:  map[string]string{"other": "{0} كم/س"},
+		Narrow: map[string]string{"other": "{0} كم/س"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "لتر", "other": "{0} لتر", "per": "{0} لكل لتر"},
+		Short:  map[string]string{"one": "لتر", "other": "{0} لتر", "per": "{0}/ل"},
+		Narrow: map[string]string{"other": "{0} ل", "per": "{0}/ل"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"two": "لتران لكل كيلومتر", "few": "{0} لترات لكل كيلومتر", "many": "{0} لترًا لكل كيلومتر", "other": "{0} لتر لكل كيلومتر"},
+		Short:  map[string]string{"other": "{0} لتر/كم"},
+		Narrow: map[string]string{"other": "{0} ل/كم"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} ميغابت", "per": "{0} لكل ميغابت"},
+		Short:  map[string]string{"other": "{0} ميغابت", "per": "{0}/ميغابت"},
+		Narrow: map[string]string{"other": "{0} م.بت", "per": "{0}/م.بت"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} ميغابايت", "per": "{0} لكل ميغابايت"},
+		Short:  map[string]string{"other": "{0} م.ب", "per": "{0}/م.ب"},
+		Narrow: map[string]string{"other": "{0} م.ب", "per": "{0}/م.ب"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "متر", "few": "{0} أمتار", "many": "{0} مترًا", "other": "{0} متر", "per": "{0} لكل متر"},
+		Short:  map[string]string{"one": "متر", "two": "متران", "few": "{0} أمتار", "many": "{0} مترًا", "other": "{0} متر", "per": "{0}/م"},
+		Narrow: map[string]string{"other": "{0} م", "per": "{0}/م"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} متر في الثانية"},
+		Short:  map[string]string{"other": "{0} م/ث"},
+		Narrow: map[string]string{"other": "{0} م/ث"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} ميكروثانية", "per": "{0} لكل ميكروثانية"},
+		Short:  map[string]string{"other": "{0} م.ث.", "per": "{0}/م.ث."},
+		Narrow: map[string]string{"other": "{0} م.ث.", "per": "{0}/م.ث."},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "ميل", "two": "ميلان", "few": "{0} أميال", "many": "{0} ميلاً", "other": "{0} ميل", "per": "{0} لكل ميل"},
+		Short:  map[string]string{"one": "ميل", "other": "{0} ميل", "per": "{0}/ميل"},
+		Narrow: map[string]string{"few": "{0} أميال", "many": "{0} ميلاً", "other": "{0} ميل", "per": "{0}/ميل"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"two": "ميلان لكل غالون", "few": "{0} أميال لكل غالون", "many": "{0} ميلًا لكل غالون", "other": "{0} ميل لكل غالون"},
+		Short:  map[string]string{"other": "{0} ميل/غالون"},
+		Narrow: map[string]string{"other": "{0} ميل/غالون"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} ميل في الساعة"},
+		Short:  map[string]string{"other": "{0} ميل/س"},
+		Narrow: map[string]string{"other": "{0} ميل/س"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} ميل اسكندنافي", "per": "{0} لكل ميل اسكندنافي"},
+		Short:  map[string]string{"other": "{0} ميل اسكندنافي", "per": "{0}/ميل اسكندنافي"},
+		Narrow: map[string]string{"other": "{0} ميل اسكندنافي", "per": "{0}/ميل اسكندنافي"},
+		Gender: "masculine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} مليلتر", "per": "{0} لكل مليلتر"},
+		Short:  map[string]string{"other": "{0} ملتر", "per": "{0}/ملتر"},
+		Narrow: map[string]string{"other": "{0} ملتر", "per": "{0}/ملتر"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} مليمتر", "per": "{0} لكل مليمتر"},
+		Short:  map[string]string{"other": "{0} مم", "per": "{0}/مم"},
+		Narrow: map[string]string{"other": "{0} مم", "per": "{0}/مم"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} ملي ثانية", "per": "{0} لكل ملي ثانية"},
+		Short:  map[string]string{"other": "{0} ملي ث", "per": "{0}/ملي ث"},
+		Narrow: map[string]string{"other": "{0} ملي ث", "per": "{0}/ملي ث"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "دقيقة", "two": "دقيقتان", "few": "{0} دقائق", "other": "{0} دقيقة", "per": "{0} كل دقيقة"},
+		Short:  map[string]string{"other": "{0} د", "per": "{0}/د"},
+		Narrow: map[string]string{"other": "{0} د", "per": "{0}/د"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "شهر", "two": "شهران", "few": "{0} أشهر", "many": "{0} شهرًا", "other": "{0} شهر", "per": "{0} في الشهر"},
+		Short:  map[string]string{"one": "شهر", "two": "شهران", "few": "{0} أشهر", "many": "{0} شهرًا", "other": "{0} شهر", "per": "{0}/ش"},
+		Narrow: map[string]string{"one": "شهر", "two": "شهران", "few": "{0} أشهر", "many": "{0} شهرًا", "other": "{0} شهر", "per": "{0}/ش"},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} نانو ثانية", "per": "{0} لكل نانو ثانية"},
+		Short:  map[string]string{"other": "{0} ن.ث.", "per": "{0}/ن.ث."},
+		Narrow: map[string]string{"other": "{0} ن.ث.", "per": "{0}/ن.ث."},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} أونصة", "per": "{0}/أونصة"},
+		Short:  map[string]string{"one": "أونصة", "other": "{0} أونصة", "per": "{0}/أونصة"},
+		Narrow: map[string]string{"one": "أونصة", "other": "{0} أونصة", "per": "{0}/أونصة"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} لكل {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} بيتابايت", "per": "{0} لكل بيتابايت"},
+		Short:  map[string]string{"other": "{0} بيتابايت", "per": "{0}/بيتابايت"},
+		Narrow: map[string]string{"other": "{0} بيتابايت", "per": "{0}/بيتابايت"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"two": "رطلان", "many": "{0} رطلًا", "other": "{0} رطل", "per": "{0}/رطل"},
+		Short:  map[string]string{"other": "{0} رطل", "per": "{0}/رطل"},
+		Narrow: map[string]string{"other": "{0} رطل", "per": "{0}/رطل"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "ثانية", "two": "ثانيتان", "few": "{0} ثوان", "other": "{0} ثانية"},
+		Short:  map[string]string{"other": "{0} ث"},
+		Narrow: map[string]string{"other": "{0} ث", "per": "{0}/ث"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} سنتيمتر مربع", "per": "{0}/سنتيمتر مربع"},
+		Short:  map[string]string{"other": "{0} سم²", "per": "{0}/سم²"},
+		Narrow: map[string]string{"other": "{0} سم²", "per": "{0}/سم²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "قدم مربعة", "other": "{0} قدم مربعة"},
+		Short:  map[string]string{"other": "{0} قدم²"},
+		Narrow: map[string]string{"other": "{0} قدم²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} كيلومتر مربع", "per": "{0}/كيلومتر مربع"},
+		Short:  map[string]string{"other": "{0} كم²", "per": "{0}/كم²"},
+		Narrow: map[string]string{"other": "{0} كم²", "per": "{0}/كم²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} متر مربع", "per": "{0} لكل متر مربع"},
+		Short:  map[string]string{"other": "{0} م²", "per": "{0}/م²"},
+		Narrow: map[string]string{"other": "{0} م²", "per": "{0}/م²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} ميل مربع", "per": "{0} لكل ميل مربع"},
+		Short:  map[string]string{"other": "{0} ميل²", "per": "{0}/ميل²"},
+		Narrow: map[string]string{"other": "{0} ميل²", "per": "{0}/ميل²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} ستون", "per": "{0} لكل ستون"},
+		Short:  map[string]string{"other": "{0} ستون", "per": "{0}/ستون"},
+		Narrow: map[string]string{"other": "{0} ستون", "per": "{0}/ستون"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} تيرابت", "per": "{0} لكل تيرابت"},
+		Short:  map[string]string{"other": "{0} تيرابت", "per": "{0}/تيرابت"},
+		Narrow: map[string]string{"other": "{0} ت.بت", "per": "{0}/ت.بت"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} تيرابايت", "per": "{0} لكل تيرابايت"},
+		Short:  map[string]string{"other": "{0} تيرابايت", "per": "{0}/تيرابايت"},
+		Narrow: map[string]string{"other": "{0} ت.ب", "per": "{0}/ت.ب"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "أسبوع", "two": "أسبوعان", "few": "{0} أسابيع", "many": "{0} أسبوعًا", "other": "{0} أسبوع", "per": "{0} في الأسبوع"},
+		Short:  map[string]string{"one": "أسبوع", "two": "أسبوعان", "few": "{0} أسابيع", "many": "{0} أسبوعًا", "other": "{0} أسبوع", "per": "{0}/أ"},
+		Narrow: map[string]string{"other": "{0} أ", "per": "{0}/أ"},
+		Gender: "masculine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "ياردة", "other": "{0} ياردة", "per": "{0} لكل ياردة"},
+		Short:  map[string]string{"one": "ياردة", "other": "{0} ياردة", "per": "{0}/ياردة"},
+		Narrow: map[string]string{"other": "{0} ياردة", "per": "{0}/ياردة"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "سنة", "two": "سنتان", "few": "{0} سنوات", "other": "{0} سنة", "per": "{0} في السنة"},
+		Short:  map[string]string{"one": "سنة واحدة", "two": "سنتان", "few": "{0} سنوات", "other": "{0} سنة", "per": "{0}/سنة"},
+		Narrow: map[string]string{"other": "{0} سنة", "per": "{0}/سنة"},
+		Gender: "feminine",
+	},
+}
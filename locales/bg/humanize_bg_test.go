@@ -146,3 +146,34 @@ func TestHumanizeBgOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeBgOrdinal(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "1-ви"},
+		{"2", "2-ри"},
+		{"3", "3-ти"},
+		{"4", "4-ти"},
+		{"11", "11-и"},
+		{"20", "20-и"},
+		{"21", "21-ви"},
+		{"100", "100-тен"},
+		{"101", "101-и"},
+		{"1200", "1\u00a0200-тен"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatOrdinal(tt.number, language.Bulgarian, hc.Options{})
+		if err != nil {
+			t.Errorf("[ORDINAL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[ORDINAL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package locale
 
 import (
+	"strconv"
+
 	hc "github.com/dejurin/humanizecompact"
 	"github.com/govalues/decimal"
 	"golang.org/x/text/language"
@@ -33,6 +35,43 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
+// OrdinalSuffix follows the CLDR digits-ordinal-masculine rules: the suffix
+// is chosen by the last three digits, e.g. "1-ви", "2-ри", "4-ти",
+// "11-и", "21-ви", "100-тен", "101-и" and "1200-тен".
+func (l Locale) OrdinalSuffix(n decimal.Decimal) string {
+	digits := n.Trunc(0).String()
+	if len(digits) > 3 {
+		digits = digits[len(digits)-3:]
+	}
+	last, err := strconv.Atoi(digits)
+	if err != nil {
+		return "и"
+	}
+
+	switch {
+	case last >= 100 && last%100 == 0:
+		return "тен"
+	case last >= 100:
+		return "и"
+	case last >= 20:
+		last %= 10
+	}
+	switch {
+	case last == 1:
+		return "ви"
+	case last == 2:
+		return "ри"
+	case last == 3, last == 4:
+		return "ти"
+	}
+	return "и"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Bulgarian,
 	data: hc.CldrData{
@@ -92,5 +131,79 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 трлн.",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤;(#,##0.00 ¤)",
+			Short: map[string]string{
+				"1000-count-one":              "0 хил. ¤",
+				"1000-count-other":            "0 хил. ¤",
+				"10000-count-one":             "00 хил. ¤",
+				"10000-count-other":           "00 хил. ¤",
+				"100000-count-one":            "000 хил. ¤",
+				"100000-count-other":          "000 хил. ¤",
+				"1000000-count-one":           "0 млн. ¤",
+				"1000000-count-other":         "0 млн. ¤",
+				"10000000-count-one":          "00 млн. ¤",
+				"10000000-count-other":        "00 млн. ¤",
+				"100000000-count-one":         "000 млн. ¤",
+				"100000000-count-other":       "000 млн. ¤",
+				"1000000000-count-one":        "0 млрд. ¤",
+				"1000000000-count-other":      "0 млрд. ¤",
+				"10000000000-count-one":       "00 млрд. ¤",
+				"10000000000-count-other":     "00 млрд. ¤",
+				"100000000000-count-one":      "000 млрд. ¤",
+				"100000000000-count-other":    "000 млрд. ¤",
+				"1000000000000-count-one":     "0 трлн. ¤",
+				"1000000000000-count-other":   "0 трлн. ¤",
+				"10000000000000-count-one":    "00 трлн. ¤",
+				"10000000000000-count-other":  "00 трлн. ¤",
+				"100000000000000-count-one":   "000 трлн. ¤",
+				"100000000000000-count-other": "000 трлн. ¤",
+			},
+			Symbols: map[string]string{
+				"BGN": "лв.",
+				"EUR": "€",
+				"USD": "щ.д.",
+			},
+		},
+		PercentFormat:         "#,##0%",
+		MinimumGroupingDigits: 2,
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} и {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} и {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} и {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} и {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0} – {1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥ {0}",
+		},
+		PluralRanges: map[string]string{
+			"other-one": "other",
+		},
 	},
 }
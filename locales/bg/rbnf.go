@@ -0,0 +1,190 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets and the digits-ordinal rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-neuter=;
+%spellout-cardinal-masculine:
+0: нула;
+1: един;
+2: два;
+3: три;
+4: четири;
+5: пет;
+6: шест;
+7: седем;
+8: осем;
+9: девет;
+10: десет;
+11: единайсет;
+12: дванайсет;
+13: >%spellout-cardinal-masculine>найсет;
+20: <%spellout-cardinal-masculine<йсет;
+21: <%spellout-cardinal-masculine<йсет и >%spellout-cardinal-masculine>;
+40: четиресет;
+41: четиресет и >%spellout-cardinal-masculine>;
+50: <%spellout-cardinal-masculine<десет;
+51: <%spellout-cardinal-masculine<десет и >%spellout-cardinal-masculine>;
+60: шейсет;
+61: шейсет и >%spellout-cardinal-masculine>;
+70: <%spellout-cardinal-masculine<десет;
+71: <%spellout-cardinal-masculine<десет и >%spellout-cardinal-masculine>;
+100: сто;
+101: сто >%%spellout-cardinal-masculine-and>;
+200: двеста;
+201: двеста >%%spellout-cardinal-masculine-and>;
+300: триста;
+301: триста >%%spellout-cardinal-masculine-and>;
+400: <%spellout-cardinal-masculine<стотин;
+401: <%spellout-cardinal-masculine<стотин >%%spellout-cardinal-masculine-and>;
+1000: хиляда;
+1001: хиляда >%%spellout-cardinal-masculine-and>;
+2000: <%spellout-cardinal-feminine< хиляди;
+2001: <%spellout-cardinal-feminine< хиляди >%%spellout-cardinal-masculine-and>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$ >%%spellout-cardinal-masculine-and>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$ >%%spellout-cardinal-masculine-and>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$ >%%spellout-cardinal-masculine-and>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$ >%%spellout-cardinal-masculine-and>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< цяло и >%spellout-cardinal-masculine>;
+%%spellout-cardinal-masculine-and:
+0: и =%spellout-cardinal-masculine=;
+10: =%spellout-cardinal-masculine=;
+%spellout-cardinal-feminine:
+0: нула;
+1: една;
+2: две;
+3: =%spellout-cardinal-masculine=;
+20: <%spellout-cardinal-masculine<йсет;
+21: <%spellout-cardinal-masculine<йсет и >%spellout-cardinal-feminine>;
+40: четиресет;
+41: четиресет и >%spellout-cardinal-feminine>;
+50: <%spellout-cardinal-masculine<десет;
+51: <%spellout-cardinal-masculine<десет и >%spellout-cardinal-feminine>;
+60: шейсет;
+61: шейсет и >%spellout-cardinal-feminine>;
+70: <%spellout-cardinal-masculine<десет;
+71: <%spellout-cardinal-masculine<десет и >%spellout-cardinal-feminine>;
+100: сто;
+101: сто >%%spellout-cardinal-feminine-and>;
+200: двеста;
+201: двеста >%%spellout-cardinal-feminine-and>;
+300: триста;
+301: триста >%%spellout-cardinal-feminine-and>;
+400: <%spellout-cardinal-feminine<стотин;
+401: <%spellout-cardinal-feminine<стотин >%%spellout-cardinal-feminine-and>;
+1000: хиляда;
+1001: хиляда >%%spellout-cardinal-feminine-and>;
+2000: <%spellout-cardinal-feminine< хиляди;
+2001: <%spellout-cardinal-feminine< хиляди >%%spellout-cardinal-feminine-and>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$ >%%spellout-cardinal-feminine-and>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$ >%%spellout-cardinal-feminine-and>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$ >%%spellout-cardinal-feminine-and>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$ >%%spellout-cardinal-feminine-and>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< цяло и >%spellout-cardinal-feminine>;
+%%spellout-cardinal-feminine-and:
+0: и =%spellout-cardinal-feminine=;
+10: =%spellout-cardinal-feminine=;
+%spellout-cardinal-neuter:
+0: нула;
+1: едно;
+2: две;
+3: =%spellout-cardinal-masculine=;
+20: <%spellout-cardinal-masculine<йсет;
+21: <%spellout-cardinal-masculine<йсет и >%spellout-cardinal-neuter>;
+40: четиресет;
+41: четиресет и >%spellout-cardinal-neuter>;
+50: <%spellout-cardinal-masculine<десет;
+51: <%spellout-cardinal-masculine<десет и >%spellout-cardinal-neuter>;
+60: шейсет;
+61: шейсет и >%spellout-cardinal-neuter>;
+70: <%spellout-cardinal-masculine<десет;
+71: <%spellout-cardinal-masculine<десет и >%spellout-cardinal-neuter>;
+100: сто;
+101: сто >%%spellout-cardinal-neuter-and>;
+200: двеста;
+201: двеста >%%spellout-cardinal-neuter-and>;
+300: триста;
+301: триста >%%spellout-cardinal-neuter-and>;
+400: <%spellout-cardinal-neuter<стотин;
+401: <%spellout-cardinal-neuter<стотин >%%spellout-cardinal-neuter-and>;
+1000: хиляда;
+1001: хиляда >%%spellout-cardinal-neuter-and>;
+2000: <%spellout-cardinal-feminine< хиляди;
+2001: <%spellout-cardinal-feminine< хиляди >%%spellout-cardinal-neuter-and>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{милион}other{милиона})$ >%%spellout-cardinal-neuter-and>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{милиард}other{милиарда})$ >%%spellout-cardinal-neuter-and>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{трилион}other{трилиона})$ >%%spellout-cardinal-neuter-and>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадрилион}other{квадрилиона})$ >%%spellout-cardinal-neuter-and>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-neuter< цяло и >%spellout-cardinal-neuter>;
+%%spellout-cardinal-neuter-and:
+0: и =%spellout-cardinal-neuter=;
+10: =%spellout-cardinal-neuter=;
+%%digits-ordinal-masculine-larger-suffix:
+0: тен;
+1: >%%digits-ordinal-masculine-suffix>;
+100: >%%digits-ordinal-masculine-larger-suffix>;
+%%digits-ordinal-masculine-suffix:
+0: и;
+1: ви;
+2: ри;
+3: ти;
+5: и;
+20: >%%digits-ordinal-masculine-suffix>;
+100: >%%digits-ordinal-masculine-larger-suffix>;
+1000: >%%digits-ordinal-masculine-suffix>;
+%digits-ordinal-masculine:
+0: =#,##0=-=%%digits-ordinal-masculine-suffix=;
+-x: −>%digits-ordinal-masculine>;
+%%digits-ordinal-feminine-larger-suffix:
+0: тна;
+1: >%%digits-ordinal-feminine-suffix>;
+100: >%%digits-ordinal-feminine-larger-suffix>;
+%%digits-ordinal-feminine-suffix:
+0: а;
+1: ва;
+2: ра;
+3: та;
+5: а;
+20: >%%digits-ordinal-feminine-suffix>;
+100: >%%digits-ordinal-feminine-larger-suffix>;
+1000: >%%digits-ordinal-feminine-suffix>;
+%digits-ordinal-feminine:
+0: =#,##0=-=%%digits-ordinal-feminine-suffix=;
+-x: −>%digits-ordinal-feminine>;
+%%digits-ordinal-neuter-larger-suffix:
+0: тно;
+1: >%%digits-ordinal-neuter-suffix>;
+100: >%%digits-ordinal-neuter-larger-suffix>;
+%%digits-ordinal-neuter-suffix:
+0: o;
+1: вo;
+2: рo;
+3: тo;
+5: o;
+20: >%%digits-ordinal-neuter-suffix>;
+100: >%%digits-ordinal-neuter-larger-suffix>;
+1000: >%%digits-ordinal-neuter-suffix>;
+%digits-ordinal-neuter:
+0: =#,##0=-=%%digits-ordinal-neuter-suffix=;
+-x: −>%digits-ordinal-neuter>;
+%digits-ordinal:
+0: =%digits-ordinal-masculine=;
+`
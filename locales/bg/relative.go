@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} година", "other": "след {0} години"},
+			Past:     map[string]string{"one": "преди {0} година", "other": "преди {0} години"},
+			Relative: map[string]string{"-1": "миналата година", "0": "тази година", "1": "следващата година"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} г."},
+			Past:     map[string]string{"other": "преди {0} г."},
+			Relative: map[string]string{"-1": "мин. г.", "0": "т. г.", "1": "следв. г."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} г."},
+			Past:     map[string]string{"other": "пр. {0} г."},
+			Relative: map[string]string{"-1": "мин. г.", "0": "т. г.", "1": "сл. г."},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} тримесечие", "other": "след {0} тримесечия"},
+			Past:     map[string]string{"one": "преди {0} тримесечие", "other": "преди {0} тримесечия"},
+			Relative: map[string]string{"-1": "предходно тримесечие", "0": "това тримесечие", "1": "следващо тримесечие"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} трим."},
+			Past:     map[string]string{"other": "преди {0} трим."},
+			Relative: map[string]string{"-1": "мин. трим.", "0": "това трим.", "1": "следв. трим."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} трим."},
+			Past:     map[string]string{"other": "пр. {0} трим."},
+			Relative: map[string]string{"-1": "мин. трим.", "0": "това трим.", "1": "следв. трим."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} месец", "other": "след {0} месеца"},
+			Past:     map[string]string{"one": "преди {0} месец", "other": "преди {0} месеца"},
+			Relative: map[string]string{"-1": "предходен месец", "0": "този месец", "1": "следващ месец"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} м."},
+			Past:     map[string]string{"other": "преди {0} м."},
+			Relative: map[string]string{"-1": "мин. мес.", "0": "този мес.", "1": "следв. мес."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} м."},
+			Past:     map[string]string{"other": "пр. {0} м."},
+			Relative: map[string]string{"-1": "мин. м.", "0": "т. м.", "1": "сл. м."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} седмица", "other": "след {0} седмици"},
+			Past:     map[string]string{"one": "преди {0} седмица", "other": "преди {0} седмици"},
+			Relative: map[string]string{"-1": "предходната седмица", "0": "тази седмица", "1": "следващата седмица"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} седм."},
+			Past:     map[string]string{"other": "преди {0} седм."},
+			Relative: map[string]string{"-1": "мин. седм.", "0": "тази седм.", "1": "следв. седм."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} седм."},
+			Past:     map[string]string{"other": "пр. {0} седм."},
+			Relative: map[string]string{"-1": "мин. седм.", "0": "тази седм.", "1": "сл. седм."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} ден", "other": "след {0} дни"},
+			Past:     map[string]string{"one": "преди {0} ден", "other": "преди {0} дни"},
+			Relative: map[string]string{"-2": "онзи ден", "-1": "вчера", "0": "днес", "1": "утре", "2": "вдругиден"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} ден", "other": "след {0} дни"},
+			Past:     map[string]string{"one": "преди {0} ден", "other": "преди {0} дни"},
+			Relative: map[string]string{"-2": "онзи ден", "-1": "вчера", "0": "днес", "1": "утре", "2": "вдругиден"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} д"},
+			Past:     map[string]string{"other": "пр. {0} д"},
+			Relative: map[string]string{"-2": "онзи ден", "-1": "вчера", "0": "днес", "1": "утре", "2": "вдругиден"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} час", "other": "след {0} часа"},
+			Past:     map[string]string{"one": "преди {0} час", "other": "преди {0} часа"},
+			Relative: map[string]string{"0": "в този час"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} ч"},
+			Past:     map[string]string{"other": "преди {0} ч"},
+			Relative: map[string]string{"0": "в този час"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} ч"},
+			Past:     map[string]string{"other": "пр. {0} ч"},
+			Relative: map[string]string{"0": "в този час"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} минута", "other": "след {0} минути"},
+			Past:     map[string]string{"one": "преди {0} минута", "other": "преди {0} минути"},
+			Relative: map[string]string{"0": "в тази минута"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} мин"},
+			Past:     map[string]string{"other": "преди {0} мин"},
+			Relative: map[string]string{"0": "в тази минута"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} мин"},
+			Past:     map[string]string{"other": "пр. {0} мин"},
+			Relative: map[string]string{"0": "в тази минута"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "след {0} секунда", "other": "след {0} секунди"},
+			Past:     map[string]string{"one": "преди {0} секунда", "other": "преди {0} секунди"},
+			Relative: map[string]string{"0": "сега"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "след {0} сек"},
+			Past:     map[string]string{"other": "преди {0} сек"},
+			Relative: map[string]string{"0": "сега"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "сл. {0} сек"},
+			Past:     map[string]string{"other": "пр. {0} сек"},
+			Relative: map[string]string{"0": "сега"},
+		},
+	},
+}
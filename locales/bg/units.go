@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} акър", "other": "{0} акра", "per": "{0} на акър"},
+		Short:  map[string]string{"one": "{0} акър", "other": "{0} акра", "per": "{0}/акър"},
+		Narrow: map[string]string{"one": "{0} акър", "other": "{0} акра", "per": "{0}/акър"},
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} бит", "other": "{0} бита", "per": "{0} на бит"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0} b", "per": "{0}/b"},
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} байт", "other": "{0} байта", "per": "{0} на байт"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} градус Целзий", "other": "{0} градуса Целзий", "per": "{0} на градус Целзий"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} сантиметър", "other": "{0} сантиметра", "per": "{0} на сантиметър"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} ден", "other": "{0} дни", "per": "{0} на ден"},
+		Short:  map[string]string{"other": "{0} д", "per": "{0}/д"},
+		Narrow: map[string]string{"other": "{0} д", "per": "{0}/д"},
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} градус", "other": "{0} градуса", "per": "{0} на градус"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} градус по Фаренхайт", "other": "{0} градуса по Фаренхайт", "per": "{0} на градус по Фаренхайт"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} течна унция", "other": "{0} течни унции", "per": "{0} на течна унция"},
+		Short:  map[string]string{"other": "{0} fl oz US", "per": "{0}/fl oz US"},
+		Narrow: map[string]string{"other": "{0} fl oz US", "per": "{0}/fl oz US"},
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} фут", "other": "{0} фута", "per": "{0} на фут"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} галон", "other": "{0} галона", "per": "{0} на галон"},
+		Short:  map[string]string{"other": "{0} gal US", "per": "{0}/gal US"},
+		Narrow: map[string]string{"other": "{0} gal US", "per": "{0}/gal US"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} гигабит", "other": "{0} гигабита", "per": "{0} на гигабит"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} гигабайт", "other": "{0} гигабайта", "per": "{0} на гигабайт"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} грам", "other": "{0} грама", "per": "{0} на грам"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} хектар", "other": "{0} хектара", "per": "{0} на хектар"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} час", "other": "{0} часа", "per": "{0} за час"},
+		Short:  map[string]string{"other": "{0} ч", "per": "{0}/ч"},
+		Narrow: map[string]string{"other": "{0} ч", "per": "{0}/ч"},
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} инч", "other": "{0} инча", "per": "{0} на инч"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}\"", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} килобит", "other": "{0} килобита", "per": "{0} на килобит"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} килобайт", "other": "{0} килобайта", "per": "{0} на килобайт"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} килограм", "other": "{0} килограма", "per": "{0} на килограм"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} километър", "other": "{0} километра", "per": "{0} на километър"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} километър в час", "other": "{0} километра в час"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0} km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} литър", "other": "{0} литра", "per": "{0} на литър"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} литър на километър", "other": "{0} литра на километър"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} мегабит", "other": "{0} мегабита", "per": "{0} на мегабит"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} мегабайт", "other": "{0} мегабайта", "per": "{0} на мегабайт"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} метър", "other": "{0} метра", "per": "{0} на метър"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} метър за секунда", "other": "{0} метра за секунда"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} микросекунда", "other": "{0} микросекунди", "per": "{0} на микросекунда"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} миля", "other": "{0} мили", "per": "{0} на миля"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} миля на галон", "other": "{0} мили на галон"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} миля в час", "other": "{0} мили в час"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"one": "{0} миля/ч", "other": "{0} мили/ч"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} шведска миля", "other": "{0} шведски мили", "per": "{0} на шведска миля"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} милилитър", "other": "{0} милилитра", "per": "{0} на милилитър"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} милиметър", "other": "{0} милиметра", "per": "{0} на милиметър"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} милисекунда", "other": "{0} милисекунди", "per": "{0} на милисекунда"},
+		Short:  map[string]string{"other": "{0} мсек", "per": "{0}/мсек"},
+		Narrow: map[string]string{"other": "{0} мсек", "per": "{0}/мсек"},
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} минута", "other": "{0} минути", "per": "{0} на минута"},
+		Short:  map[string]string{"other": "{0} мин", "per": "{0}/мин"},
+		Narrow: map[string]string{"other": "{0} мин", "per": "{0}/мин"},
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} месец", "other": "{0} месеца", "per": "{0} на месец"},
+		Short:  map[string]string{"other": "{0} мес.", "per": "{0}/месец"},
+		Narrow: map[string]string{"other": "{0} мес.", "per": "{0}/мес."},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} наносекунда", "other": "{0} наносекунди", "per": "{0} на наносекунда"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} унция", "other": "{0} унции", "per": "{0} на унция"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} на {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} петабайт", "other": "{0} петабайта", "per": "{0} на петабайт"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} фунт", "other": "{0} фунта", "per": "{0} на фунт"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} секунда", "other": "{0} секунди", "per": "{0} за секунда"},
+		Short:  map[string]string{"other": "{0} сек", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} с", "per": "{0}/s"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} квадратен сантиметър", "other": "{0} квадратни сантиметра", "per": "{0} на квадратен сантиметър"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} квадратен фут", "other": "{0} квадратни фута"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"one": "{0} кв. фут", "other": "{0} кв. фута"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} квадратен километър", "other": "{0} квадратни километра", "per": "{0} на квадратен километър"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} квадратен метър", "other": "{0} квадратни метра", "per": "{0} на квадратен метър"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} квадратна миля", "other": "{0} квадратни мили", "per": "{0} на квадратна миля"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"one": "{0} кв. миля", "other": "{0} кв. мили", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} стоун", "other": "{0} стоуна", "per": "{0} на стоун"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} терабит", "other": "{0} терабита", "per": "{0} на терабит"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} терабайт", "other": "{0} терабайта", "per": "{0} на терабайт"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} седмица", "other": "{0} седмици", "per": "{0} на седмица"},
+		Short:  map[string]string{"other": "{0} седм.", "per": "{0}/седмица"},
+		Narrow: map[string]string{"other": "{0} седм.", "per": "{0}/седм."},
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} ярд", "other": "{0} ярда", "per": "{0} на ярд"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} година", "other": "{0} години", "per": "{0} на година"},
+		Short:  map[string]string{"other": "{0} год.", "per": "{0}/год."},
+		Narrow: map[string]string{"other": "{0} г.", "per": "{0}/год."},
+	},
+}
@@ -43,6 +43,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Czech,
 	data: hc.CldrData{
@@ -127,5 +132,84 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 bil.",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 tis. ¤",
+				"1000-count-other":            "0 tis. ¤",
+				"10000-count-one":             "00 tis. ¤",
+				"10000-count-other":           "00 tis. ¤",
+				"100000-count-one":            "000 tis. ¤",
+				"100000-count-other":          "000 tis. ¤",
+				"1000000-count-one":           "0 mil. ¤",
+				"1000000-count-other":         "0 mil. ¤",
+				"10000000-count-one":          "00 mil. ¤",
+				"10000000-count-other":        "00 mil. ¤",
+				"100000000-count-one":         "000 mil. ¤",
+				"100000000-count-other":       "000 mil. ¤",
+				"1000000000-count-one":        "0 mld. ¤",
+				"1000000000-count-other":      "0 mld. ¤",
+				"10000000000-count-one":       "00 mld. ¤",
+				"10000000000-count-other":     "00 mld. ¤",
+				"100000000000-count-one":      "000 mld. ¤",
+				"100000000000-count-other":    "000 mld. ¤",
+				"1000000000000-count-one":     "0 bil. ¤",
+				"1000000000000-count-other":   "0 bil. ¤",
+				"10000000000000-count-one":    "00 bil. ¤",
+				"10000000000000-count-other":  "00 bil. ¤",
+				"100000000000000-count-one":   "000 bil. ¤",
+				"100000000000000-count-other": "000 bil. ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"CZK": "Kč",
+				"EUR": "€",
+				"GBP": "£",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"USD": "US$",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} a {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} a {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} a {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥{0}",
+		},
 	},
 }
@@ -0,0 +1,197 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: nula;
+1: jeden;
+2: dva;
+3: tři;
+4: čtyři;
+5: pět;
+6: šest;
+7: sedm;
+8: osm;
+9: devět;
+10: deset;
+11: jedenáct;
+12: dvanáct;
+13: třináct;
+14: čtrnáct;
+15: patnáct;
+16: šestnáct;
+17: sedmnáct;
+18: osmnáct;
+19: devatenáct;
+20: <%spellout-cardinal-masculine<cet;
+21: <%spellout-cardinal-masculine<cet >%spellout-cardinal-masculine>;
+50: padesát;
+51: padesát >%spellout-cardinal-masculine>;
+60: šedesát;
+61: šedesát >%spellout-cardinal-masculine>;
+70: sedmdesát;
+71: sedmdesát >%spellout-cardinal-masculine>;
+80: osmdesát;
+81: osmdesát >%spellout-cardinal-masculine>;
+90: devadesát;
+91: devadesát >%spellout-cardinal-masculine>;
+100: sto;
+101: sto >%spellout-cardinal-masculine>;
+200: <%spellout-cardinal-feminine< stě;
+201: <%spellout-cardinal-feminine< stě >%spellout-cardinal-masculine>;
+300: <%spellout-cardinal-feminine< sta;
+301: <%spellout-cardinal-feminine< sta >%spellout-cardinal-masculine>;
+500: <%spellout-cardinal-feminine< set;
+501: <%spellout-cardinal-feminine< set >%spellout-cardinal-masculine>;
+1000: <%spellout-cardinal-feminine< tisíc;
+1001: <%spellout-cardinal-feminine< tisíc >%spellout-cardinal-masculine>;
+2000: <%spellout-cardinal-feminine< tisíce;
+2001: <%spellout-cardinal-feminine< tisíce >%spellout-cardinal-masculine>;
+5000: <%spellout-cardinal-feminine< tisíc;
+5001: <%spellout-cardinal-feminine< tisíc >%spellout-cardinal-masculine>;
+1000000: <%spellout-cardinal-masculine< milión;
+1000001: <%spellout-cardinal-masculine< milión >%spellout-cardinal-masculine>;
+2000000: <%spellout-cardinal-masculine< milióny;
+2000001: <%spellout-cardinal-masculine< milióny >%spellout-cardinal-masculine>;
+5000000: <%spellout-cardinal-masculine< miliónů;
+5000001: <%spellout-cardinal-masculine< miliónů >%spellout-cardinal-masculine>;
+1000000000: <%spellout-cardinal-masculine< miliarda;
+1000000001: <%spellout-cardinal-masculine< miliarda >%spellout-cardinal-masculine>;
+2000000000: <%spellout-cardinal-masculine< miliardy;
+2000000001: <%spellout-cardinal-masculine< miliardy >%spellout-cardinal-masculine>;
+5000000000: <%spellout-cardinal-masculine< miliardů;
+5000000001: <%spellout-cardinal-masculine< miliardů >%spellout-cardinal-masculine>;
+1000000000000: <%spellout-cardinal-masculine< bilión;
+1000000000001: <%spellout-cardinal-masculine< bilión >%spellout-cardinal-masculine>;
+2000000000000: <%spellout-cardinal-masculine< bilióny;
+2000000000001: <%spellout-cardinal-masculine< bilióny >%spellout-cardinal-masculine>;
+5000000000000: <%spellout-cardinal-masculine< biliónů;
+5000000000001: <%spellout-cardinal-masculine< biliónů >%spellout-cardinal-masculine>;
+1000000000000000: <%spellout-cardinal-masculine< biliarda;
+1000000000000001: <%spellout-cardinal-masculine< biliarda >%spellout-cardinal-masculine>;
+2000000000000000: <%spellout-cardinal-masculine< biliardy;
+2000000000000001: <%spellout-cardinal-masculine< biliardy >%spellout-cardinal-masculine>;
+5000000000000000: <%spellout-cardinal-masculine< biliardů;
+5000000000000001: <%spellout-cardinal-masculine< biliardů >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< čárka >%spellout-cardinal-masculine>;
+%spellout-cardinal-neuter:
+0: nula;
+1: jedno;
+2: dvě;
+3: =%spellout-cardinal-masculine=;
+20: <%spellout-cardinal-masculine<cet;
+21: <%spellout-cardinal-masculine<cet >%spellout-cardinal-neuter>;
+50: padesát;
+51: padesát >%spellout-cardinal-neuter>;
+60: šedesát;
+61: šedesát >%spellout-cardinal-neuter>;
+70: sedmdesát;
+71: sedmdesát >%spellout-cardinal-neuter>;
+80: osmdesát;
+81: osmdesát >%spellout-cardinal-neuter>;
+90: devadesát;
+91: devadesát >%spellout-cardinal-neuter>;
+100: sto;
+101: sto >%spellout-cardinal-neuter>;
+200: <%spellout-cardinal-feminine< stě;
+201: <%spellout-cardinal-feminine< stě >%spellout-cardinal-neuter>;
+300: <%spellout-cardinal-feminine< sta;
+301: <%spellout-cardinal-feminine< sta >%spellout-cardinal-neuter>;
+500: <%spellout-cardinal-feminine< set;
+501: <%spellout-cardinal-feminine< set >%spellout-cardinal-neuter>;
+1000: <%spellout-cardinal-feminine< tisíc;
+1001: <%spellout-cardinal-feminine< tisíc >%spellout-cardinal-neuter>;
+2000: <%spellout-cardinal-feminine< tisíce;
+2001: <%spellout-cardinal-feminine< tisíce >%spellout-cardinal-neuter>;
+5000: <%spellout-cardinal-feminine< tisíc;
+5001: <%spellout-cardinal-feminine< tisíc >%spellout-cardinal-neuter>;
+1000000: <%spellout-cardinal-masculine< milión;
+1000001: <%spellout-cardinal-masculine< milión >%spellout-cardinal-neuter>;
+2000000: <%spellout-cardinal-masculine< milióny;
+2000001: <%spellout-cardinal-masculine< milióny >%spellout-cardinal-neuter>;
+5000000: <%spellout-cardinal-masculine< miliónů;
+5000001: <%spellout-cardinal-masculine< miliónů >%spellout-cardinal-neuter>;
+1000000000: <%spellout-cardinal-masculine< miliarda;
+1000000001: <%spellout-cardinal-masculine< miliarda >%spellout-cardinal-neuter>;
+2000000000: <%spellout-cardinal-masculine< miliardy;
+2000000001: <%spellout-cardinal-masculine< miliardy >%spellout-cardinal-neuter>;
+5000000000: <%spellout-cardinal-masculine< miliardů;
+5000000001: <%spellout-cardinal-masculine< miliardů >%spellout-cardinal-neuter>;
+1000000000000: <%spellout-cardinal-masculine< bilión;
+1000000000001: <%spellout-cardinal-masculine< bilión >%spellout-cardinal-neuter>;
+2000000000000: <%spellout-cardinal-masculine< bilióny;
+2000000000001: <%spellout-cardinal-masculine< bilióny >%spellout-cardinal-neuter>;
+5000000000000: <%spellout-cardinal-masculine< biliónů;
+5000000000001: <%spellout-cardinal-masculine< biliónů >%spellout-cardinal-neuter>;
+1000000000000000: <%spellout-cardinal-masculine< biliarda;
+1000000000000001: <%spellout-cardinal-masculine< biliarda >%spellout-cardinal-neuter>;
+2000000000000000: <%spellout-cardinal-masculine< biliardy;
+2000000000000001: <%spellout-cardinal-masculine< biliardy >%spellout-cardinal-neuter>;
+5000000000000000: <%spellout-cardinal-masculine< biliardů;
+5000000000000001: <%spellout-cardinal-masculine< biliardů >%spellout-cardinal-neuter>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-neuter< čárka >%spellout-cardinal-neuter>;
+%spellout-cardinal-feminine:
+0: nula;
+1: jedna;
+2: dvě;
+3: =%spellout-cardinal-masculine=;
+20: <%spellout-cardinal-masculine<cet;
+21: <%spellout-cardinal-masculine<cet >%spellout-cardinal-feminine>;
+50: padesát;
+51: padesát >%spellout-cardinal-feminine>;
+60: šedesát;
+61: šedesát >%spellout-cardinal-feminine>;
+70: sedmdesát;
+71: sedmdesát >%spellout-cardinal-feminine>;
+80: osmdesát;
+81: osmdesát >%spellout-cardinal-feminine>;
+90: devadesát;
+91: devadesát >%spellout-cardinal-feminine>;
+100: sto;
+101: sto >%spellout-cardinal-feminine>;
+200: <%spellout-cardinal-feminine< stě;
+201: <%spellout-cardinal-feminine< stě >%spellout-cardinal-feminine>;
+300: <%spellout-cardinal-feminine< sta;
+301: <%spellout-cardinal-feminine< sta >%spellout-cardinal-feminine>;
+500: <%spellout-cardinal-feminine< set;
+501: <%spellout-cardinal-feminine< set >%spellout-cardinal-feminine>;
+1000: <%spellout-cardinal-feminine< tisíc;
+1001: <%spellout-cardinal-feminine< tisíc >%spellout-cardinal-feminine>;
+2000: <%spellout-cardinal-feminine< tisíce;
+2001: <%spellout-cardinal-feminine< tisíce >%spellout-cardinal-feminine>;
+5000: <%spellout-cardinal-feminine< tisíc;
+5001: <%spellout-cardinal-feminine< tisíc >%spellout-cardinal-feminine>;
+1000000: <%spellout-cardinal-masculine< milión;
+1000001: <%spellout-cardinal-masculine< milión >%spellout-cardinal-feminine>;
+2000000: <%spellout-cardinal-masculine< milióny;
+2000001: <%spellout-cardinal-masculine< milióny >%spellout-cardinal-feminine>;
+5000000: <%spellout-cardinal-masculine< miliónů;
+5000001: <%spellout-cardinal-masculine< miliónů >%spellout-cardinal-feminine>;
+1000000000: <%spellout-cardinal-masculine< miliarda;
+1000000001: <%spellout-cardinal-masculine< miliarda >%spellout-cardinal-feminine>;
+2000000000: <%spellout-cardinal-masculine< miliardy;
+2000000001: <%spellout-cardinal-masculine< miliardy >%spellout-cardinal-feminine>;
+5000000000: <%spellout-cardinal-masculine< miliardů;
+5000000001: <%spellout-cardinal-masculine< miliardů >%spellout-cardinal-feminine>;
+1000000000000: <%spellout-cardinal-masculine< bilión;
+1000000000001: <%spellout-cardinal-masculine< bilión >%spellout-cardinal-feminine>;
+2000000000000: <%spellout-cardinal-masculine< bilióny;
+2000000000001: <%spellout-cardinal-masculine< bilióny >%spellout-cardinal-feminine>;
+5000000000000: <%spellout-cardinal-masculine< biliónů;
+5000000000001: <%spellout-cardinal-masculine< biliónů >%spellout-cardinal-feminine>;
+1000000000000000: <%spellout-cardinal-masculine< biliarda;
+1000000000000001: <%spellout-cardinal-masculine< biliarda >%spellout-cardinal-feminine>;
+2000000000000000: <%spellout-cardinal-masculine< biliardy;
+2000000000000001: <%spellout-cardinal-masculine< biliardy >%spellout-cardinal-feminine>;
+5000000000000000: <%spellout-cardinal-masculine< biliardů;
+5000000000000001: <%spellout-cardinal-masculine< biliardů >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< čárka >%spellout-cardinal-feminine>;
+`
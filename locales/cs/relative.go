@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} rok", "few": "za {0} roky", "many": "za {0} roku", "other": "za {0} let"},
+			Past:     map[string]string{"one": "před {0} rokem", "many": "před {0} roku", "other": "před {0} lety"},
+			Relative: map[string]string{"-1": "minulý rok", "0": "tento rok", "1": "příští rok"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} r.", "few": "za {0} r.", "many": "za {0} r.", "other": "za {0} l."},
+			Past:     map[string]string{"one": "před {0} r.", "few": "před {0} r.", "many": "před {0} r.", "other": "před {0} l."},
+			Relative: map[string]string{"-1": "minulý rok", "0": "tento rok", "1": "příští rok"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} r.", "few": "za {0} r.", "many": "za {0} r.", "other": "za {0} l."},
+			Past:     map[string]string{"one": "před {0} r.", "few": "před {0} r.", "many": "před {0} r.", "other": "před {0} l."},
+			Relative: map[string]string{"-1": "minulý rok", "0": "tento rok", "1": "příští rok"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} čtvrtletí"},
+			Past:     map[string]string{"one": "před {0} čtvrtletím", "many": "před {0} čtvrtletí", "other": "před {0} čtvrtletími"},
+			Relative: map[string]string{"-1": "minulé čtvrtletí", "0": "toto čtvrtletí", "1": "příští čtvrtletí"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} Q"},
+			Past:     map[string]string{"other": "-{0} Q"},
+			Relative: map[string]string{"-1": "minulé čtvrtletí", "0": "toto čtvrtletí", "1": "příští čtvrtletí"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} Q"},
+			Past:     map[string]string{"other": "-{0} Q"},
+			Relative: map[string]string{"-1": "minulé čtvrtletí", "0": "toto čtvrtletí", "1": "příští čtvrtletí"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} měsíc", "few": "za {0} měsíce", "many": "za {0} měsíce", "other": "za {0} měsíců"},
+			Past:     map[string]string{"one": "před {0} měsícem", "many": "před {0} měsíce", "other": "před {0} měsíci"},
+			Relative: map[string]string{"-1": "minulý měsíc", "0": "tento měsíc", "1": "příští měsíc"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} měs."},
+			Past:     map[string]string{"other": "před {0} měs."},
+			Relative: map[string]string{"-1": "minulý měs.", "0": "tento měs.", "1": "příští měs."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} měs."},
+			Past:     map[string]string{"other": "před {0} měs."},
+			Relative: map[string]string{"-1": "minulý měs.", "0": "tento měs.", "1": "příští měs."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} týden", "few": "za {0} týdny", "many": "za {0} týdne", "other": "za {0} týdnů"},
+			Past:     map[string]string{"one": "před {0} týdnem", "many": "před {0} týdne", "other": "před {0} týdny"},
+			Relative: map[string]string{"-1": "minulý týden", "0": "tento týden", "1": "příští týden"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} týd."},
+			Past:     map[string]string{"other": "před {0} týd."},
+			Relative: map[string]string{"-1": "minulý týd.", "0": "tento týd.", "1": "příští týd."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} týd."},
+			Past:     map[string]string{"other": "před {0} týd."},
+			Relative: map[string]string{"-1": "minulý týd.", "0": "tento týd.", "1": "příští týd."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} den", "few": "za {0} dny", "many": "za {0} dne", "other": "za {0} dní"},
+			Past:     map[string]string{"one": "před {0} dnem", "many": "před {0} dne", "other": "před {0} dny"},
+			Relative: map[string]string{"-2": "předevčírem", "-1": "včera", "0": "dnes", "1": "zítra", "2": "pozítří"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} den", "few": "za {0} dny", "many": "za {0} dne", "other": "za {0} dní"},
+			Past:     map[string]string{"one": "před {0} dnem", "many": "před {0} dne", "other": "před {0} dny"},
+			Relative: map[string]string{"-2": "předevčírem", "-1": "včera", "0": "dnes", "1": "zítra", "2": "pozítří"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} den", "few": "za {0} dny", "many": "za {0} dne", "other": "za {0} dní"},
+			Past:     map[string]string{"one": "před {0} dnem", "many": "před {0} dne", "other": "před {0} dny"},
+			Relative: map[string]string{"-2": "předevčírem", "-1": "včera", "0": "dnes", "1": "zítra", "2": "pozítří"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} hodinu", "few": "za {0} hodiny", "many": "za {0} hodiny", "other": "za {0} hodin"},
+			Past:     map[string]string{"one": "před {0} hodinou", "many": "před {0} hodiny", "other": "před {0} hodinami"},
+			Relative: map[string]string{"0": "tuto hodinu"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} h"},
+			Past:     map[string]string{"other": "před {0} h"},
+			Relative: map[string]string{"0": "tuto hodinu"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} h"},
+			Past:     map[string]string{"other": "před {0} h"},
+			Relative: map[string]string{"0": "tuto hodinu"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} minutu", "few": "za {0} minuty", "many": "za {0} minuty", "other": "za {0} minut"},
+			Past:     map[string]string{"one": "před {0} minutou", "many": "před {0} minuty", "other": "před {0} minutami"},
+			Relative: map[string]string{"0": "tuto minutu"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} min"},
+			Past:     map[string]string{"other": "před {0} min"},
+			Relative: map[string]string{"0": "tuto minutu"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} min"},
+			Past:     map[string]string{"other": "před {0} min"},
+			Relative: map[string]string{"0": "tuto minutu"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} sekundu", "few": "za {0} sekundy", "many": "za {0} sekundy", "other": "za {0} sekund"},
+			Past:     map[string]string{"one": "před {0} sekundou", "many": "před {0} sekundy", "other": "před {0} sekundami"},
+			Relative: map[string]string{"0": "nyní"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} s"},
+			Past:     map[string]string{"other": "před {0} s"},
+			Relative: map[string]string{"0": "nyní"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} s"},
+			Past:     map[string]string{"other": "před {0} s"},
+			Relative: map[string]string{"0": "nyní"},
+		},
+	},
+}
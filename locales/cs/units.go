@@ -0,0 +1,321 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} akr", "few": "{0} akry", "many": "{0} akru", "other": "{0} akrů", "per": "{0}/akr"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} bit", "few": "{0} bity", "many": "{0} bitu", "other": "{0} bitů", "per": "{0}/bit"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Gender: "inanimate",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} bajt", "few": "{0} bajty", "many": "{0} bajtu", "other": "{0} bajtů", "per": "{0}/bajt"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Gender: "inanimate",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} stupeň Celsia", "few": "{0} stupně Celsia", "many": "{0} stupně Celsia", "other": "{0} stupňů Celsia", "per": "{0}/stupeň Celsia"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Gender: "inanimate",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centimetr", "few": "{0} centimetry", "many": "{0} centimetru", "other": "{0} centimetrů", "per": "{0} na centimetr"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Gender: "inanimate",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} den", "few": "{0} dny", "many": "{0} dne", "other": "{0} dnů", "per": "{0} za den"},
+		Short:  map[string]string{"one": "{0} den", "few": "{0} dny", "many": "{0} dne", "other": "{0} dnů", "per": "{0}/den"},
+		Narrow: map[string]string{"other": "{0} d.", "per": "{0}/d."},
+		Gender: "inanimate",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} stupeň", "few": "{0} stupně", "many": "{0} stupně", "other": "{0} stupňů", "per": "{0}/stupeň"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "inanimate",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} stupeň Fahrenheita", "few": "{0} stupně Fahrenheita", "many": "{0} stupně Fahrenheita", "other": "{0} stupňů Fahrenheita", "per": "{0}/stupeň Fahrenheita"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} dutá unce", "few": "{0} duté unce", "many": "{0} duté unce", "other": "{0} dutých uncí", "per": "{0}/dutá unce"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} stopa", "few": "{0} stopy", "many": "{0} stopy", "other": "{0} stop", "per": "{0} na stopu"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} galon", "few": "{0} galony", "many": "{0} galonu", "other": "{0} galonů", "per": "{0} na galon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} gigabit", "few": "{0} gigabity", "many": "{0} gigabitu", "other": "{0} gigabitů", "per": "{0}/gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Gender: "inanimate",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigabajt", "few": "{0} gigabajty", "many": "{0} gigabajtu", "other": "{0} gigabajtů", "per": "{0}/gigabajt"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Gender: "inanimate",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} gram", "few": "{0} gramy", "many": "{0} gramu", "other": "{0} gramů", "per": "{0} na gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Gender: "inanimate",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} hektar", "few": "{0} hektary", "many": "{0} hektaru", "other": "{0} hektarů", "per": "{0}/hektar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Gender: "inanimate",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} hodina", "few": "{0} hodiny", "many": "{0} hodiny", "other": "{0} hodin", "per": "{0} za hodinu"},
+		Short:  map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} palec", "few": "{0} palce", "many": "{0} palce", "other": "{0} palců", "per": "{0} na palec"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} kilobit", "few": "{0} kilobity", "many": "{0} kilobitu", "other": "{0} kilobitů", "per": "{0}/kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Gender: "inanimate",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilobajt", "few": "{0} kilobajty", "many": "{0} kilobajtu", "other": "{0} kilobajtů", "per": "{0}/kilobajt"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Gender: "inanimate",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} kilogram", "few": "{0} kilogramy", "many": "{0} kilogramu", "other": "{0} kilogramů", "per": "{0} na kilogram"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Gender: "inanimate",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} kilometr", "few": "{0} kilometry", "many": "{0} kilometru", "other": "{0} kilometrů", "per": "{0} na kilometr"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Gender: "inanimate",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} kilometr za hodinu", "few": "{0} kilometry za hodinu", "many": "{0} kilometru za hodinu", "other": "{0} kilometrů za hodinu"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0} km/h"},
+		Gender: "inanimate",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} litr", "few": "{0} litry", "many": "{0} litru", "other": "{0} litrů", "per": "{0} na litr"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Gender: "inanimate",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} litr na kilometr", "few": "{0} litry na kilometr", "many": "{0} litru na kilometr", "other": "{0} litrů na kilometr"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+		Gender: "inanimate",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} megabit", "few": "{0} megabity", "many": "{0} megabitu", "other": "{0} megabitů", "per": "{0}/megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Gender: "inanimate",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} megabajt", "few": "{0} megabajty", "many": "{0} megabajtu", "other": "{0} megabajtů", "per": "{0}/megabajt"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Gender: "inanimate",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} metr", "few": "{0} metry", "many": "{0} metru", "other": "{0} metrů", "per": "{0} na metr"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "inanimate",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} metr za sekundu", "few": "{0} metry za sekundu", "many": "{0} metru za sekundu", "other": "{0} metrů za sekundu"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+		Gender: "inanimate",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} mikrosekunda", "few": "{0} mikrosekundy", "many": "{0} mikrosekundy", "other": "{0} mikrosekund", "per": "{0}/mikrosekunda"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} míle", "few": "{0} míle", "many": "{0} míle", "other": "{0} mil", "per": "{0}/míle"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} míle na galon", "few": "{0} míle na galon", "many": "{0} míle na galon", "other": "{0} mil na galon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} míle za hodinu", "few": "{0} míle za hodinu", "many": "{0} míle za hodinu", "other": "{0} mil za hodinu"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0} mi/h"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} skandinávská míle", "few": "{0} skandinávské míle", "many": "{0} skandinávské míle", "other": "{0} skandinávských mil", "per": "{0}/skandinávská míle"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} mililitr", "few": "{0} mililitry", "many": "{0} mililitru", "other": "{0} mililitrů", "per": "{0}/mililitr"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Gender: "inanimate",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} milimetr", "few": "{0} milimetry", "many": "{0} milimetru", "other": "{0} milimetrů", "per": "{0}/milimetr"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Gender: "inanimate",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} milisekunda", "few": "{0} milisekundy", "many": "{0} milisekundy", "other": "{0} milisekund", "per": "{0}/milisekunda"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minuta", "few": "{0} minuty", "many": "{0} minuty", "other": "{0} minut", "per": "{0} za minutu"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} měsíc", "few": "{0} měsíce", "many": "{0} měsíce", "other": "{0} měsíců", "per": "{0} za měsíc"},
+		Short:  map[string]string{"other": "{0} měs.", "per": "{0}/měs."},
+		Narrow: map[string]string{"other": "{0} m.", "per": "{0}/m."},
+		Gender: "inanimate",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosekunda", "few": "{0} nanosekundy", "many": "{0} nanosekundy", "other": "{0} nanosekund", "per": "{0}/nanosekunda"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} unce", "few": "{0} unce", "many": "{0} unce", "other": "{0} uncí", "per": "{0} na unci"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} na {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} petabajt", "few": "{0} petabajty", "many": "{0} petabajtu", "other": "{0} petabajtů", "per": "{0}/petabajt"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Gender: "inanimate",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} libra", "few": "{0} libry", "many": "{0} libry", "other": "{0} liber", "per": "{0} na libru"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} sekunda", "few": "{0} sekundy", "many": "{0} sekundy", "other": "{0} sekund", "per": "{0} za sekundu"},
+		Short:  map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} centimetr čtvereční", "few": "{0} centimetry čtvereční", "many": "{0} centimetru čtverečního", "other": "{0} centimetrů čtverečních", "per": "{0} na centimetr čtvereční"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Gender: "inanimate",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} stopa čtvereční", "few": "{0} stopy čtvereční", "many": "{0} stopy čtvereční", "other": "{0} stop čtverečních"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} kilometr čtvereční", "few": "{0} kilometry čtvereční", "many": "{0} kilometru čtverečního", "other": "{0} kilometrů čtverečních", "per": "{0} na kilometr čtvereční"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Gender: "inanimate",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} metr čtvereční", "few": "{0} metry čtvereční", "many": "{0} metru čtverečního", "other": "{0} metrů čtverečních", "per": "{0} na metr čtvereční"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Gender: "inanimate",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} míle čtvereční", "few": "{0} míle čtvereční", "many": "{0} míle čtvereční", "other": "{0} mil čtverečních", "per": "{0} na míli čtvereční"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} kámen", "few": "{0} kameny", "many": "{0} kamene", "other": "{0} kamenů", "per": "{0}/kámen"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} terabit", "few": "{0} terabity", "many": "{0} terabitu", "other": "{0} terabitů", "per": "{0}/terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Gender: "inanimate",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} terabajt", "few": "{0} terabajty", "many": "{0} terabajtu", "other": "{0} terabajtů", "per": "{0}/terabajt"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Gender: "inanimate",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} týden", "few": "{0} týdny", "many": "{0} týdne", "other": "{0} týdnů", "per": "{0} za týden"},
+		Short:  map[string]string{"other": "{0} týd.", "per": "{0}/týd."},
+		Narrow: map[string]string{"other": "{0} t.", "per": "{0}/t."},
+		Gender: "inanimate",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} yard", "few": "{0} yardy", "many": "{0} yardu", "other": "{0} yardů", "per": "{0}/yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} rok", "few": "{0} roky", "many": "{0} roku", "other": "{0} let", "per": "{0} za rok"},
+		Short:  map[string]string{"one": "{0} rok", "few": "{0} roky", "many": "{0} roku", "other": "{0} let", "per": "{0}/rok"},
+		Narrow: map[string]string{"one": "{0} r.", "few": "{0} r.", "many": "{0} r.", "other": "{0} l.", "per": "{0}/r."},
+		Gender: "inanimate",
+	},
+}
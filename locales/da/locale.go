@@ -41,6 +41,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Danish,
 	data: hc.CldrData{
@@ -100,5 +105,88 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 bio.",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 t ¤",
+				"1000-count-other":            "0 t ¤",
+				"10000-count-one":             "00 t ¤",
+				"10000-count-other":           "00 t ¤",
+				"100000-count-one":            "000 t ¤",
+				"100000-count-other":          "000 t ¤",
+				"1000000-count-one":           "0 mio. ¤",
+				"1000000-count-other":         "0 mio. ¤",
+				"10000000-count-one":          "00 mio. ¤",
+				"10000000-count-other":        "00 mio. ¤",
+				"100000000-count-one":         "000 mio. ¤",
+				"100000000-count-other":       "000 mio. ¤",
+				"1000000000-count-one":        "0 mia. ¤",
+				"1000000000-count-other":      "0 mia. ¤",
+				"10000000000-count-one":       "00 mia. ¤",
+				"10000000000-count-other":     "00 mia. ¤",
+				"100000000000-count-one":      "000 mia. ¤",
+				"100000000000-count-other":    "000 mia. ¤",
+				"1000000000000-count-one":     "0 bio. ¤",
+				"1000000000000-count-other":   "0 bio. ¤",
+				"10000000000000-count-one":    "00 bio. ¤",
+				"10000000000000-count-other":  "00 bio. ¤",
+				"100000000000000-count-one":   "000 bio. ¤",
+				"100000000000000-count-other": "000 bio. ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"DKK": "kr.",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} og {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} og {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} og {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} og {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} og {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} og {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}-{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
@@ -0,0 +1,108 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-neuter=;
+%spellout-cardinal-common:
+0: nul;
+1: en;
+2: to;
+3: tre;
+4: fire;
+5: fem;
+6: seks;
+7: syv;
+8: otte;
+9: ni;
+10: ti;
+11: elleve;
+12: tolv;
+13: tretten;
+14: fjorten;
+15: femten;
+16: seksten;
+17: sytten;
+18: atten;
+19: nitten;
+20: tyve;
+21: >%spellout-cardinal-common>­og­tyve;
+30: tredive;
+31: >%spellout-cardinal-common>­og­tredive;
+40: fyrre;
+41: >%spellout-cardinal-common>­og­fyrre;
+50: halvtreds;
+51: >%spellout-cardinal-common>­og­halvtreds;
+60: tres;
+61: >%spellout-cardinal-common>­og­tres;
+70: halvfjerds;
+71: >%spellout-cardinal-common>­og­halvfjerds;
+80: firs;
+81: >%spellout-cardinal-common>­og­firs;
+90: halvfems;
+91: >%spellout-cardinal-common>­og­halvfems;
+100: hundrede;
+101: hundrede og >%spellout-cardinal-common>;
+200: <%spellout-cardinal-neuter<­hundrede;
+201: <%spellout-cardinal-neuter<­hundrede og >%spellout-cardinal-common>;
+1000: tusinde;
+1001: tusinde >%%and-small>;
+2000: <%spellout-cardinal-neuter< tusinde;
+2001: <%spellout-cardinal-neuter< tusinde >%%and-small>;
+1000000: million;
+1000001: million >%spellout-cardinal-common>;
+2000000: <%spellout-cardinal-common< millioner;
+2000001: <%spellout-cardinal-common< millioner >%spellout-cardinal-common>;
+1000000000: milliard;
+1000000001: milliard >%spellout-cardinal-common>;
+2000000000: <%spellout-cardinal-common< milliarder;
+2000000001: <%spellout-cardinal-common< milliarder >%spellout-cardinal-common>;
+1000000000000: billion;
+1000000000001: billion >%spellout-cardinal-common>;
+2000000000000: <%spellout-cardinal-common< billioner;
+2000000000001: <%spellout-cardinal-common< billioner >%spellout-cardinal-common>;
+1000000000000000: billiard;
+1000000000000001: billiard >%spellout-cardinal-common>;
+2000000000000000: <%spellout-cardinal-common< billiarder;
+2000000000000001: <%spellout-cardinal-common< billiarder >%spellout-cardinal-common>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-common>;
+x.x: <%spellout-cardinal-common< komma >%spellout-cardinal-common>;
+%%and-small:
+0: og =%spellout-cardinal-common=;
+100: =%spellout-cardinal-common=;
+%spellout-cardinal-neuter:
+0: nul;
+1: et;
+2: =%spellout-cardinal-common=;
+100: hundrede;
+101: hundrede og >%spellout-cardinal-neuter>;
+200: <%spellout-cardinal-neuter<­hundrede;
+201: <%spellout-cardinal-neuter<­hundrede og >%spellout-cardinal-neuter>;
+1000: tusind;
+1001: tusind >%%and-small-n>;
+2000: <%spellout-cardinal-neuter< tusind;
+2001: <%spellout-cardinal-neuter< tusind >%%and-small-n>;
+1000000: en million;
+1000001: en million >%spellout-cardinal-neuter>;
+2000000: <%spellout-cardinal-common< millioner;
+2000001: <%spellout-cardinal-common< millioner >%spellout-cardinal-neuter>;
+1000000000: en milliard;
+1000000001: en milliard >%spellout-cardinal-neuter>;
+2000000000: <%spellout-cardinal-common< milliarder;
+2000000001: <%spellout-cardinal-common< milliarder >%spellout-cardinal-neuter>;
+1000000000000: en billion;
+1000000000001: en billion >%spellout-cardinal-neuter>;
+2000000000000: <%spellout-cardinal-common< billioner;
+2000000000001: <%spellout-cardinal-common< billioner >%spellout-cardinal-neuter>;
+1000000000000000: en billiard;
+1000000000000001: en billiard >%spellout-cardinal-neuter>;
+2000000000000000: <%spellout-cardinal-common< billiarder;
+2000000000000001: <%spellout-cardinal-common< billiarder >%spellout-cardinal-neuter>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-neuter< komma >%spellout-cardinal-neuter>;
+%%and-small-n:
+0: og =%spellout-cardinal-neuter=;
+100: =%spellout-cardinal-neuter=;
+`
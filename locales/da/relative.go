@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} år"},
+			Past:     map[string]string{"other": "for {0} år siden"},
+			Relative: map[string]string{"-1": "sidste år", "0": "i år", "1": "næste år"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} år"},
+			Past:     map[string]string{"other": "{0} år siden"},
+			Relative: map[string]string{"-1": "sidste år", "0": "i år", "1": "næste år"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} år"},
+			Past:     map[string]string{"other": "{0} år siden"},
+			Relative: map[string]string{"-1": "sidste år", "0": "i år", "1": "næste år"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} kvartal", "other": "om {0} kvartaler"},
+			Past:     map[string]string{"one": "for {0} kvartal siden", "other": "for {0} kvartaler siden"},
+			Relative: map[string]string{"-1": "sidste kvartal", "0": "dette kvartal", "1": "næste kvartal"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} kvt."},
+			Past:     map[string]string{"other": "{0} kvt. siden"},
+			Relative: map[string]string{"-1": "sidste kvt.", "0": "dette kvt.", "1": "næste kvt."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} kvt."},
+			Past:     map[string]string{"other": "{0} kvt. siden"},
+			Relative: map[string]string{"-1": "sidste kvt.", "0": "dette kvt.", "1": "næste kvt."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} måned", "other": "om {0} måneder"},
+			Past:     map[string]string{"one": "for {0} måned siden", "other": "for {0} måneder siden"},
+			Relative: map[string]string{"-1": "sidste måned", "0": "denne måned", "1": "næste måned"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} md.", "other": "om {0} mdr."},
+			Past:     map[string]string{"one": "{0} md. siden", "other": "{0} mdr. siden"},
+			Relative: map[string]string{"-1": "sidste md.", "0": "denne md.", "1": "næste md."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} md.", "other": "om {0} mdr."},
+			Past:     map[string]string{"one": "{0} md. siden", "other": "{0} mdr. siden"},
+			Relative: map[string]string{"-1": "sidste md.", "0": "denne md.", "1": "næste md."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} uge", "other": "om {0} uger"},
+			Past:     map[string]string{"one": "for {0} uge siden", "other": "for {0} uger siden"},
+			Relative: map[string]string{"-1": "sidste uge", "0": "denne uge", "1": "næste uge"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} uge", "other": "om {0} uger"},
+			Past:     map[string]string{"one": "{0} uge siden", "other": "{0} uger siden"},
+			Relative: map[string]string{"-1": "sidste uge", "0": "denne uge", "1": "næste uge"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} uge", "other": "om {0} uger"},
+			Past:     map[string]string{"one": "{0} uge siden", "other": "{0} uger siden"},
+			Relative: map[string]string{"-1": "sidste uge", "0": "denne uge", "1": "næste uge"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} dag", "other": "om {0} dage"},
+			Past:     map[string]string{"one": "for {0} dag siden", "other": "for {0} dage siden"},
+			Relative: map[string]string{"-2": "i forgårs", "-1": "i går", "0": "i dag", "1": "i morgen", "2": "i overmorgen"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} dag", "other": "om {0} dage"},
+			Past:     map[string]string{"one": "{0} dag siden", "other": "{0} dage siden"},
+			Relative: map[string]string{"-2": "i forgårs", "-1": "i går", "0": "i dag", "1": "i morgen", "2": "i overmorgen"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} dag", "other": "om {0} dage"},
+			Past:     map[string]string{"one": "{0} dag siden", "other": "{0} dage siden"},
+			Relative: map[string]string{"-2": "i forgårs", "-1": "i går", "0": "i dag", "1": "i morgen", "2": "i overmorgen"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} time", "other": "om {0} timer"},
+			Past:     map[string]string{"one": "for {0} time siden", "other": "for {0} timer siden"},
+			Relative: map[string]string{"0": "denne time"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} time", "other": "om {0} timer"},
+			Past:     map[string]string{"one": "{0} time siden", "other": "{0} timer siden"},
+			Relative: map[string]string{"0": "denne time"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} time", "other": "om {0} timer"},
+			Past:     map[string]string{"one": "{0} time siden", "other": "{0} timer siden"},
+			Relative: map[string]string{"0": "denne time"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} minut", "other": "om {0} minutter"},
+			Past:     map[string]string{"one": "for {0} minut siden", "other": "for {0} minutter siden"},
+			Relative: map[string]string{"0": "dette minut"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} min."},
+			Past:     map[string]string{"other": "{0} min. siden"},
+			Relative: map[string]string{"0": "dette minut"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} min."},
+			Past:     map[string]string{"other": "{0} min. siden"},
+			Relative: map[string]string{"0": "dette minut"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} sekund", "other": "om {0} sekunder"},
+			Past:     map[string]string{"one": "for {0} sekund siden", "other": "for {0} sekunder siden"},
+			Relative: map[string]string{"0": "nu"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} sek."},
+			Past:     map[string]string{"other": "{0} sek. siden"},
+			Relative: map[string]string{"0": "nu"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} sek."},
+			Past:     map[string]string{"other": "{0} sek. siden"},
+			Relative: map[string]string{"0": "nu"},
+		},
+	},
+}
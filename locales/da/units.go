@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} acre", "other": "{0} acres", "per": "{0} pr. acre"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+		Gender: "common",
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0} pr. bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Gender: "common",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} byte", "other": "{0} bytes", "per": "{0} pr. byte"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Gender: "common",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} grad celsius", "other": "{0} grader celsius", "per": "{0} pr. grad celsius"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "common",
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} centimeter", "per": "{0} pr. centimeter"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Gender: "common",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} dag", "other": "{0} dage", "per": "{0} pr. dag"},
+		Short:  map[string]string{"one": "{0} dag", "other": "{0} dage", "per": "{0}/dag"},
+		Narrow: map[string]string{"other": "{0} d", "per": "{0}/d"},
+		Gender: "common",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} grad", "other": "{0} grader", "per": "{0} pr. grad"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "common",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} grad fahrenheit", "other": "{0} grader fahrenheit", "per": "{0} pr. grad fahrenheit"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "common",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} engelsk fluid ounce", "other": "{0} engelske fluid ounces", "per": "{0} pr. engelsk fluid ounce"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Gender: "common",
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} fod", "per": "{0} pr. fod"},
+		Short:  map[string]string{"other": "{0} fod", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0} fod", "per": "{0}/ft"},
+		Gender: "common",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} gallon", "other": "{0} gallons", "per": "{0}/gal"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Gender: "common",
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0} pr. gigabit"},
+		Short:  map[string]string{"other": "{0} Gbit", "per": "{0}/Gbit"},
+		Narrow: map[string]string{"other": "{0} Gbit", "per": "{0}/Gbit"},
+		Gender: "common",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigabyte", "other": "{0} gigabytes", "per": "{0} pr. gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Gender: "common",
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} gram", "per": "{0} pr. gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Gender: "neuter",
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} hektar", "per": "{0} pr. hektar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+		Gender: "common",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} time", "other": "{0} timer", "per": "{0} pr. time"},
+		Short:  map[string]string{"other": "{0} t.", "per": "{0}/t."},
+		Narrow: map[string]string{"other": "{0} t", "per": "{0}/t"},
+		Gender: "common",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} tomme", "other": "{0} tommer", "per": "{0} pr. tomme"},
+		Short:  map[string]string{"one": "{0} tomme", "other": "{0} tommer", "per": "{0}/tomme"},
+		Narrow: map[string]string{"other": "{0}\"", "per": "{0}/tomme"},
+		Gender: "common",
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0} pr. kilobit"},
+		Short:  map[string]string{"other": "{0} kbit", "per": "{0}/kbit"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Gender: "common",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilobyte", "other": "{0} kilobytes", "per": "{0} pr. kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Gender: "common",
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} kilogram", "per": "{0} pr. kg"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Gender: "neuter",
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} kilometer", "per": "{0} pr. kilometer"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Gender: "common",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} kilometer i timen"},
+		Short:  map[string]string{"other": "{0} km/t."},
+		Narrow: map[string]string{"other": "{0} km/t"},
+		Gender: "common",
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} liter", "per": "{0}/l"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Gender: "common",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} liter pr. kilometer"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+		Gender: "common",
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0} pr. megabit"},
+		Short:  map[string]string{"other": "{0} Mbit", "per": "{0}/Mbit"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Gender: "common",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} megabyte", "other": "{0} megabytes", "per": "{0} pr. megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Gender: "common",
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} meter", "per": "{0} pr. meter"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "common",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} meter i sekundet"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+		Gender: "common",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} mikrosekund", "other": "{0} mikrosekunder", "per": "{0} pr. mikrosekund"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Gender: "neuter",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} mile", "other": "{0} miles", "per": "{0} pr. mile"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Gender: "common",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "mil pr. gallon", "other": "{0} mil pr. gallon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+		Gender: "common",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} engelsk mil i timen", "other": "{0} engelske mil i timen"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"other": "{0} mph"},
+		Gender: "common",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} svensk mil", "other": "{0} svenske mil", "per": "{0} pr. svensk mil"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}sv. mil", "per": "{0}/sv. mil"},
+		Gender: "common",
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} milliliter", "per": "{0} pr. milliliter"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Gender: "common",
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} millimeter", "per": "{0} pr. millimeter"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Gender: "common",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} millisekund", "other": "{0} millisekunder", "per": "{0} pr. millisekund"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Gender: "neuter",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minut", "other": "{0} minutter", "per": "{0} pr. min."},
+		Short:  map[string]string{"other": "{0} min.", "per": "{0}/min."},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "neuter",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} måned", "other": "{0} måneder", "per": "{0} pr. måned"},
+		Short:  map[string]string{"one": "{0} md.", "other": "{0} mdr.", "per": "{0}/md."},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "common",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosekund", "other": "{0} nanosekunder", "per": "{0} pr. nanosekund"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+		Gender: "neuter",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} ounce", "other": "{0} ounces", "per": "{0} pr. ounce"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Gender: "common",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} pr. {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} petabyte", "other": "{0} petabytes", "per": "{0} pr. petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Gender: "common",
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} pund", "per": "{0} pr. pund"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0} pund", "per": "{0}/lb"},
+		Gender: "neuter",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} sekund", "other": "{0} sekunder", "per": "{0} i sekundet"},
+		Short:  map[string]string{"other": "{0} sek.", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} s"},
+		Gender: "neuter",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} kvadratcentimeter", "per": "{0}/cm²"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Gender: "common",
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} kvadratfod"},
+		Short:  map[string]string{"other": "{0} kvadratfod"},
+		Narrow: map[string]string{"other": "{0} fod²"},
+		Gender: "common",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} kvadratkilometer", "per": "{0} pr. kvadratkilometer"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+		Gender: "common",
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} kvadratmeter", "per": "{0}/m²"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+		Gender: "common",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} kvadrat-engelsk mil", "other": "{0} kvadrat-engelske mil", "per": "{0} pr. kvadrat-engelske mil"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Gender: "common",
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} stone", "per": "{0} pr. stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0} pr. terabit"},
+		Short:  map[string]string{"other": "{0} Tbit", "per": "{0}/Tbit"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Gender: "common",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} terabyte", "other": "{0} terabytes", "per": "{0} pr. terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Gender: "common",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} uge", "other": "{0} uger", "per": "{0} pr. uge"},
+		Short:  map[string]string{"one": "{0} uge", "other": "{0} uger", "per": "{0}/uge"},
+		Narrow: map[string]string{"other": "{0} u", "per": "{0}/u"},
+		Gender: "common",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} engelsk yard", "other": "{0} engelske yard", "per": "{0} pr. engelsk yard"},
+		Short:  map[string]string{"other": "{0} yard", "per": "{0}/yard"},
+		Narrow: map[string]string{"other": "{0} yard", "per": "{0}/yard"},
+		Gender: "common",
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} år", "per": "{0} om året"},
+		Short:  map[string]string{"other": "{0} år", "per": "{0}/år"},
+		Narrow: map[string]string{"other": "{0} år", "per": "{0}/år"},
+		Gender: "neuter",
+	},
+}
@@ -225,3 +225,80 @@ func TestHumanizeDeOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeDePercent(t *testing.T) {
+	tests := []struct {
+		number   string
+		opts     hc.Options
+		expected string
+	}{
+		{"0.125", hc.Options{}, "12,5\u00A0%"},
+		{"0.125", hc.Options{SignDisplay: hc.SignAlways}, "+12,5\u00A0%"},
+		{"-0.03", hc.Options{}, "-3\u00A0%"},
+		{"12", hc.Options{}, "1.200\u00A0%"},
+		{"0.125", hc.Options{Precision: hc.FractionDigits(0, 0)}, "12\u00A0%"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatPercent(tt.number, language.German, tt.opts)
+		if err != nil {
+			t.Errorf("[PERCENT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[PERCENT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeDeOrdinal(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "1."},
+		{"1000", "1.000."},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatOrdinal(tt.number, language.German, hc.Options{})
+		if err != nil {
+			t.Errorf("[ORDINAL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[ORDINAL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeDeSpellSmallNumbers(t *testing.T) {
+	tests := []struct {
+		number   string
+		unit     string
+		expected string
+	}{
+		{"1", "day", "ein Tag"},
+		{"1", "week", "eine Woche"},
+		{"1", "year", "ein Jahr"},
+		{"2", "week", "zwei Wochen"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+	opts := hc.Options{SpellSmallNumbers: 10}
+
+	for _, tt := range tests {
+		res, err := h.FormatUnit(tt.number, tt.unit, language.German, opts)
+		if err != nil {
+			t.Errorf("[SPELL SMALL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELL SMALL] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+}
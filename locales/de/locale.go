@@ -42,6 +42,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.German,
 	data: hc.CldrData{
@@ -100,5 +105,87 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 Bio.",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0",
+				"1000-count-other":            "0",
+				"10000-count-one":             "0",
+				"10000-count-other":           "0",
+				"100000-count-one":            "0",
+				"100000-count-other":          "0",
+				"1000000-count-one":           "0 Mio. ¤",
+				"1000000-count-other":         "0 Mio. ¤",
+				"10000000-count-one":          "00 Mio. ¤",
+				"10000000-count-other":        "00 Mio. ¤",
+				"100000000-count-one":         "000 Mio. ¤",
+				"100000000-count-other":       "000 Mio. ¤",
+				"1000000000-count-one":        "0 Mrd. ¤",
+				"1000000000-count-other":      "0 Mrd. ¤",
+				"10000000000-count-one":       "00 Mrd. ¤",
+				"10000000000-count-other":     "00 Mrd. ¤",
+				"100000000000-count-one":      "000 Mrd. ¤",
+				"100000000000-count-other":    "000 Mrd. ¤",
+				"1000000000000-count-one":     "0 Bio. ¤",
+				"1000000000000-count-other":   "0 Bio. ¤",
+				"10000000000000-count-one":    "00 Bio. ¤",
+				"10000000000000-count-other":  "00 Bio. ¤",
+				"100000000000000-count-one":   "000 Bio. ¤",
+				"100000000000000-count-other": "000 Bio. ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"USD": "$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} und {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} und {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} und {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "≈{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
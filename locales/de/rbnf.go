@@ -0,0 +1,118 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: null;
+1: eins;
+2: zwei;
+3: drei;
+4: vier;
+5: fünf;
+6: sechs;
+7: sieben;
+8: acht;
+9: neun;
+10: zehn;
+11: elf;
+12: zwölf;
+13: >%spellout-numbering>zehn;
+16: sechzehn;
+17: siebzehn;
+18: >%spellout-numbering>zehn;
+20: zwanzig;
+21: >%spellout-cardinal-masculine>­und­zwanzig;
+30: dreißig;
+31: >%spellout-cardinal-masculine>­und­dreißig;
+40: vierzig;
+41: >%spellout-cardinal-masculine>­und­vierzig;
+50: fünfzig;
+51: >%spellout-cardinal-masculine>­und­fünfzig;
+60: sechzig;
+61: >%spellout-cardinal-masculine>­und­sechzig;
+70: siebzig;
+71: >%spellout-cardinal-masculine>­und­siebzig;
+80: achtzig;
+81: >%spellout-cardinal-masculine>­und­achtzig;
+90: neunzig;
+91: >%spellout-cardinal-masculine>­und­neunzig;
+100: <%spellout-cardinal-masculine<­hundert;
+101: <%spellout-cardinal-masculine<­hundert­>%spellout-numbering>;
+1000: <%spellout-cardinal-masculine<­tausend;
+1001: <%spellout-cardinal-masculine<­tausend­>%spellout-numbering>;
+1000000: eine Million;
+1000001: eine Million >%spellout-numbering>;
+2000000: <%spellout-cardinal-feminine< Millionen;
+2000001: <%spellout-cardinal-feminine< Millionen >%spellout-numbering>;
+1000000000: eine Milliarde;
+1000000001: eine Milliarde >%spellout-numbering>;
+2000000000: <%spellout-cardinal-feminine< Milliarden;
+2000000001: <%spellout-cardinal-feminine< Milliarden >%spellout-numbering>;
+1000000000000: eine Billion;
+1000000000001: eine Billion >%spellout-numbering>;
+2000000000000: <%spellout-cardinal-feminine< Billionen;
+2000000000001: <%spellout-cardinal-feminine< Billionen >%spellout-numbering>;
+1000000000000000: eine Billiarde;
+1000000000000001: eine Billiarde >%spellout-numbering>;
+2000000000000000: <%spellout-cardinal-feminine< Billiarden;
+2000000000000001: <%spellout-cardinal-feminine< Billiarden >%spellout-numbering>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-numbering>;
+x.x: <%spellout-numbering< Komma >%spellout-numbering>;
+%spellout-cardinal-neuter:
+0: =%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: null;
+1: ein;
+2: =%spellout-numbering=;
+100: <%spellout-cardinal-masculine<­hundert;
+101: <%spellout-cardinal-masculine<­hundert­>%spellout-cardinal-masculine>;
+1000: <%spellout-cardinal-masculine<­tausend;
+1001: <%spellout-cardinal-masculine<­tausend­>%spellout-cardinal-masculine>;
+1000000: eine Million;
+1000001: eine Million >%spellout-cardinal-masculine>;
+2000000: <%spellout-cardinal-feminine< Millionen;
+2000001: <%spellout-cardinal-feminine< Millionen >%spellout-cardinal-masculine>;
+1000000000: eine Milliarde;
+1000000001: eine Milliarde >%spellout-cardinal-masculine>;
+2000000000: <%spellout-cardinal-feminine< Milliarden;
+2000000001: <%spellout-cardinal-feminine< Milliarden >%spellout-cardinal-masculine>;
+1000000000000: eine Billion;
+1000000000001: eine Billion >%spellout-cardinal-masculine>;
+2000000000000: <%spellout-cardinal-feminine< Billionen;
+2000000000001: <%spellout-cardinal-feminine< Billionen >%spellout-cardinal-masculine>;
+1000000000000000: eine Billiarde;
+1000000000000001: eine Billiarde >%spellout-cardinal-masculine>;
+2000000000000000: <%spellout-cardinal-feminine< Billiarden;
+2000000000000001: <%spellout-cardinal-feminine< Billiarden >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< Komma >%spellout-cardinal-masculine>;
+%spellout-cardinal-feminine:
+0: null;
+1: eine;
+2: =%spellout-numbering=;
+100: <%spellout-cardinal-masculine<­hundert;
+101: <%spellout-cardinal-masculine<­hundert­>%spellout-cardinal-feminine>;
+1000: <%spellout-cardinal-masculine<­tausend;
+1001: <%spellout-cardinal-masculine<­tausend­>%spellout-cardinal-feminine>;
+1000000: eine Million;
+1000001: eine Million >%spellout-cardinal-feminine>;
+2000000: <%spellout-cardinal-feminine< Millionen;
+2000001: <%spellout-cardinal-feminine< Millionen >%spellout-cardinal-feminine>;
+1000000000: eine Milliarde;
+1000000001: eine Milliarde >%spellout-cardinal-feminine>;
+2000000000: <%spellout-cardinal-feminine< Milliarden;
+2000000001: <%spellout-cardinal-feminine< Milliarden >%spellout-cardinal-feminine>;
+1000000000000: eine Billion;
+1000000000001: eine Billion >%spellout-cardinal-feminine>;
+2000000000000: <%spellout-cardinal-feminine< Billionen;
+2000000000001: <%spellout-cardinal-feminine< Billionen >%spellout-cardinal-feminine>;
+1000000000000000: eine Billiarde;
+1000000000000001: eine Billiarde >%spellout-cardinal-feminine>;
+2000000000000000: <%spellout-cardinal-feminine< Billiarden;
+2000000000000001: <%spellout-cardinal-feminine< Billiarden >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< Komma >%spellout-cardinal-feminine>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+			Past:     map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+			Relative: map[string]string{"-1": "letztes Jahr", "0": "dieses Jahr", "1": "nächstes Jahr"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+			Past:     map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+			Relative: map[string]string{"-1": "letztes Jahr", "0": "dieses Jahr", "1": "nächstes Jahr"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Jahr", "other": "in {0} Jahren"},
+			Past:     map[string]string{"one": "vor {0} Jahr", "other": "vor {0} Jahren"},
+			Relative: map[string]string{"-1": "letztes Jahr", "0": "dieses Jahr", "1": "nächstes Jahr"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Quartal", "other": "in {0} Quartalen"},
+			Past:     map[string]string{"one": "vor {0} Quartal", "other": "vor {0} Quartalen"},
+			Relative: map[string]string{"-1": "letztes Quartal", "0": "dieses Quartal", "1": "nächstes Quartal"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Quart."},
+			Past:     map[string]string{"other": "vor {0} Quart."},
+			Relative: map[string]string{"-1": "letztes Quartal", "0": "dieses Quartal", "1": "nächstes Quartal"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Q"},
+			Past:     map[string]string{"other": "vor {0} Q"},
+			Relative: map[string]string{"-1": "letztes Quartal", "0": "dieses Quartal", "1": "nächstes Quartal"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+			Past:     map[string]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+			Relative: map[string]string{"-1": "letzten Monat", "0": "diesen Monat", "1": "nächsten Monat"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+			Past:     map[string]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+			Relative: map[string]string{"-1": "letzten Monat", "0": "diesen Monat", "1": "nächsten Monat"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Monat", "other": "in {0} Monaten"},
+			Past:     map[string]string{"one": "vor {0} Monat", "other": "vor {0} Monaten"},
+			Relative: map[string]string{"-1": "letzten Monat", "0": "diesen Monat", "1": "nächsten Monat"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Woche", "other": "in {0} Wochen"},
+			Past:     map[string]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+			Relative: map[string]string{"-1": "letzte Woche", "0": "diese Woche", "1": "nächste Woche"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Woche", "other": "in {0} Wochen"},
+			Past:     map[string]string{"one": "vor {0} Woche", "other": "vor {0} Wochen"},
+			Relative: map[string]string{"-1": "letzte Woche", "0": "diese Woche", "1": "nächste Woche"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Wo."},
+			Past:     map[string]string{"other": "vor {0} Wo."},
+			Relative: map[string]string{"-1": "letzte Woche", "0": "diese Woche", "1": "nächste Woche"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+			Past:     map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+			Relative: map[string]string{"-2": "vorgestern", "-1": "gestern", "0": "heute", "1": "morgen", "2": "übermorgen"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+			Past:     map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+			Relative: map[string]string{"-2": "vorgestern", "-1": "gestern", "0": "heute", "1": "morgen", "2": "übermorgen"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Tag", "other": "in {0} Tagen"},
+			Past:     map[string]string{"one": "vor {0} Tag", "other": "vor {0} Tagen"},
+			Relative: map[string]string{"-2": "vorgestern", "-1": "gestern", "0": "heute", "1": "morgen", "2": "übermorgen"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Stunde", "other": "in {0} Stunden"},
+			Past:     map[string]string{"one": "vor {0} Stunde", "other": "vor {0} Stunden"},
+			Relative: map[string]string{"0": "in dieser Stunde"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Std."},
+			Past:     map[string]string{"other": "vor {0} Std."},
+			Relative: map[string]string{"0": "in dieser Stunde"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Std."},
+			Past:     map[string]string{"other": "vor {0} Std."},
+			Relative: map[string]string{"0": "in dieser Stunde"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Minute", "other": "in {0} Minuten"},
+			Past:     map[string]string{"one": "vor {0} Minute", "other": "vor {0} Minuten"},
+			Relative: map[string]string{"0": "in dieser Minute"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Min."},
+			Past:     map[string]string{"other": "vor {0} Min."},
+			Relative: map[string]string{"0": "in dieser Minute"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} m"},
+			Past:     map[string]string{"other": "vor {0} m"},
+			Relative: map[string]string{"0": "in dieser Minute"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} Sekunde", "other": "in {0} Sekunden"},
+			Past:     map[string]string{"one": "vor {0} Sekunde", "other": "vor {0} Sekunden"},
+			Relative: map[string]string{"0": "jetzt"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} Sek."},
+			Past:     map[string]string{"other": "vor {0} Sek."},
+			Relative: map[string]string{"0": "jetzt"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} s"},
+			Past:     map[string]string{"other": "vor {0} s"},
+			Relative: map[string]string{"0": "jetzt"},
+		},
+	},
+}
@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} Acre", "other": "{0} Acres", "per": "{0} pro Acre"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Gender: "masculine",
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} Bit", "other": "{0} Bit", "per": "{0} pro Bit"},
+		Short:  map[string]string{"one": "{0} Bit", "other": "{0} Bit", "per": "{0}/Bit"},
+		Narrow: map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Gender: "neuter",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} Byte", "other": "{0} Byte", "per": "{0} pro Byte"},
+		Short:  map[string]string{"one": "{0} Byte", "other": "{0} Byte", "per": "{0}/Byte"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Gender: "neuter",
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} Grad Celsius", "per": "{0} pro Grad Celsius"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Gender: "neuter",
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} Zentimeter", "per": "{0} pro Zentimeter"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} Tag", "other": "{0} Tage", "per": "{0} pro Tag"},
+		Short:  map[string]string{"other": "{0} Tg.", "per": "{0}/T"},
+		Narrow: map[string]string{"other": "{0} T", "per": "{0}/T"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} Grad", "per": "{0} pro Grad"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "neuter",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} Grad Fahrenheit", "per": "{0} pro Grad Fahrenheit"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "neuter",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} Flüssigunze", "other": "{0} Flüssigunzen", "per": "{0} pro Flüssigunze"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Gender: "feminine",
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} Fuß", "per": "{0} pro Fuß"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Gender: "masculine",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} Gallone", "other": "{0} Gallonen", "per": "{0} pro Gallone"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Gender: "feminine",
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} Gigabit", "other": "{0} Gigabit", "per": "{0} pro Gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Gender: "neuter",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} Gigabyte", "other": "{0} Gigabyte", "per": "{0} pro Gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Gender: "neuter",
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} Gramm", "per": "{0} pro Gramm"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Gender: "neuter",
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} Hektar", "per": "{0} pro Hektar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} Stunde", "other": "{0} Stunden", "per": "{0} pro Stunde"},
+		Short:  map[string]string{"other": "{0} Std.", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0} Std.", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} Zoll", "per": "{0} pro Zoll"},
+		Short:  map[string]string{"one": "{0} in", "other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"one": "{0} in", "other": "{0} in", "per": "{0}/in"},
+		Gender: "masculine",
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} Kilobit", "other": "{0} Kilobit", "per": "{0} pro Kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Gender: "neuter",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} Kilobyte", "other": "{0} Kilobyte", "per": "{0} pro Kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Gender: "neuter",
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} Kilogramm", "per": "{0} pro Kilogramm"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Gender: "neuter",
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} Kilometer", "per": "{0} pro Kilometer"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} Kilometer pro Stunde"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0} km/h"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} Liter", "per": "{0} pro Liter"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} Liter pro Kilometer"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0}l/km"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} Megabit", "other": "{0} Megabit", "per": "{0} pro Megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Gender: "neuter",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} Megabyte", "other": "{0} Megabyte", "per": "{0} pro Megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Gender: "neuter",
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} Meter", "per": "{0} pro Meter"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} Meter pro Sekunde"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} Mikrosekunde", "other": "{0} Mikrosekunden", "per": "{0} pro Mikrosekunde"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} Meile", "other": "{0} Meilen", "per": "{0} pro Meile"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Gender: "feminine",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} Meile pro Gallone", "other": "{0} Meilen pro Gallone"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+		Gender: "feminine",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} Meile pro Stunde", "other": "{0} Meilen pro Stunde"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0} mi/h"},
+		Gender: "feminine",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} skandinavische Meile", "other": "{0} skandinavische Meilen", "per": "{0} pro skandinavische Meile"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} Milliliter", "per": "{0} pro Milliliter"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} Millimeter", "per": "{0} pro Millimeter"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} Millisekunde", "other": "{0} Millisekunden", "per": "{0} pro Millisekunde"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} Minute", "other": "{0} Minuten", "per": "{0} pro Minute"},
+		Short:  map[string]string{"other": "{0} Min.", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0} Min.", "per": "{0}/min"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} Monat", "other": "{0} Monate", "per": "{0} pro Monat"},
+		Short:  map[string]string{"other": "{0} Mon.", "per": "{0}/M"},
+		Narrow: map[string]string{"other": "{0} M", "per": "{0}/M"},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} Nanosekunde", "other": "{0} Nanosekunden", "per": "{0} pro Nanosekunde"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} Unze", "other": "{0} Unzen", "per": "{0} pro Unze"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Gender: "feminine",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} pro {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} Petabyte", "per": "{0} pro Petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Gender: "neuter",
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} Pfund", "per": "{0} pro Pfund"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Gender: "neuter",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} Sekunde", "other": "{0} Sekunden", "per": "{0} pro Sekunde"},
+		Short:  map[string]string{"other": "{0} Sek.", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} Sek.", "per": "{0}/s"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} Quadratzentimeter", "per": "{0} pro Quadratzentimeter"},
+		Short:  map[string]string{"one": "{0} Quadratzentimeter", "other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} Quadratfuß"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+		Gender: "masculine",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} Quadratkilometer", "per": "{0} pro Quadratkilometer"},
+		Short:  map[string]string{"one": "{0} Quadratkilometer", "other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} Quadratmeter", "per": "{0} pro Quadratmeter"},
+		Short:  map[string]string{"one": "{0} Quadratmeter", "other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} Quadratmeile", "other": "{0} Quadratmeilen", "per": "{0} pro Quadratmeile"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Gender: "feminine",
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} Stone", "other": "{0} Stones", "per": "{0} pro Stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} Terabit", "other": "{0} Terabit", "per": "{0} pro Terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Gender: "neuter",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} Terabyte", "other": "{0} Terabyte", "per": "{0} pro Terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Gender: "neuter",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} Woche", "other": "{0} Wochen", "per": "{0} pro Woche"},
+		Short:  map[string]string{"other": "{0} Wo.", "per": "{0}/W"},
+		Narrow: map[string]string{"other": "{0} W", "per": "{0}/W"},
+		Gender: "feminine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} Yard", "other": "{0} Yards", "per": "{0} pro Yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Gender: "neuter",
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} Jahr", "other": "{0} Jahre", "per": "{0} pro Jahr"},
+		Short:  map[string]string{"other": "{0} J", "per": "{0}/J"},
+		Narrow: map[string]string{"other": "{0} J", "per": "{0}/J"},
+		Gender: "neuter",
+	},
+}
@@ -1,10 +1,14 @@
 package locale_test
 
 import (
+	"math"
+	"strings"
 	"testing"
+	"time"
 
 	hc "github.com/dejurin/humanizecompact"
 	locale "github.com/dejurin/humanizecompact/locales/en"
+	"github.com/govalues/decimal"
 	"golang.org/x/text/language"
 )
 
@@ -186,3 +190,991 @@ func TestHumanizeEnOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeEnCurrency(t *testing.T) {
+	tests := []struct {
+		number   string
+		currency string
+		display  hc.CurrencyDisplay
+		expected string
+	}{
+		{"1200000", "USD", hc.CurrencySymbol, "$1.2M"},
+		{"-1200000", "USD", hc.CurrencySymbol, "-$1.2M"},
+		{"1000", "EUR", hc.CurrencySymbol, "€1K"},
+		{"1234567", "USD", hc.CurrencySymbol, "$1.2M"},
+		{"1234.5", "USD", hc.CurrencySymbol, "$1.2K"},
+		{"1234.5", "JPY", hc.CurrencySymbol, "¥1.2K"},
+		{"999.25", "USD", hc.CurrencySymbol, "$999.25"},
+		{"999.5", "JPY", hc.CurrencySymbol, "¥1K"},
+		{"1200000", "CAD", hc.CurrencySymbol, "CA$1.2M"},
+		{"1200000", "CAD", hc.CurrencyNarrowSymbol, "$1.2M"},
+		{"1200000", "USD", hc.CurrencyCode, "USD\u00A01.2M"},
+		{"999", "CHF", hc.CurrencySymbol, "CHF\u00A0999.00"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatCurrency(tt.number, tt.currency, language.English, hc.Options{CurrencyDisplay: tt.display})
+		if err != nil {
+			t.Errorf("[CURRENCY] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[CURRENCY] number %q %s => got %q, want %q", tt.number, tt.currency, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEnPercent(t *testing.T) {
+	oneDigit := hc.Options{Precision: hc.FractionDigits(0, 1)}
+	signed := hc.Options{Precision: hc.FractionDigits(0, 1), SignDisplay: hc.SignExceptZero}
+
+	tests := []struct {
+		number   string
+		opts     hc.Options
+		expected string
+	}{
+		{"0.125", hc.Options{}, "12.5%"},
+		{"-0.03", hc.Options{}, "-3%"},
+		{"0.125", signed, "+12.5%"},
+		{"0", signed, "0%"},
+		{"-0.0001", signed, "0%"},
+		{"0", hc.Options{SignDisplay: hc.SignAlways}, "+0%"},
+		{"-0.0001", hc.Options{Precision: hc.FractionDigits(0, 0), SignDisplay: hc.SignAlways}, "-0%"},
+		{"-0.0001", hc.Options{Precision: hc.FractionDigits(0, 0)}, "-0%"},
+		{"0.0001", hc.Options{Precision: hc.FractionDigits(0, 0), SignDisplay: hc.SignAlways}, "+0%"},
+		{"-0.03", hc.Options{SignDisplay: hc.SignNever}, "3%"},
+		{"0.33333", oneDigit, "33.3%"},
+		{"12", hc.Options{}, "1.2K%"},
+		{"12.345", oneDigit, "1.2K%"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatPercent(tt.number, language.English, tt.opts)
+		if err != nil {
+			t.Errorf("[PERCENT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[PERCENT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	if res, _ := h.FormatPermille("0.125", language.English, hc.Options{}); res != "125‰" {
+		t.Errorf("[PERMILLE] got %q, want %q", res, "125‰")
+	}
+	if res, _ := h.FormatBasisPoints("0.0025", language.English, hc.Options{}); res != "25‱" {
+		t.Errorf("[BASIS POINTS] got %q, want %q", res, "25‱")
+	}
+}
+
+func TestHumanizeEnOptions(t *testing.T) {
+	tests := []struct {
+		number   string
+		opts     hc.Options
+		expected string
+	}{
+		{"1234", hc.Options{Precision: hc.FractionDigits(0, 1)}, "1.2K"},
+		{"1234567.89", hc.Options{Precision: hc.FractionDigits(0, 1)}, "1.2M"},
+		{"-1250000", hc.Options{Precision: hc.FractionDigits(0, 1)}, "-1.2M"},
+		{"-1250000", hc.Options{Precision: hc.FractionDigits(0, 1), Rounding: hc.RoundHalfUp}, "-1.3M"},
+		{"1299999", hc.Options{Precision: hc.FractionDigits(0, 1), Rounding: hc.RoundDown}, "1.2M"},
+		{"1000000", hc.Options{Precision: hc.FractionDigits(1, 1), SignDisplay: hc.SignAlways}, "+1.0M"},
+		{"-1000", hc.Options{}, "-1K"},
+		{"999", hc.Options{Precision: hc.FractionDigits(0, 1)}, "999"}, // fallback
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.English, tt.opts)
+		if err != nil {
+			t.Errorf("[OPTIONS] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[OPTIONS] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEnUnit(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		expected string
+	}{
+		{hc.Long, "1", "kilometer", "1 kilometer"},
+		{hc.Long, "1234", "kilometer", "1.2 thousand kilometers"},
+		{hc.Long, "3400000", "byte", "3.4 million bytes"},
+		{hc.Short, "1234", "kilometer", "1.2K km"},
+		{hc.Short, "-2.5", "hour", "-2.5 hr"},
+		{hc.Narrow, "5", "kilometer", "5km"},
+		{hc.Narrow, "1200", "gram", "1.2Kg"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatUnit(tt.number, tt.unit, language.English, hc.Options{Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[UNIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[UNIT] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	if _, err := h.FormatUnit("1", "parsec", language.English, hc.Options{}); err == nil {
+		t.Errorf("[UNIT] unknown unit => expected error")
+	}
+}
+
+func TestHumanizeEnCompoundUnit(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		expected string
+	}{
+		{hc.Short, "1234", "per-second", "1.2K/s"},
+		{hc.Short, "3000000", "per-minute", "3M/min"},
+		{hc.Short, "1234", "megabyte-per-second", "1.2K MB/s"},
+		{hc.Short, "90", "kilometer-per-hour", "90 km/h"},
+		{hc.Long, "1", "kilometer-per-hour", "1 kilometer per hour"},
+		{hc.Long, "1200", "byte-per-acre", "1.2 thousand bytes per acre"},
+		{hc.Narrow, "1234", "per-second", "1.2K/s"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatUnit(tt.number, tt.unit, language.English, hc.Options{Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[UNIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[UNIT] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEnMeasure(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		usage    string
+		locale   string
+		expected string
+	}{
+		{hc.Short, "1200", "kilometer", "", "en-US", "745.6 mi"},
+		{hc.Short, "1200", "kilometer", "", "en-US-u-ms-metric", "1.2K km"},
+		{hc.Short, "1200", "mile", "", "en-u-ms-metric", "1.9K km"},
+		{hc.Short, "500", "meter", "", "en-US-u-ms-metric", "500 m"},
+		{hc.Short, "100", "meter", "road", "en-US", "328.1 ft"},
+		{hc.Short, "160", "centimeter", "person-height", "en-US", "5 ft, 3 in"},
+		{hc.Short, "182.8", "centimeter", "person-height", "en-US", "6 ft, 0 in"},
+		{hc.Long, "160", "centimeter", "person-height", "en-GB", "5 feet, 3 inches"},
+		{hc.Narrow, "160", "centimeter", "person-height", "en-US", "5′ 3″"},
+		{hc.Long, "70", "kilogram", "person", "en-GB", "11 stones, 0 pounds"},
+		{hc.Short, "20", "celsius", "", "en-US", "68°F"},
+		{hc.Short, "-40", "celsius", "", "en", "-40°F"},
+		{hc.Short, "5", "second", "", "en-US", "5 sec"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatMeasure(tt.number, tt.unit, tt.usage, language.MustParse(tt.locale), hc.Options{})
+		if err != nil {
+			t.Errorf("[MEASURE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[MEASURE] number %q %s in %s => got %q, want %q", tt.number, tt.unit, tt.locale, res, tt.expected)
+		}
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	if _, err := h.FormatMeasure("1", "kilogram", "", language.AmericanEnglish, hc.Options{}); err != nil {
+		t.Errorf("[MEASURE] en-US => unexpected error: %v", err)
+	}
+	if _, err := hc.ConvertUnit(decimal.MustParse("1"), "kilogram", "meter"); err == nil {
+		t.Errorf("[MEASURE] kilogram to meter => expected error")
+	}
+}
+
+func TestHumanizeEnDuration(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		duration time.Duration
+		maxUnits int
+		expected string
+	}{
+		{hc.Short, 90 * time.Minute, 0, "1.5 hr"},
+		{hc.Short, 90 * time.Minute, 2, "1 hr, 30 min"},
+		{hc.Narrow, 90 * time.Minute, 2, "1h 30m"},
+		{hc.Long, 72 * time.Hour, 0, "3 days"},
+		{hc.Long, time.Hour, 0, "1 hour"},
+		{hc.Short, 1500 * time.Microsecond, 0, "1.5 ms"},
+		{hc.Short, -2 * time.Second, 0, "-2 sec"},
+		{hc.Short, 0, 2, "0 sec"},
+		{hc.Short, 59*time.Minute + 59*time.Second + 990*time.Millisecond, 0, "1 hr"},
+		{hc.Short, 59*time.Minute + 59*time.Second + 990*time.Millisecond, 3, "59 min, 59 sec, 990 ms"},
+		{hc.Short, 2*time.Hour + 5*time.Second, 3, "2 hr, 5 sec"},
+		{hc.Short, 250 * 24 * 365 * time.Hour, 0, "249.8 yrs"},
+		{hc.Short, 400 * time.Microsecond, 0, "0.4 ms"},
+		{hc.Short, 20 * time.Nanosecond, 0, "0 ms"},
+		{hc.Short, 500 * time.Microsecond, 2, "0.5 ms"},
+		{hc.Short, 1500 * time.Millisecond, 2, "1 sec, 500 ms"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatDuration(tt.duration, language.English, hc.Options{MaxUnits: tt.maxUnits})
+		if err != nil {
+			t.Errorf("[DURATION] duration %v => unexpected error: %v", tt.duration, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[DURATION] duration %v => got %q, want %q", tt.duration, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEnRelative(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		numeric  hc.NumericDisplay
+		expected string
+	}{
+		{hc.Long, "-5", "minute", hc.NumericAlways, "5 minutes ago"},
+		{hc.Long, "2", "week", hc.NumericAlways, "in 2 weeks"},
+		{hc.Long, "1234", "year", hc.NumericAlways, "in 1.2 thousand years"},
+		{hc.Short, "1234", "year", hc.NumericAlways, "in 1.2K yr."},
+		{hc.Narrow, "-5", "minute", hc.NumericAlways, "5m ago"},
+		{hc.Long, "-1", "day", hc.NumericAlways, "1 day ago"},
+		{hc.Long, "-1", "day", hc.NumericAuto, "yesterday"},
+		{hc.Long, "1", "day", hc.NumericAuto, "tomorrow"},
+		{hc.Long, "0", "year", hc.NumericAuto, "this year"},
+		{hc.Long, "-2", "day", hc.NumericAuto, "2 days ago"},
+		{hc.Short, "1.5", "hour", hc.NumericAuto, "in 1.5 hr."},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatRelative(tt.number, tt.unit, language.English, hc.Options{Numeric: tt.numeric, Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[RELATIVE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[RELATIVE] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+
+	durations := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{-3*time.Hour - 10*time.Minute, "3 hours ago"},
+		{-400 * time.Millisecond, "0 seconds ago"},
+		{400 * time.Millisecond, "in 0 seconds"},
+		{59*time.Minute + 40*time.Second, "in 1 hour"},
+		{-(23*time.Hour + 50*time.Minute), "1 day ago"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+	for _, tt := range durations {
+		res, err := h.FormatRelativeDuration(tt.duration, language.English, hc.Options{})
+		if err != nil || res != tt.expected {
+			t.Errorf("[RELATIVE] duration %v => got %q, %v, want %q", tt.duration, res, err, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEnOrdinal(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "1st"},
+		{"2", "2nd"},
+		{"3", "3rd"},
+		{"4", "4th"},
+		{"11", "11th"},
+		{"12", "12th"},
+		{"13", "13th"},
+		{"22", "22nd"},
+		{"101", "101st"},
+		{"111", "111th"},
+		{"1234567", "1,234,567th"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatOrdinal(tt.number, language.English, hc.Options{})
+		if err != nil {
+			t.Errorf("[ORDINAL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[ORDINAL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	if _, err := h.FormatOrdinal("1.5", language.English, hc.Options{}); err == nil {
+		t.Errorf("[ORDINAL] number %q => expected error", "1.5")
+	}
+}
+
+func TestHumanizeEnSpellOut(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"0", "zero"},
+		{"5", "five"},
+		{"21", "twenty-one"},
+		{"101", "one hundred one"},
+		{"1200", "one thousand two hundred"},
+		{"123456", "one hundred twenty-three thousand four hundred fifty-six"},
+		{"-3", "minus three"},
+		{"1.5", "one point five"},
+		{"2.05", "two point zero five"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+
+	for _, tt := range tests {
+		res, err := h.SpellOut(tt.number, language.English, "")
+		if err != nil {
+			t.Errorf("[SPELLOUT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELLOUT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	if _, err := h.SpellOut("5", language.English, "spellout-unknown"); err == nil {
+		t.Errorf("[SPELLOUT] rule set %q => expected error", "spellout-unknown")
+	}
+}
+
+func TestHumanizeEnSpellSmallNumbers(t *testing.T) {
+	tests := []struct {
+		number   string
+		unit     string // empty for a plain number
+		expected string
+	}{
+		{"5", "", "five"},
+		{"-5", "", "-five"},
+		{"10", "", "ten"},
+		{"11", "", "11"},
+		{"5000", "", "5 thousand"},
+		{"1", "day", "one day"},
+		{"3", "day", "three days"},
+		{"2.5", "day", "2.5 days"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+	opts := hc.Options{SpellSmallNumbers: 10}
+
+	for _, tt := range tests {
+		var res string
+		var err error
+		if tt.unit == "" {
+			res, _, err = h.FormatDecimalOptions(decimal.MustParse(tt.number), language.English, opts)
+		} else {
+			res, err = h.FormatUnit(tt.number, tt.unit, language.English, opts)
+		}
+		if err != nil {
+			t.Errorf("[SPELL SMALL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELL SMALL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	if res, _ := h.FormatRelative("-2", "week", language.English, opts); res != "two weeks ago" {
+		t.Errorf("[SPELL SMALL] relative %q => got %q, want %q", "-2", res, "two weeks ago")
+	}
+
+	short := hc.New(locales, hc.Short, fallback)
+	if res, _ := short.FormatUnit("3", "day", language.English, opts); res != "3 days" {
+		t.Errorf("[SPELL SMALL] short %q => got %q, want %q", "3", res, "3 days")
+	}
+}
+
+func TestHumanizeEnCount(t *testing.T) {
+	followers := hc.Noun{"one": "follower", "other": "followers"}
+
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"0", "0 followers"},
+		{"1", "1 follower"},
+		{"2", "2 followers"},
+		{"1000", "1K followers"},
+		{"1234", "1.2K followers"},
+		{"1500000", "1.5M followers"},
+		{"-1", "-1 follower"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1)}
+
+	for _, tt := range tests {
+		res, err := h.FormatCount(tt.number, followers, language.English, opts)
+		if err != nil {
+			t.Errorf("[COUNT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[COUNT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	catalog := hc.NounCatalog{"followers": {language.English: followers}}
+	if res, _ := h.FormatCountKey("1", "followers", catalog, language.MustParse("en-GB"), opts); res != "1 follower" {
+		t.Errorf("[COUNT] key %q => got %q, want %q", "followers", res, "1 follower")
+	}
+	if _, err := h.FormatCountKey("1", "likes", catalog, language.English, opts); err == nil {
+		t.Errorf("[COUNT] key %q => expected error", "likes")
+	}
+
+	requests := hc.Noun{"other": "req"}
+	if res, _ := h.FormatCountPer("3000000", requests, "minute", language.English, opts); res != "3M req/min" {
+		t.Errorf("[COUNT] per %q => got %q, want %q", "minute", res, "3M req/min")
+	}
+	if _, err := h.FormatCountPer("3", requests, "fortnight", language.English, opts); err == nil {
+		t.Errorf("[COUNT] per %q => expected error", "fortnight")
+	}
+}
+
+func TestHumanizeEnMessage(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		args     map[string]any
+		expected string
+	}{
+		{"{count, plural, one {# follower} other {# followers}}", map[string]any{"count": 1}, "1 follower"},
+		{"{count, plural, one {# follower} other {# followers}}", map[string]any{"count": 1234}, "1.2K followers"},
+		{"{count, plural, =0 {no followers} one {# follower} other {# followers}}", map[string]any{"count": 0}, "no followers"},
+		{"{count, plural, offset:1 =1 {{name}} one {{name} and # other} other {{name} and # others}}", map[string]any{"count": 2, "name": "Ann"}, "Ann and 1 other"},
+		{"{count, plural, offset:1 =1 {{name}} one {{name} and # other} other {{name} and # others}}", map[string]any{"count": 1501, "name": "Ann"}, "Ann and 1.5K others"},
+		{"{n, number, compact-short} / {n, number, compact-long} / {n, number} / {n, number, integer}", map[string]any{"n": "1234.5"}, "1.2K / 1.2 thousand / 1,234.5 / 1,234"},
+		{"{n, number, ::compact-short}", map[string]any{"n": 2500000}, "2.5M"},
+		{"{n, number, percent}", map[string]any{"n": 0.25}, "25%"},
+		{"{place, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", map[string]any{"place": 22}, "22nd"},
+		{"{place, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}", map[string]any{"place": 1113}, "1,113th"},
+		{"{gender, select, female {She} male {He} other {They}} replied", map[string]any{"gender": "female"}, "She replied"},
+		{"{gender, select, female {She} male {He} other {They}} replied", map[string]any{"gender": "x"}, "They replied"},
+		{"{count, plural, other {{gender, select, female {# of hers} other {# of theirs}}}}", map[string]any{"count": 5, "gender": "female"}, "5 of hers"},
+		{"It''s '{'literal'}' and # here", nil, "It's {literal} and # here"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		m, err := hc.ParseMessage(tt.pattern)
+		if err != nil {
+			t.Errorf("[MESSAGE] pattern %q => unexpected error: %v", tt.pattern, err)
+			continue
+		}
+		res, err := h.FormatMessage(m, tt.args, language.English, hc.Options{})
+		if err != nil {
+			t.Errorf("[MESSAGE] pattern %q => unexpected error: %v", tt.pattern, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[MESSAGE] pattern %q => got %q, want %q", tt.pattern, res, tt.expected)
+		}
+	}
+
+	for _, pattern := range []string{
+		"{count, plural, one {# follower}}",
+		"{count, choice, 0#none}",
+		"{count, number, currency}",
+		"{count",
+		"text}",
+	} {
+		if _, err := hc.ParseMessage(pattern); err == nil {
+			t.Errorf("[MESSAGE] pattern %q => expected syntax error", pattern)
+		}
+	}
+}
+
+func TestHumanizeEnRange(t *testing.T) {
+	tests := []struct {
+		lo, hi   string
+		expected string
+	}{
+		{"3", "5", "3–5"},
+		{"1000", "5000", "1–5K"},
+		{"1000", "2000000", "1K – 2M"},
+		{"5", "5000", "5–5K"},
+		{"-5", "5", "-5 – 5"},
+		{"5000", "1000", "1–5K"},
+		{"-1000", "5000", "-1K – 5K"},
+		{"1200", "1240", "~1.2K"},
+		{"3", "3", "3"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatRange(tt.lo, tt.hi, language.English, hc.Options{})
+		if err != nil {
+			t.Errorf("[RANGE] range %q-%q => unexpected error: %v", tt.lo, tt.hi, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[RANGE] range %q-%q => got %q, want %q", tt.lo, tt.hi, res, tt.expected)
+		}
+	}
+
+	long := hc.New(locales, hc.Long, fallback)
+	if res, _ := long.FormatRange("1000", "5000", language.English, hc.Options{}); res != "1–5 thousand" {
+		t.Errorf("[RANGE] long => got %q, want %q", res, "1–5 thousand")
+	}
+
+	followers := hc.Noun{"one": "follower", "other": "followers"}
+	if res, _ := h.FormatCountRange("0.5", "1", followers, language.English, hc.Options{}); res != "0.5–1 followers" {
+		t.Errorf("[RANGE] count => got %q, want %q", res, "0.5–1 followers")
+	}
+}
+
+func TestHumanizeEnApproximately(t *testing.T) {
+	tests := []struct {
+		number        string
+		expected      string
+		approximate   bool
+		relativeError float64
+	}{
+		{"1200000", "1.2M", false, 0},
+		{"1234567", "~1.2M", true, 0.028},
+		{"-1234567", "~-1.2M", true, 0.028},
+		{"999", "999", false, 0},
+		{"1050", "~1K", true, 0.048},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1), Approximately: true}
+
+	for _, tt := range tests {
+		res, err := h.FormatResult(decimal.MustParse(tt.number), language.English, opts)
+		if err != nil {
+			t.Errorf("[APPROX] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res.Text != tt.expected || res.Approximate != tt.approximate {
+			t.Errorf("[APPROX] number %q => got %q (%v), want %q (%v)", tt.number, res.Text, res.Approximate, tt.expected, tt.approximate)
+		}
+		if math.Abs(res.RelativeError-tt.relativeError) > 0.001 {
+			t.Errorf("[APPROX] number %q => relative error %v, want %v", tt.number, res.RelativeError, tt.relativeError)
+		}
+	}
+
+	if res, _ := h.FormatUnit("1234567", "meter", language.English, opts); res != "~1.2M m" {
+		t.Errorf("[APPROX] unit => got %q, want %q", res, "~1.2M m")
+	}
+}
+
+func TestHumanizeEnCap(t *testing.T) {
+	tests := []struct {
+		number   string
+		cap      int64
+		expected string
+	}{
+		{"998", 999, "998"},
+		{"999", 999, "999"},
+		{"1000", 999, "999+"},
+		{"150000", 99000, "99K+"},
+		{"98950", 99000, "98.9K"},
+		{"2500000", 1000000, "1M+"},
+		{"1999", 1000000, "1.9K"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		opts := hc.Options{Precision: hc.FractionDigits(0, 1), Cap: tt.cap}
+		res, err := h.FormatResult(decimal.MustParse(tt.number), language.English, opts)
+		if err != nil {
+			t.Errorf("[CAP] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res.Text != tt.expected {
+			t.Errorf("[CAP] number %q => got %q, want %q", tt.number, res.Text, tt.expected)
+		}
+	}
+
+	res, err := h.FormatResult(decimal.MustParse("1000"), language.English, hc.Options{Cap: 999})
+	if err != nil || res.Text != "999+" || res.Fallback {
+		t.Errorf("[CAP] exact => got %+v, %v, want %q", res, err, "999+")
+	}
+
+	notifications := hc.Noun{"one": "notification", "other": "notifications"}
+	opts := hc.Options{Cap: 99}
+	if res, _ := h.FormatCount("250", notifications, language.English, opts); res != "99+ notifications" {
+		t.Errorf("[CAP] count => got %q, want %q", res, "99+ notifications")
+	}
+}
+
+// TestHumanizeEnSignDisplay checks the sign displays of uncompacted numbers
+// and of compact currencies; compact numbers are checked against ICU in the
+// root package.
+func TestHumanizeEnSignDisplay(t *testing.T) {
+	tests := []struct {
+		number   string
+		sign     hc.SignDisplay
+		expected string
+	}{
+		{"0", hc.SignAuto, "0"},
+		{"0", hc.SignAlways, "+0"},
+		{"0", hc.SignExceptZero, "0"},
+		{"0", hc.SignNever, "0"},
+		{"0", hc.SignAccounting, "0"},
+		{"12", hc.SignAuto, "12"},
+		{"12", hc.SignAlways, "+12"},
+		{"12", hc.SignExceptZero, "+12"},
+		{"12", hc.SignNever, "12"},
+		{"12", hc.SignAccounting, "12"},
+		{"-12", hc.SignAuto, "-12"},
+		{"-12", hc.SignAlways, "-12"},
+		{"-12", hc.SignNever, "12"},
+		{"-12", hc.SignAccounting, "(12)"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		opts := hc.Options{Precision: hc.FractionDigits(0, 1), SignDisplay: tt.sign}
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.English, opts)
+		if err != nil {
+			t.Errorf("[SIGN] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SIGN] number %q (%d) => got %q, want %q", tt.number, tt.sign, res, tt.expected)
+		}
+	}
+
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1), SignDisplay: hc.SignAccounting}
+	if res, _ := h.FormatCurrency("-1234567", "USD", language.English, opts); res != "($1.2M)" {
+		t.Errorf("[SIGN] currency => got %q, want %q", res, "($1.2M)")
+	}
+}
+
+func TestHumanizeEnSignificantDigits(t *testing.T) {
+	tests := []struct {
+		number    string
+		precision hc.Precision
+		expected  string
+	}{
+		{"1000", hc.CompactDigits(), "1K"},
+		{"1234", hc.CompactDigits(), "1.2K"},
+		{"12345", hc.CompactDigits(), "12K"},
+		{"123456", hc.CompactDigits(), "123K"},
+		{"9960", hc.CompactDigits(), "10K"},
+		{"1000", hc.SignificantDigits(2, 2), "1.0K"},
+		{"123456", hc.SignificantDigits(2, 2), "120K"},
+		{"1234", hc.SignificantDigits(1, 3), "1.23K"},
+		{"9960", hc.SignificantDigits(1, 3), "9.96K"},
+		{"1500000000", hc.SignificantDigits(1, 3), "1.5B"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.English, hc.Options{Precision: tt.precision})
+		if err != nil {
+			t.Errorf("[SIGNIFICANT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SIGNIFICANT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	opts := hc.Options{Precision: hc.SignificantDigits(2, 2)}
+	if res, _ := h.FormatUnit("0.0123", "meter", language.English, opts); res != "0.012 m" {
+		t.Errorf("[SIGNIFICANT] unit => got %q, want %q", res, "0.012 m")
+	}
+}
+
+// twoFractionDigits is a custom locale allowing two fraction digits in
+// exact compact numbers.
+type twoFractionDigits struct {
+	hc.Locale
+}
+
+func (twoFractionDigits) CompactFractionDigits() int {
+	return 2
+}
+
+func TestHumanizeEnPrecisionPolicy(t *testing.T) {
+	custom := map[language.Tag]hc.Locale{
+		language.English:                locale.Data,
+		language.MustParse("en-x-fine"): twoFractionDigits{locale.Data},
+	}
+	h := hc.New(custom, hc.Short, fallback)
+
+	tests := []struct {
+		tag      language.Tag
+		number   string
+		expected string
+	}{
+		{language.English, "1250", "1250"},
+		{language.English, "1200", "1.2K"},
+		{language.MustParse("en-x-fine"), "1250", "1.25K"},
+		{language.MustParse("en-x-fine"), "1255", "1255"},
+	}
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimal(decimal.MustParse(tt.number), tt.tag)
+		if err != nil {
+			t.Errorf("[POLICY] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[POLICY] %s number %q => got %q, want %q", tt.tag, tt.number, res, tt.expected)
+		}
+	}
+}
+
+// compactFromTenThousand is a custom locale compacting numbers from
+// 10,000 up.
+type compactFromTenThousand struct {
+	hc.Locale
+}
+
+func (l compactFromTenThousand) Data() hc.CldrData {
+	data := l.Locale.Data()
+	data.CompactThreshold = 10000
+	return data
+}
+
+func TestHumanizeEnCompactThreshold(t *testing.T) {
+	custom := map[language.Tag]hc.Locale{
+		language.English:                 locale.Data,
+		language.MustParse("en-x-large"): compactFromTenThousand{locale.Data},
+	}
+	h := hc.New(custom, hc.Short, fallback)
+
+	tests := []struct {
+		tag      language.Tag
+		number   string
+		opts     hc.Options
+		expected string
+	}{
+		{language.English, "5000", hc.Options{}, "5K"},
+		{language.English, "5000", hc.Options{CompactThreshold: 10000}, "5,000"},
+		{language.English, "1234.5", hc.Options{CompactThreshold: 10000}, "1,234.5"},
+		{language.MustParse("en-x-large"), "5000", hc.Options{}, "5,000"},
+		{language.MustParse("en-x-large"), "50000", hc.Options{}, "50K"},
+		{language.MustParse("en-x-large"), "50000", hc.Options{CompactThreshold: 100000}, "50,000"},
+	}
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), tt.tag, tt.opts)
+		if err != nil {
+			t.Errorf("[THRESHOLD] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[THRESHOLD] %s number %q => got %q, want %q", tt.tag, tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEnFixedScale(t *testing.T) {
+	values := []string{"950000", "1200000", "30000000", "-45000"}
+	expected := []string{"0.95M", "1.2M", "30M", "-0.05M"}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	scale, name, err := h.CommonScaleName(values, language.English)
+	if err != nil {
+		t.Fatalf("[SCALE] unexpected error: %v", err)
+	}
+	if scale != 6 || name != "M" {
+		t.Errorf("[SCALE] common scale => got %d %q, want %d %q", scale, name, 6, "M")
+	}
+
+	opts := hc.Options{Precision: hc.FractionDigits(0, 2), Rounding: hc.RoundHalfUp, FixedScale: scale}
+	for i, value := range values {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(value), language.English, opts)
+		if err != nil {
+			t.Errorf("[SCALE] number %q => unexpected error: %v", value, err)
+			continue
+		}
+		if res != expected[i] {
+			t.Errorf("[SCALE] number %q => got %q, want %q", value, res, expected[i])
+		}
+	}
+
+	// Without a precision the mantissa has at most two fraction digits.
+	defaults := []struct {
+		number   string
+		expected string
+	}{
+		{"12", "0M"},
+		{"-12", "-0M"},
+		{"123456789012", "123,456.79M"},
+	}
+	for _, tt := range defaults {
+		res, _, _ := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.English, hc.Options{FixedScale: 6})
+		if res != tt.expected {
+			t.Errorf("[SCALE] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	if scale, name, _ := h.CommonScaleName([]string{"12", "999"}, language.English); scale != 0 || name != "" {
+		t.Errorf("[SCALE] small values => got %d %q, want 0 \"\"", scale, name)
+	}
+
+	long := hc.New(locales, hc.Long, fallback)
+	if _, name, _ := long.CommonScaleName(values, language.English); name != "million" {
+		t.Errorf("[SCALE] long name => got %q, want %q", name, "million")
+	}
+}
+
+func TestHumanizeEnTicks(t *testing.T) {
+	tests := []struct {
+		min, max string
+		count    int
+		expected []string
+	}{
+		{"0", "7500000", 4, []string{"0.0", "2.5M", "5.0M", "7.5M"}},
+		{"0", "7300000", 5, []string{"0.0", "2.5M", "5.0M", "7.5M"}},
+		{"-1200", "3400", 5, []string{"-2K", "-1K", "0", "1K", "2K", "3K", "4K"}},
+		{"0.1", "0.93", 5, []string{"0.00", "0.25", "0.50", "0.75", "1.00"}},
+		{"0", "100", 6, []string{"0", "20", "40", "60", "80", "100"}},
+		{"123456789", "987654321", 6, []string{"0.0", "0.2B", "0.4B", "0.6B", "0.8B", "1.0B"}},
+		{"1000000", "1000100", 5, []string{"1.000000M", "1.000025M", "1.000050M", "1.000075M", "1.000100M"}},
+		{"5", "5", 3, []string{"5"}},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		ticks, err := h.FormatTicks(tt.min, tt.max, tt.count, language.English)
+		if err != nil {
+			t.Errorf("[TICKS] %s–%s => unexpected error: %v", tt.min, tt.max, err)
+			continue
+		}
+		labels := make([]string, len(ticks))
+		for i, tick := range ticks {
+			labels[i] = tick.Label
+		}
+		if strings.Join(labels, " ") != strings.Join(tt.expected, " ") {
+			t.Errorf("[TICKS] %s–%s => got %q, want %q", tt.min, tt.max, labels, tt.expected)
+		}
+	}
+
+	ticks, _ := h.FormatTicks("0", "7500000", 4, language.English)
+	if len(ticks) != 4 || ticks[1].Value.String() != "2500000" {
+		t.Errorf("[TICKS] values => got %v", ticks)
+	}
+
+	for _, count := range []int{-1, 0, 1} {
+		if _, err := h.FormatTicks("0", "100", count, language.English); err == nil {
+			t.Errorf("[TICKS] count %d => expected an error", count)
+		}
+	}
+}
+
+func TestHumanizeEnComposite(t *testing.T) {
+	tests := []struct {
+		style    hc.Option
+		number   string
+		maxUnits int
+		expected string
+	}{
+		{hc.Long, "1234567", 0, "1 million 234 thousand 567"},
+		{hc.Long, "1234567", 2, "1 million 235 thousand"},
+		{hc.Long, "1000000", 0, "1 million"},
+		{hc.Long, "2000500", 0, "2 million 500"},
+		{hc.Long, "12345.67", 0, "12 thousand 345.67"},
+		{hc.Long, "199996000", 1, "200 million"},
+		{hc.Short, "123456789", 0, "123M 456K 789"},
+		{hc.Short, "-1234567", 2, "-1M 235K"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.style, fallback)
+		opts := hc.Options{Composite: true, MaxUnits: tt.maxUnits}
+		res, err := h.FormatResult(decimal.MustParse(tt.number), language.English, opts)
+		if err != nil {
+			t.Errorf("[COMPOSITE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res.Text != tt.expected {
+			t.Errorf("[COMPOSITE] number %q (%d) => got %q, want %q", tt.number, tt.maxUnits, res.Text, tt.expected)
+		}
+		if res.Approximate != (tt.maxUnits > 0) {
+			t.Errorf("[COMPOSITE] number %q (%d) => approximate %v", tt.number, tt.maxUnits, res.Approximate)
+		}
+	}
+}
+
+func TestHumanizeEnFit(t *testing.T) {
+	tests := []struct {
+		number   string
+		width    int
+		expected string
+	}{
+		{"1234567", 12, "1,234,567"},
+		{"1234567", 6, "1.23M"},
+		{"1234567", 4, "1.2M"},
+		{"1234567", 3, "1M"},
+		{"1000", 12, "1 thousand"},
+		{"1000", 5, "1K"},
+		{"1234", 5, "1,234"},
+		{"-1234567", 5, "-1.2M"},
+		{"1000000000000000000", 10, "1,000,000T"},
+		{"1000000000000000000", 9, "999,999T+"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatFit(tt.number, language.English, tt.width)
+		if err != nil {
+			t.Errorf("[FIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[FIT] number %q (%d) => got %q, want %q", tt.number, tt.width, res, tt.expected)
+		}
+	}
+
+	// Only the cap one magnitude below the number is tried.
+	for _, tt := range []struct {
+		number string
+		width  int
+	}{
+		{"1234567", 1},
+		{"1000000000000000000", 8},
+		{"123456789", 3},
+		{"123456789012", 2},
+		{"999", 2},
+	} {
+		if res, err := h.FormatFit(tt.number, language.English, tt.width); err == nil {
+			t.Errorf("[FIT] number %q (%d) => got %q, expected an error", tt.number, tt.width, res)
+		}
+	}
+}
@@ -33,6 +33,31 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-one": "n % 10 = 1 and n % 100 != 11",
+// "pluralRule-count-two": "n % 10 = 2 and n % 100 != 12",
+// "pluralRule-count-few": "n % 10 = 3 and n % 100 != 13"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	i10 := i64 % 10
+	i100 := i64 % 100
+
+	switch {
+	case i10 == 1 && i100 != 11:
+		return "one"
+	case i10 == 2 && i100 != 12:
+		return "two"
+	case i10 == 3 && i100 != 13:
+		return "few"
+	default:
+		return "other"
+	}
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.English,
 	data: hc.CldrData{
@@ -92,5 +117,89 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000T",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "¤#,##0.00",
+			Accounting: "¤#,##0.00;(¤#,##0.00)",
+			Short: map[string]string{
+				"1000-count-one":              "¤0K",
+				"1000-count-other":            "¤0K",
+				"10000-count-one":             "¤00K",
+				"10000-count-other":           "¤00K",
+				"100000-count-one":            "¤000K",
+				"100000-count-other":          "¤000K",
+				"1000000-count-one":           "¤0M",
+				"1000000-count-other":         "¤0M",
+				"10000000-count-one":          "¤00M",
+				"10000000-count-other":        "¤00M",
+				"100000000-count-one":         "¤000M",
+				"100000000-count-other":       "¤000M",
+				"1000000000-count-one":        "¤0B",
+				"1000000000-count-other":      "¤0B",
+				"10000000000-count-one":       "¤00B",
+				"10000000000-count-other":     "¤00B",
+				"100000000000-count-one":      "¤000B",
+				"100000000000-count-other":    "¤000B",
+				"1000000000000-count-one":     "¤0T",
+				"1000000000000-count-other":   "¤0T",
+				"10000000000000-count-one":    "¤00T",
+				"10000000000000-count-other":  "¤00T",
+				"100000000000000-count-one":   "¤000T",
+				"100000000000000-count-other": "¤000T",
+			},
+			Symbols: map[string]string{
+				"AUD": "A$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"USD": "$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"one": "{0}st", "two": "{0}nd", "few": "{0}rd", "other": "{0}th"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
+		PluralRanges: map[string]string{
+			"other-one": "other",
+		},
 	},
 }
@@ -0,0 +1,64 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+-x: minus >%spellout-numbering>;
+Inf: infinity;
+NaN: not a number;
+%spellout-cardinal:
+0: zero;
+1: one;
+2: two;
+3: three;
+4: four;
+5: five;
+6: six;
+7: seven;
+8: eight;
+9: nine;
+10: ten;
+11: eleven;
+12: twelve;
+13: thirteen;
+14: fourteen;
+15: fifteen;
+16: sixteen;
+17: seventeen;
+18: eighteen;
+19: nineteen;
+20: twenty;
+21: twenty->%spellout-cardinal>;
+30: thirty;
+31: thirty->%spellout-cardinal>;
+40: forty;
+41: forty->%spellout-cardinal>;
+50: fifty;
+51: fifty->%spellout-cardinal>;
+60: sixty;
+61: sixty->%spellout-cardinal>;
+70: seventy;
+71: seventy->%spellout-cardinal>;
+80: eighty;
+81: eighty->%spellout-cardinal>;
+90: ninety;
+91: ninety->%spellout-cardinal>;
+100: <%spellout-cardinal< hundred;
+101: <%spellout-cardinal< hundred >%spellout-cardinal>;
+1000: <%spellout-cardinal< thousand;
+1001: <%spellout-cardinal< thousand >%spellout-cardinal>;
+1000000: <%spellout-cardinal< million;
+1000001: <%spellout-cardinal< million >%spellout-cardinal>;
+1000000000: <%spellout-cardinal< billion;
+1000000001: <%spellout-cardinal< billion >%spellout-cardinal>;
+1000000000000: <%spellout-cardinal< trillion;
+1000000000001: <%spellout-cardinal< trillion >%spellout-cardinal>;
+1000000000000000: <%spellout-cardinal< quadrillion;
+1000000000000001: <%spellout-cardinal< quadrillion >%spellout-cardinal>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal>;
+x.x: <%spellout-cardinal< point >%spellout-cardinal>;
+Inf: infinite;
+NaN: not a number;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} year", "other": "in {0} years"},
+			Past:     map[string]string{"one": "{0} year ago", "other": "{0} years ago"},
+			Relative: map[string]string{"-1": "last year", "0": "this year", "1": "next year"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} yr."},
+			Past:     map[string]string{"other": "{0} yr. ago"},
+			Relative: map[string]string{"-1": "last yr.", "0": "this yr.", "1": "next yr."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}y"},
+			Past:     map[string]string{"other": "{0}y ago"},
+			Relative: map[string]string{"-1": "last yr.", "0": "this yr.", "1": "next yr."},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} quarter", "other": "in {0} quarters"},
+			Past:     map[string]string{"one": "{0} quarter ago", "other": "{0} quarters ago"},
+			Relative: map[string]string{"-1": "last quarter", "0": "this quarter", "1": "next quarter"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} qtr.", "other": "in {0} qtrs."},
+			Past:     map[string]string{"one": "{0} qtr. ago", "other": "{0} qtrs. ago"},
+			Relative: map[string]string{"-1": "last qtr.", "0": "this qtr.", "1": "next qtr."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}q"},
+			Past:     map[string]string{"other": "{0}q ago"},
+			Relative: map[string]string{"-1": "last qtr.", "0": "this qtr.", "1": "next qtr."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} month", "other": "in {0} months"},
+			Past:     map[string]string{"one": "{0} month ago", "other": "{0} months ago"},
+			Relative: map[string]string{"-1": "last month", "0": "this month", "1": "next month"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} mo."},
+			Past:     map[string]string{"other": "{0} mo. ago"},
+			Relative: map[string]string{"-1": "last mo.", "0": "this mo.", "1": "next mo."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}mo"},
+			Past:     map[string]string{"other": "{0}mo ago"},
+			Relative: map[string]string{"-1": "last mo.", "0": "this mo.", "1": "next mo."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} week", "other": "in {0} weeks"},
+			Past:     map[string]string{"one": "{0} week ago", "other": "{0} weeks ago"},
+			Relative: map[string]string{"-1": "last week", "0": "this week", "1": "next week"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} wk."},
+			Past:     map[string]string{"other": "{0} wk. ago"},
+			Relative: map[string]string{"-1": "last wk.", "0": "this wk.", "1": "next wk."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}w"},
+			Past:     map[string]string{"other": "{0}w ago"},
+			Relative: map[string]string{"-1": "last wk.", "0": "this wk.", "1": "next wk."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} day", "other": "in {0} days"},
+			Past:     map[string]string{"one": "{0} day ago", "other": "{0} days ago"},
+			Relative: map[string]string{"-1": "yesterday", "0": "today", "1": "tomorrow"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} day", "other": "in {0} days"},
+			Past:     map[string]string{"one": "{0} day ago", "other": "{0} days ago"},
+			Relative: map[string]string{"-1": "yesterday", "0": "today", "1": "tomorrow"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}d"},
+			Past:     map[string]string{"other": "{0}d ago"},
+			Relative: map[string]string{"-1": "yesterday", "0": "today", "1": "tomorrow"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} hour", "other": "in {0} hours"},
+			Past:     map[string]string{"one": "{0} hour ago", "other": "{0} hours ago"},
+			Relative: map[string]string{"0": "this hour"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} hr."},
+			Past:     map[string]string{"other": "{0} hr. ago"},
+			Relative: map[string]string{"0": "this hour"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}h"},
+			Past:     map[string]string{"other": "{0}h ago"},
+			Relative: map[string]string{"0": "this hour"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} minute", "other": "in {0} minutes"},
+			Past:     map[string]string{"one": "{0} minute ago", "other": "{0} minutes ago"},
+			Relative: map[string]string{"0": "this minute"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} min."},
+			Past:     map[string]string{"other": "{0} min. ago"},
+			Relative: map[string]string{"0": "this minute"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}m"},
+			Past:     map[string]string{"other": "{0}m ago"},
+			Relative: map[string]string{"0": "this minute"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "in {0} second", "other": "in {0} seconds"},
+			Past:     map[string]string{"one": "{0} second ago", "other": "{0} seconds ago"},
+			Relative: map[string]string{"0": "now"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0} sec."},
+			Past:     map[string]string{"other": "{0} sec. ago"},
+			Relative: map[string]string{"0": "now"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "in {0}s"},
+			Past:     map[string]string{"other": "{0}s ago"},
+			Relative: map[string]string{"0": "now"},
+		},
+	},
+}
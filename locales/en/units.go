@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} acre", "other": "{0} acres", "per": "{0} per acre"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} bit", "other": "{0} bits", "per": "{0} per bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0}bit", "per": "{0}/bit"},
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} byte", "other": "{0} bytes", "per": "{0} per byte"},
+		Short:  map[string]string{"other": "{0} byte", "per": "{0}/byte"},
+		Narrow: map[string]string{"other": "{0}B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} degree Celsius", "other": "{0} degrees Celsius", "per": "{0} per degree Celsius"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centimeter", "other": "{0} centimeters", "per": "{0} per centimeter"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} day", "other": "{0} days", "per": "{0} per day"},
+		Short:  map[string]string{"one": "{0} day", "other": "{0} days", "per": "{0}/d"},
+		Narrow: map[string]string{"other": "{0}d", "per": "{0}/d"},
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} degree", "other": "{0} degrees", "per": "{0} per degree"},
+		Short:  map[string]string{"other": "{0} deg", "per": "{0}/deg"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} degree Fahrenheit", "other": "{0} degrees Fahrenheit", "per": "{0} per degree Fahrenheit"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} fluid ounce", "other": "{0} fluid ounces", "per": "{0} per fluid ounce"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} foot", "other": "{0} feet", "per": "{0} per foot"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} gallon", "other": "{0} gallons", "per": "{0} per gallon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal US"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} gigabit", "other": "{0} gigabits", "per": "{0} per gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigabyte", "other": "{0} gigabytes", "per": "{0} per gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} gram", "other": "{0} grams", "per": "{0} per gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} hectare", "other": "{0} hectares", "per": "{0} per hectare"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} hour", "other": "{0} hours", "per": "{0} per hour"},
+		Short:  map[string]string{"other": "{0} hr", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/h"},
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} inch", "other": "{0} inches", "per": "{0} per inch"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} kilobit", "other": "{0} kilobits", "per": "{0} per kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilobyte", "other": "{0} kilobytes", "per": "{0} per kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} kilogram", "other": "{0} kilograms", "per": "{0} per kilogram"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} kilometer", "other": "{0} kilometers", "per": "{0} per kilometer"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} kilometer per hour", "other": "{0} kilometers per hour"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} liter", "other": "{0} liters", "per": "{0} per liter"},
+		Short:  map[string]string{"other": "{0} L", "per": "{0}/L"},
+		Narrow: map[string]string{"other": "{0}L", "per": "{0}/L"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} liter per kilometer", "other": "{0} liters per kilometer"},
+		Short:  map[string]string{"other": "{0} L/km"},
+		Narrow: map[string]string{"other": "{0}L/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} megabit", "other": "{0} megabits", "per": "{0} per megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} megabyte", "other": "{0} megabytes", "per": "{0} per megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} meter", "other": "{0} meters", "per": "{0} per meter"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} meter per second", "other": "{0} meters per second"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} microsecond", "other": "{0} microseconds", "per": "{0} per microsecond"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} mile", "other": "{0} miles", "per": "{0} per mile"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} mile per gallon", "other": "{0} miles per gallon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} mile per hour", "other": "{0} miles per hour"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"other": "{0}mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} mile-scandinavian", "other": "{0} miles-scandinavian", "per": "{0} per mile-scandinavian"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} milliliter", "other": "{0} milliliters", "per": "{0} per milliliter"},
+		Short:  map[string]string{"other": "{0} mL", "per": "{0}/mL"},
+		Narrow: map[string]string{"other": "{0}mL", "per": "{0}/mL"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} millimeter", "other": "{0} millimeters", "per": "{0} per millimeter"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} millisecond", "other": "{0} milliseconds", "per": "{0} per millisecond"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minute", "other": "{0} minutes", "per": "{0} per minute"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/min"},
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} month", "other": "{0} months", "per": "{0} per month"},
+		Short:  map[string]string{"one": "{0} mth", "other": "{0} mths", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosecond", "other": "{0} nanoseconds", "per": "{0} per nanosecond"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} ounce", "other": "{0} ounces", "per": "{0} per ounce"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} per {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} petabyte", "other": "{0} petabytes", "per": "{0} per petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} pound", "other": "{0} pounds", "per": "{0} per pound"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}#", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} second", "other": "{0} seconds", "per": "{0} per second"},
+		Short:  map[string]string{"other": "{0} sec", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} square centimeter", "other": "{0} square centimeters", "per": "{0} per square centimeter"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} square foot", "other": "{0} square feet"},
+		Short:  map[string]string{"other": "{0} sq ft"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} square kilometer", "other": "{0} square kilometers", "per": "{0} per square kilometer"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} square meter", "other": "{0} square meters", "per": "{0} per square meter"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} square mile", "other": "{0} square miles", "per": "{0} per square mile"},
+		Short:  map[string]string{"other": "{0} sq mi", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} stone", "other": "{0} stones", "per": "{0} per stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} terabit", "other": "{0} terabits", "per": "{0} per terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} terabyte", "other": "{0} terabytes", "per": "{0} per terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} week", "other": "{0} weeks", "per": "{0} per week"},
+		Short:  map[string]string{"one": "{0} wk", "other": "{0} wks", "per": "{0}/w"},
+		Narrow: map[string]string{"other": "{0}w", "per": "{0}/w"},
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} yard", "other": "{0} yards", "per": "{0} per yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} year", "other": "{0} years", "per": "{0} per year"},
+		Short:  map[string]string{"one": "{0} yr", "other": "{0} yrs", "per": "{0}/y"},
+		Narrow: map[string]string{"other": "{0}y", "per": "{0}/y"},
+	},
+}
@@ -7,6 +7,7 @@ import (
 
 	hc "github.com/dejurin/humanizecompact"
 	locale "github.com/dejurin/humanizecompact/locales/es"
+	"github.com/govalues/decimal"
 )
 
 func fallback(number string) string {
@@ -195,3 +196,117 @@ func TestHumanizeEsOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeEsSpellOut(t *testing.T) {
+	tests := []struct {
+		number   string
+		ruleSet  string
+		expected string
+	}{
+		{"1", "", "uno"},
+		{"21", "", "veintiuno"},
+		{"100", "", "cien"},
+		{"101", "", "ciento uno"},
+		{"1200", "", "mil doscientos"},
+		{"2000", "", "dos mil"},
+		{"1000000", "", "un millón"},
+		{"1.5", "", "uno coma cinco"},
+		{"1", "spellout-cardinal-feminine", "una"},
+		{"21", "spellout-cardinal-feminine", "veintiuna"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+
+	for _, tt := range tests {
+		res, err := h.SpellOut(tt.number, language.Spanish, tt.ruleSet)
+		if err != nil {
+			t.Errorf("[SPELLOUT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELLOUT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEsCap(t *testing.T) {
+	seguidores := hc.Noun{"one": "seguidor", "other": "seguidores"}
+
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"5000", "5\u00A0mil seguidores"},
+		{"9999", "9,9\u00A0mil seguidores"},
+		{"250000", "Más de 10\u00A0mil seguidores"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1), Cap: 10000}
+
+	for _, tt := range tests {
+		res, err := h.FormatCount(tt.number, seguidores, language.Spanish, opts)
+		if err != nil {
+			t.Errorf("[CAP] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[CAP] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEsCompactThreshold(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"999", "999"},
+		{"1234", "1234"},
+		{"9999", "9999"},
+		{"10000", "10\u00A0mil"},
+		{"12345", "12,3\u00A0mil"},
+		{"1234567", "1,2\u00A0M"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1), CompactThreshold: 10000}
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.Spanish, opts)
+		if err != nil {
+			t.Errorf("[THRESHOLD] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[THRESHOLD] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeEsSpellSmallNumbers(t *testing.T) {
+	tests := []struct {
+		number   string
+		unit     string
+		expected string
+	}{
+		{"1", "day", "un día"},
+		{"1", "week", "una semana"},
+		{"1", "hour", "una hora"},
+		{"2", "week", "dos semanas"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+	opts := hc.Options{SpellSmallNumbers: 10}
+
+	for _, tt := range tests {
+		res, err := h.FormatUnit(tt.number, tt.unit, language.Spanish, opts)
+		if err != nil {
+			t.Errorf("[SPELL SMALL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELL SMALL] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+}
@@ -54,6 +54,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Spanish,
 	data: hc.CldrData{
@@ -113,5 +118,81 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 B",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 mil ¤",
+				"1000-count-other":            "0 mil ¤",
+				"10000-count-one":             "00 mil ¤",
+				"10000-count-other":           "00 mil ¤",
+				"100000-count-one":            "000 mil ¤",
+				"100000-count-other":          "000 mil ¤",
+				"1000000-count-one":           "0 M¤",
+				"1000000-count-other":         "0 M¤",
+				"10000000-count-one":          "00 M¤",
+				"10000000-count-other":        "00 M¤",
+				"100000000-count-one":         "000 M¤",
+				"100000000-count-other":       "000 M¤",
+				"1000000000-count-one":        "0000 M¤",
+				"1000000000-count-other":      "0000 M¤",
+				"10000000000-count-one":       "00 mil M¤",
+				"10000000000-count-other":     "00 mil M¤",
+				"100000000000-count-one":      "000 mil M¤",
+				"100000000000-count-other":    "000 mil M¤",
+				"1000000000000-count-one":     "0 B¤",
+				"1000000000000-count-other":   "0 B¤",
+				"10000000000000-count-one":    "00 B¤",
+				"10000000000000-count-other":  "00 B¤",
+				"100000000000000-count-one":   "000 B¤",
+				"100000000000000-count-other": "000 B¤",
+			},
+			Symbols: map[string]string{
+				"EUR": "€",
+				"THB": "฿",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat:         "#,##0 %",
+		MinimumGroupingDigits: 2,
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} y {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} y {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} y {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}.º"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}-{1}",
+			Approximately: "~{0}",
+			AtLeast:       "Más de {0}",
+		},
+		PluralRanges: map[string]string{
+			"other-one": "other",
+		},
 	},
 }
@@ -0,0 +1,188 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: cero;
+1: uno;
+2: dos;
+3: tres;
+4: cuatro;
+5: cinco;
+6: seis;
+7: siete;
+8: ocho;
+9: nueve;
+10: diez;
+11: once;
+12: doce;
+13: trece;
+14: catorce;
+15: quince;
+16: dieciséis;
+17: dieci>%spellout-numbering>;
+20: veinte;
+21: veintiuno;
+22: veintidós;
+23: veintitrés;
+24: veinticuatro;
+25: veinticinco;
+26: veintiséis;
+27: veinti>%spellout-numbering>;
+30: treinta;
+31: treinta y >%spellout-numbering>;
+40: cuarenta;
+41: cuarenta y >%spellout-numbering>;
+50: cincuenta;
+51: cincuenta y >%spellout-numbering>;
+60: sesenta;
+61: sesenta y >%spellout-numbering>;
+70: setenta;
+71: setenta y >%spellout-numbering>;
+80: ochenta;
+81: ochenta y >%spellout-numbering>;
+90: noventa;
+91: noventa y >%spellout-numbering>;
+100: cien;
+101: ciento >%spellout-numbering>;
+200: doscientos;
+201: doscientos >%spellout-numbering>;
+300: trescientos;
+301: trescientos >%spellout-numbering>;
+400: cuatrocientos;
+401: cuatrocientos >%spellout-numbering>;
+500: quinientos;
+501: quinientos >%spellout-numbering>;
+600: seiscientos;
+601: seiscientos >%spellout-numbering>;
+700: setecientos;
+701: setecientos >%spellout-numbering>;
+800: ochocientos;
+801: ochocientos >%spellout-numbering>;
+900: novecientos;
+901: novecientos >%spellout-numbering>;
+1000: mil;
+1001: mil >%spellout-numbering>;
+2000: <%spellout-cardinal-masculine< mil;
+2001: <%spellout-cardinal-masculine< mil >%spellout-numbering>;
+1000000: un millón;
+1000001: un millón >%spellout-numbering>;
+2000000: <%spellout-cardinal-masculine< millones;
+2000001: <%spellout-cardinal-masculine< millones >%spellout-numbering>;
+1000000000000: un billón;
+1000000000001: un billón >%spellout-numbering>;
+2000000000000: <%spellout-cardinal-masculine< billones;
+2000000000001: <%spellout-cardinal-masculine< billones >%spellout-numbering>;
+1000000000000000000: =#,##0=;
+-x: menos >%spellout-numbering>;
+x.x: <%spellout-numbering< punto >%spellout-numbering>;
+x,x: <%spellout-numbering< coma >%spellout-numbering>;
+%spellout-cardinal-masculine:
+0: cero;
+1: un;
+2: =%spellout-numbering=;
+21: veintiún;
+22: =%spellout-numbering=;
+30: treinta;
+31: treinta y >%spellout-cardinal-masculine>;
+40: cuarenta;
+41: cuarenta y >%spellout-cardinal-masculine>;
+50: cincuenta;
+51: cincuenta y >%spellout-cardinal-masculine>;
+60: sesenta;
+61: sesenta y >%spellout-cardinal-masculine>;
+70: setenta;
+71: setenta y >%spellout-cardinal-masculine>;
+80: ochenta;
+81: ochenta y >%spellout-cardinal-masculine>;
+90: noventa;
+91: noventa y >%spellout-cardinal-masculine>;
+100: cien;
+101: ciento >%spellout-cardinal-masculine>;
+200: doscientos;
+201: doscientos >%spellout-cardinal-masculine>;
+300: trescientos;
+301: trescientos >%spellout-cardinal-masculine>;
+400: cuatrocientos;
+401: cuatrocientos >%spellout-cardinal-masculine>;
+500: quinientos;
+501: quinientos >%spellout-cardinal-masculine>;
+600: seis­cientos;
+601: seis­cientos >%spellout-cardinal-masculine>;
+700: sete­cientos;
+701: sete­cientos >%spellout-cardinal-masculine>;
+800: ocho­cientos;
+801: ocho­cientos >%spellout-cardinal-masculine>;
+900: nove­cientos;
+901: nove­cientos >%spellout-cardinal-masculine>;
+1000: mil;
+1001: mil >%spellout-cardinal-masculine>;
+2000: <%spellout-cardinal-masculine< mil;
+2001: <%spellout-cardinal-masculine< mil >%spellout-cardinal-masculine>;
+1000000: un millón;
+1000001: un millón >%spellout-cardinal-masculine>;
+2000000: <%spellout-cardinal-masculine< millones;
+2000001: <%spellout-cardinal-masculine< millones >%spellout-cardinal-masculine>;
+1000000000000: un billón;
+1000000000001: un billón >%spellout-cardinal-masculine>;
+2000000000000: <%spellout-cardinal-masculine< billones;
+2000000000001: <%spellout-cardinal-masculine< billones >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: menos >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< punto >%spellout-cardinal-masculine>;
+x,x: <%spellout-cardinal-masculine< coma >%spellout-cardinal-masculine>;
+%spellout-cardinal-feminine:
+0: cero;
+1: una;
+2: =%spellout-numbering=;
+21: veintiuna;
+22: =%spellout-numbering=;
+30: treinta;
+31: treinta y >%spellout-cardinal-feminine>;
+40: cuarenta;
+41: cuarenta y >%spellout-cardinal-feminine>;
+50: cincuenta;
+51: cincuenta y >%spellout-cardinal-feminine>;
+60: sesenta;
+61: sesenta y >%spellout-cardinal-feminine>;
+70: setenta;
+71: setenta y >%spellout-cardinal-feminine>;
+80: ochenta;
+81: ochenta y >%spellout-cardinal-feminine>;
+90: noventa;
+91: noventa y >%spellout-cardinal-feminine>;
+100: cien;
+101: ciento >%spellout-cardinal-feminine>;
+200: dos­cientas;
+201: dos­cientas >%spellout-cardinal-feminine>;
+300: tres­cientas;
+301: tres­cientas >%spellout-cardinal-feminine>;
+400: cuatro­cientas;
+401: cuatro­cientas >%spellout-cardinal-feminine>;
+500: quinientas;
+501: quinientas >%spellout-cardinal-feminine>;
+600: seis­cientas;
+601: seis­cientas >%spellout-cardinal-feminine>;
+700: sete­cientas;
+701: sete­cientas >%spellout-cardinal-feminine>;
+800: ocho­cientas;
+801: ocho­cientas >%spellout-cardinal-feminine>;
+900: nove­cientas;
+901: nove­cientas >%spellout-cardinal-feminine>;
+1000: mil;
+1001: mil >%spellout-cardinal-feminine>;
+2000: <%spellout-cardinal-masculine< mil;
+2001: <%spellout-cardinal-masculine< mil >%spellout-cardinal-feminine>;
+1000000: un millón;
+1000001: un millón >%spellout-cardinal-feminine>;
+2000000: <%spellout-cardinal-masculine< millones;
+2000001: <%spellout-cardinal-masculine< millones >%spellout-cardinal-feminine>;
+1000000000000: un billón;
+1000000000001: un billón >%spellout-cardinal-feminine>;
+2000000000000: <%spellout-cardinal-masculine< billones;
+2000000000001: <%spellout-cardinal-masculine< billones >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: menos >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< punto >%spellout-cardinal-feminine>;
+x,x: <%spellout-cardinal-feminine< coma >%spellout-cardinal-feminine>;
+`
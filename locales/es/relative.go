@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} año", "other": "dentro de {0} años"},
+			Past:     map[string]string{"one": "hace {0} año", "other": "hace {0} años"},
+			Relative: map[string]string{"-1": "el año pasado", "0": "este año", "1": "el próximo año"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} a"},
+			Past:     map[string]string{"other": "hace {0} a"},
+			Relative: map[string]string{"-1": "el año pasado", "0": "este año", "1": "el próximo año"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} a"},
+			Past:     map[string]string{"other": "hace {0} a"},
+			Relative: map[string]string{"-1": "el año pasado", "0": "este año", "1": "el próximo año"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} trimestre", "other": "dentro de {0} trimestres"},
+			Past:     map[string]string{"one": "hace {0} trimestre", "other": "hace {0} trimestres"},
+			Relative: map[string]string{"-1": "el trimestre pasado", "0": "este trimestre", "1": "el próximo trimestre"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} trim."},
+			Past:     map[string]string{"other": "hace {0} trim."},
+			Relative: map[string]string{"-1": "el trimestre pasado", "0": "este trimestre", "1": "el próximo trimestre"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} trim."},
+			Past:     map[string]string{"other": "hace {0} trim."},
+			Relative: map[string]string{"-1": "el trimestre pasado", "0": "este trimestre", "1": "el próximo trimestre"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} mes", "other": "dentro de {0} meses"},
+			Past:     map[string]string{"one": "hace {0} mes", "other": "hace {0} meses"},
+			Relative: map[string]string{"-1": "el mes pasado", "0": "este mes", "1": "el próximo mes"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} m"},
+			Past:     map[string]string{"other": "hace {0} m"},
+			Relative: map[string]string{"-1": "el mes pasado", "0": "este mes", "1": "el próximo mes"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} m"},
+			Past:     map[string]string{"other": "hace {0} m"},
+			Relative: map[string]string{"-1": "el mes pasado", "0": "este mes", "1": "el próximo mes"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} semana", "other": "dentro de {0} semanas"},
+			Past:     map[string]string{"one": "hace {0} semana", "other": "hace {0} semanas"},
+			Relative: map[string]string{"-1": "la semana pasada", "0": "esta semana", "1": "la próxima semana"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} sem."},
+			Past:     map[string]string{"other": "hace {0} sem."},
+			Relative: map[string]string{"-1": "sem. ant.", "0": "esta sem.", "1": "próx. sem."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} sem."},
+			Past:     map[string]string{"other": "hace {0} sem."},
+			Relative: map[string]string{"-1": "sem. ant.", "0": "esta sem.", "1": "próx. sem."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} día", "other": "dentro de {0} días"},
+			Past:     map[string]string{"one": "hace {0} día", "other": "hace {0} días"},
+			Relative: map[string]string{"-2": "anteayer", "-1": "ayer", "0": "hoy", "1": "mañana", "2": "pasado mañana"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} d"},
+			Past:     map[string]string{"other": "hace {0} d"},
+			Relative: map[string]string{"-2": "anteayer", "-1": "ayer", "0": "hoy", "1": "mañana", "2": "pasado mañana"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} d"},
+			Past:     map[string]string{"other": "hace {0} d"},
+			Relative: map[string]string{"-2": "anteayer", "-1": "ayer", "0": "hoy", "1": "mañana", "2": "pasado mañana"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} hora", "other": "dentro de {0} horas"},
+			Past:     map[string]string{"one": "hace {0} hora", "other": "hace {0} horas"},
+			Relative: map[string]string{"0": "esta hora"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} h"},
+			Past:     map[string]string{"other": "hace {0} h"},
+			Relative: map[string]string{"0": "esta hora"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} h"},
+			Past:     map[string]string{"other": "hace {0} h"},
+			Relative: map[string]string{"0": "esta hora"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} minuto", "other": "dentro de {0} minutos"},
+			Past:     map[string]string{"one": "hace {0} minuto", "other": "hace {0} minutos"},
+			Relative: map[string]string{"0": "este minuto"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} min"},
+			Past:     map[string]string{"other": "hace {0} min"},
+			Relative: map[string]string{"0": "este minuto"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} min"},
+			Past:     map[string]string{"other": "hace {0} min"},
+			Relative: map[string]string{"0": "este minuto"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dentro de {0} segundo", "other": "dentro de {0} segundos"},
+			Past:     map[string]string{"one": "hace {0} segundo", "other": "hace {0} segundos"},
+			Relative: map[string]string{"0": "ahora"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} s"},
+			Past:     map[string]string{"other": "hace {0} s"},
+			Relative: map[string]string{"0": "ahora"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dentro de {0} s"},
+			Past:     map[string]string{"other": "hace {0} s"},
+			Relative: map[string]string{"0": "ahora"},
+		},
+	},
+}
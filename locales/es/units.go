@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} acre", "other": "{0} acres", "per": "{0} por acre"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+		Gender: "masculine",
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} bit", "other": "{0} bits", "per": "{0} por bit"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0}b", "per": "{0}/b"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} byte", "other": "{0} bytes", "per": "{0} por byte"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0}B", "per": "{0}/B"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} grado Celsius", "other": "{0} grados Celsius", "per": "{0} por grado Celsius"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "masculine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centímetro", "other": "{0} centímetros", "per": "{0} por centímetro"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} día", "other": "{0} días", "per": "{0} por día"},
+		Short:  map[string]string{"other": "{0} d", "per": "{0}/d"},
+		Narrow: map[string]string{"other": "{0}d", "per": "{0}/d"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} grado", "other": "{0} grados", "per": "{0} por grado"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "masculine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} grado Fahrenheit", "other": "{0} grados Fahrenheit", "per": "{0} por grado Fahrenheit"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "masculine",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} onza líquida", "other": "{0} onzas líquidas", "per": "{0} por onza líquida"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+		Gender: "feminine",
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} pie", "other": "{0} pies", "per": "{0} por pie"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}ft", "per": "{0}/ft"},
+		Gender: "masculine",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} galón", "other": "{0} galones", "per": "{0} por galón"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+		Gender: "masculine",
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} gigabit", "other": "{0} gigabits", "per": "{0} por gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigabyte", "other": "{0} gigabytes", "per": "{0} por gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} gramo", "other": "{0} gramos", "per": "{0} por gramo"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} hectárea", "other": "{0} hectáreas", "per": "{0} por hectárea"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+		Gender: "feminine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} hora", "other": "{0} horas", "per": "{0} por hora"},
+		Short:  map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} pulgada", "other": "{0} pulgadas", "per": "{0} por pulgada"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}in", "per": "{0}/in"},
+		Gender: "feminine",
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} kilobit", "other": "{0} kilobits", "per": "{0} por kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilobyte", "other": "{0} kilobytes", "per": "{0} por kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} kilogramo", "other": "{0} kilogramos", "per": "{0} por kilogramo"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} kilómetro", "other": "{0} kilómetros", "per": "{0} por kilómetro"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} kilómetro por hora", "other": "{0} kilómetros por hora"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} litro", "other": "{0} litros", "per": "{0} por litro"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0}l", "per": "{0}/l"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} litro por kilómetro", "other": "{0} litros por kilómetro"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0}l/km"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} megabit", "other": "{0} megabits", "per": "{0} por megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} megabyte", "other": "{0} megabytes", "per": "{0} por megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} metro", "other": "{0} metros", "per": "{0} por metro"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} metro por segundo", "other": "{0} metros por segundo"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} microsegundo", "other": "{0} microsegundos", "per": "{0} por microsegundo"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Gender: "masculine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} milla", "other": "{0} millas", "per": "{0} por milla"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+		Gender: "feminine",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} milla por galón", "other": "{0} millas por galón"},
+		Short:  map[string]string{"other": "{0} mi/gal"},
+		Narrow: map[string]string{"other": "{0}mi/gal"},
+		Gender: "feminine",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} milla por hora", "other": "{0} millas por hora"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0}mi/h"},
+		Gender: "feminine",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} milla escandinava", "other": "{0} millas escandinavas", "per": "{0} por milla escandinava"},
+		Short:  map[string]string{"other": "{0} mi esc.", "per": "{0}/mi esc."},
+		Narrow: map[string]string{"other": "{0}mi esc", "per": "{0}/mi esc"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} mililitro", "other": "{0} mililitros", "per": "{0} por mililitro"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} milímetro", "other": "{0} milímetros", "per": "{0} por milímetro"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} milisegundo", "other": "{0} milisegundos", "per": "{0} por milisegundo"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+		Gender: "masculine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minuto", "other": "{0} minutos", "per": "{0} por minuto"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0}min", "per": "{0}/min"},
+		Gender: "masculine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} mes", "other": "{0} meses", "per": "{0} por mes"},
+		Short:  map[string]string{"other": "{0} m.", "per": "{0}/m."},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosegundo", "other": "{0} nanosegundos", "per": "{0} por nanosegundo"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+		Gender: "masculine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} onza", "other": "{0} onzas", "per": "{0} por onza"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+		Gender: "feminine",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} por {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} petabyte", "other": "{0} petabytes", "per": "{0} por petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} libra", "other": "{0} libras", "per": "{0} por libra"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+		Gender: "feminine",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} segundo", "other": "{0} segundos", "per": "{0} por segundo"},
+		Short:  map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+		Gender: "masculine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} centímetro cuadrado", "other": "{0} centímetros cuadrados", "per": "{0} por centímetro cuadrado"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} pie cuadrado", "other": "{0} pies cuadrados"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+		Gender: "masculine",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} kilómetro cuadrado", "other": "{0} kilómetros cuadrados", "per": "{0} por kilómetro cuadrado"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} metro cuadrado", "other": "{0} metros cuadrados", "per": "{0} por metro cuadrado"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} milla cuadrada", "other": "{0} millas cuadradas", "per": "{0} por milla cuadrada"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+		Gender: "feminine",
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} stone", "other": "{0} stones", "per": "{0} por stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} terabit", "other": "{0} terabits", "per": "{0} por terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} terabyte", "other": "{0} terabytes", "per": "{0} por terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} semana", "other": "{0} semanas", "per": "{0} por semana"},
+		Short:  map[string]string{"other": "{0} sem.", "per": "{0}/sem."},
+		Narrow: map[string]string{"other": "{0}sem", "per": "{0}/sem"},
+		Gender: "feminine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} yarda", "other": "{0} yardas", "per": "{0} por yarda"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+		Gender: "feminine",
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} año", "other": "{0} años", "per": "{0} por año"},
+		Short:  map[string]string{"other": "{0} a", "per": "{0}/a"},
+		Narrow: map[string]string{"other": "{0}a", "per": "{0}/a"},
+		Gender: "masculine",
+	},
+}
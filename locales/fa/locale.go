@@ -43,6 +43,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Persian,
 	data: hc.CldrData{
@@ -102,5 +107,91 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 تریلیون",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "‎¤#,##0.00",
+			Accounting: "‎¤ #,##0.00;‎(¤ #,##0.00)",
+			Short: map[string]string{
+				"1000-count-one":              "0 هزار ¤",
+				"1000-count-other":            "0 هزار ¤",
+				"10000-count-one":             "00 هزار ¤",
+				"10000-count-other":           "00 هزار ¤",
+				"100000-count-one":            "000 هزار ¤",
+				"100000-count-other":          "000 هزار ¤",
+				"1000000-count-one":           "0 میلیون ¤",
+				"1000000-count-other":         "0 میلیون ¤",
+				"10000000-count-one":          "00 میلیون ¤",
+				"10000000-count-other":        "00 میلیون ¤",
+				"100000000-count-one":         "000 میلیون ¤",
+				"100000000-count-other":       "000 میلیون ¤",
+				"1000000000-count-one":        "0 میلیارد ¤",
+				"1000000000-count-other":      "0 میلیارد ¤",
+				"10000000000-count-one":       "00 میلیارد ¤",
+				"10000000000-count-other":     "00 میلیارد ¤",
+				"100000000000-count-one":      "000 میلیارد ¤",
+				"100000000000-count-other":    "000 میلیارد ¤",
+				"1000000000000-count-one":     "0 هزارمیلیارد ¤",
+				"1000000000000-count-other":   "0 هزارمیلیارد ¤",
+				"10000000000000-count-one":    "00 هزارمیلیارد ¤",
+				"10000000000000-count-other":  "00 هزارمیلیارد ¤",
+				"100000000000000-count-one":   "000 هزارمیلیارد ¤",
+				"100000000000000-count-other": "000 هزارمیلیارد ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "A$",
+				"BRL": "R$",
+				"CAD": "$CA",
+				"CNY": "¥CN",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"IRR": "ریال",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "$MX",
+				"THB": "฿",
+				"USD": "$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "‎+",
+			MinusSign:   "‎−",
+			PercentSign: "٪",
+			PerMille:    "؉",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} و {1}",
+				Start:  "{0}،‏ {1}",
+				Middle: "{0}،‏ {1}",
+				End:    "{0}، و {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}،‏ {1}",
+				Start:  "{0}،‏ {1}",
+				Middle: "{0}،‏ {1}",
+				End:    "{0}، و {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "‎{0}+‎",
+		},
+		PluralRanges: map[string]string{
+			"one-one": "other",
+		},
 	},
 }
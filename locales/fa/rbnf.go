@@ -0,0 +1,73 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%spellout-cardinal:
+0: صفر;
+1: یک;
+2: دو;
+3: سه;
+4: چهار;
+5: پنج;
+6: شش;
+7: هفت;
+8: هشت;
+9: نه;
+10: ده;
+11: یازده;
+12: دوازده;
+13: سیزده;
+14: چهارده;
+15: پانزده;
+16: شانزده;
+17: هفده;
+18: هجده;
+19: نوزده;
+20: بیست;
+21: بیست و >%spellout-cardinal>;
+30: سی;
+31: سی و >%spellout-cardinal>;
+40: چهل;
+41: چهل و >%spellout-cardinal>;
+50: پنجاه;
+51: پنجاه و >%spellout-cardinal>;
+60: شصت;
+61: شصت و >%spellout-cardinal>;
+70: هفتاد;
+71: هفتاد و >%spellout-cardinal>;
+80: هشتاد;
+81: هشتاد و >%spellout-cardinal>;
+90: نود;
+91: نود و >%spellout-cardinal>;
+100: صد;
+101: صد و >%spellout-cardinal>;
+200: دویست;
+201: دویست و >%spellout-cardinal>;
+300: سیصد;
+301: سیصد و >%spellout-cardinal>;
+400: چهارصد;
+401: چهارصد و >%spellout-cardinal>;
+500: پانصد;
+501: پانصد و >%spellout-cardinal>;
+600: ششصد;
+601: ششصد و >%spellout-cardinal>;
+700: هفتصد;
+701: هفتصد و >%spellout-cardinal>;
+800: هشتصد;
+801: هشتصد و >%spellout-cardinal>;
+900: نهصد;
+901: نهصد و >%spellout-cardinal>;
+1000: <%spellout-cardinal< هزار;
+1001: <%spellout-cardinal< هزار و >%spellout-cardinal>;
+1000000: <%spellout-cardinal< میلیون;
+1000001: <%spellout-cardinal< میلیون و >%spellout-cardinal>;
+1000000000: <%spellout-cardinal< میلیارد;
+1000000001: <%spellout-cardinal< میلیارد و >%spellout-cardinal>;
+1000000000000: <%spellout-cardinal< هزار میلیارد;
+1000000000001: <%spellout-cardinal< هزار میلیارد و >%spellout-cardinal>;
+1000000000000000000: =#,##0=;
+-x: منفی >%spellout-cardinal>;
+x.x: <%spellout-cardinal< ممیز >%spellout-cardinal>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} سال بعد"},
+			Past:     map[string]string{"other": "{0} سال پیش"},
+			Relative: map[string]string{"-1": "سال گذشته", "0": "امسال", "1": "سال آینده"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} سال بعد"},
+			Past:     map[string]string{"other": "{0} سال پیش"},
+			Relative: map[string]string{"-1": "سال گذشته", "0": "امسال", "1": "سال آینده"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} سال بعد"},
+			Past:     map[string]string{"other": "{0} سال پیش"},
+			Relative: map[string]string{"-1": "سال گذشته", "0": "امسال", "1": "سال آینده"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} سه‌ماههٔ بعد"},
+			Past:     map[string]string{"other": "{0} سه‌ماههٔ پیش"},
+			Relative: map[string]string{"-1": "سه‌ماههٔ گذشته", "0": "سه‌ماههٔ کنونی", "1": "سه‌ماههٔ آینده"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} سه‌ماههٔ بعد"},
+			Past:     map[string]string{"other": "{0} سه‌ماههٔ پیش"},
+			Relative: map[string]string{"-1": "سه‌ماههٔ گذشته", "0": "سه‌ماههٔ کنونی", "1": "سه‌ماههٔ آینده"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} سه‌ماههٔ بعد"},
+			Past:     map[string]string{"other": "{0} سه‌ماههٔ پیش"},
+			Relative: map[string]string{"-1": "سه‌ماههٔ گذشته", "0": "سه‌ماههٔ کنونی", "1": "سه‌ماههٔ آینده"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ماه بعد"},
+			Past:     map[string]string{"other": "{0} ماه پیش"},
+			Relative: map[string]string{"-1": "ماه گذشته", "0": "این ماه", "1": "ماه آینده"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ماه بعد"},
+			Past:     map[string]string{"other": "{0} ماه پیش"},
+			Relative: map[string]string{"-1": "ماه گذشته", "0": "این ماه", "1": "ماه آینده"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ماه بعد"},
+			Past:     map[string]string{"other": "{0} ماه پیش"},
+			Relative: map[string]string{"-1": "ماه گذشته", "0": "این ماه", "1": "ماه آینده"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} هفته بعد"},
+			Past:     map[string]string{"other": "{0} هفته پیش"},
+			Relative: map[string]string{"-1": "هفتهٔ گذشته", "0": "این هفته", "1": "هفتهٔ آینده"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} هفته بعد"},
+			Past:     map[string]string{"other": "{0} هفته پیش"},
+			Relative: map[string]string{"-1": "هفتهٔ گذشته", "0": "این هفته", "1": "هفتهٔ آینده"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} هفته بعد"},
+			Past:     map[string]string{"other": "{0} هفته پیش"},
+			Relative: map[string]string{"-1": "هفتهٔ گذشته", "0": "این هفته", "1": "هفتهٔ آینده"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} روز دیگر"},
+			Past:     map[string]string{"other": "{0} روز پیش"},
+			Relative: map[string]string{"-2": "پریروز", "-1": "دیروز", "0": "امروز", "1": "فردا", "2": "پس‌فردا"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} روز دیگر"},
+			Past:     map[string]string{"other": "{0} روز پیش"},
+			Relative: map[string]string{"-2": "پریروز", "-1": "دیروز", "0": "امروز", "1": "فردا", "2": "پس‌فردا"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} روز بعد"},
+			Past:     map[string]string{"other": "{0} روز پیش"},
+			Relative: map[string]string{"-2": "پریروز", "-1": "دیروز", "0": "امروز", "1": "فردا", "2": "پس‌فردا"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ساعت بعد"},
+			Past:     map[string]string{"other": "{0} ساعت پیش"},
+			Relative: map[string]string{"0": "همین ساعت"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ساعت بعد"},
+			Past:     map[string]string{"other": "{0} ساعت پیش"},
+			Relative: map[string]string{"0": "همین ساعت"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ساعت بعد"},
+			Past:     map[string]string{"other": "{0} ساعت پیش"},
+			Relative: map[string]string{"0": "همین ساعت"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} دقیقه بعد"},
+			Past:     map[string]string{"other": "{0} دقیقه پیش"},
+			Relative: map[string]string{"0": "همین دقیقه"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} دقیقه بعد"},
+			Past:     map[string]string{"other": "{0} دقیقه پیش"},
+			Relative: map[string]string{"0": "همین دقیقه"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} دقیقه بعد"},
+			Past:     map[string]string{"other": "{0} دقیقه پیش"},
+			Relative: map[string]string{"0": "همین دقیقه"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ثانیه بعد"},
+			Past:     map[string]string{"other": "{0} ثانیه پیش"},
+			Relative: map[string]string{"0": "اکنون"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ثانیه بعد"},
+			Past:     map[string]string{"other": "{0} ثانیه پیش"},
+			Relative: map[string]string{"0": "اکنون"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ثانیه بعد"},
+			Past:     map[string]string{"other": "{0} ثانیه پیش"},
+			Relative: map[string]string{"0": "اکنون"},
+		},
+	},
+}
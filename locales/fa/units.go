@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} جریب", "per": "{0} در جریب"},
+		Short:  map[string]string{"other": "{0} جریب", "per": "{0}/جریب"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} بیت", "per": "{0} در بیت"},
+		Short:  map[string]string{"other": "{0} بیت", "per": "{0}/بیت"},
+		Narrow: map[string]string{"other": "{0} بیت", "per": "{0}/بیت"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} بایت", "per": "{0} در بایت"},
+		Short:  map[string]string{"other": "{0} بایت", "per": "{0}/بایت"},
+		Narrow: map[string]string{"other": "{0} بایت", "per": "{0}/بایت"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} درجهٔ سلسیوس", "per": "{0} در درجهٔ سلسیوس"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} سانتی‌متر", "per": "{0} در سانتی‌متر"},
+		Short:  map[string]string{"other": "{0} سانتی‌متر", "per": "{0}/سانتی‌متر"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} روز", "per": "{0} در روز"},
+		Short:  map[string]string{"other": "{0} روز", "per": "{0}/روز"},
+		Narrow: map[string]string{"other": "{0} روز", "per": "{0}/روز"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} درجه", "per": "{0} در درجه"},
+		Short:  map[string]string{"other": "{0} درجه", "per": "{0}/درجه"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} درجهٔ فارنهایت", "per": "{0} در درجهٔ فارنهایت"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} اونس سیال", "per": "{0} در اونس سیال"},
+		Short:  map[string]string{"other": "{0}‎ fl oz", "per": "{0}/‎ fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} فوت", "per": "{0} در فوت"},
+		Short:  map[string]string{"other": "{0} فوت", "per": "{0}/فوت"},
+		Narrow: map[string]string{"other": "{0}ft", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} گالن", "per": "{0} در گالن"},
+		Short:  map[string]string{"other": "{0} گالن", "per": "{0} در گالن"},
+		Narrow: map[string]string{"other": "{0} گالن", "per": "{0} در گالن"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} گیگابیت", "per": "{0} در گیگابیت"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} گیگابایت", "per": "{0} در گیگابایت"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} گرم", "per": "{0}/g"},
+		Short:  map[string]string{"other": "{0} گرم", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} هکتار", "per": "{0} در هکتار"},
+		Short:  map[string]string{"other": "{0} هکتار", "per": "{0}/هکتار"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} ساعت", "per": "{0} در ساعت"},
+		Short:  map[string]string{"other": "{0} ساعت", "per": "{0} در ساعت"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/ساعت"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} اینچ", "per": "{0} در اینچ"},
+		Short:  map[string]string{"other": "{0} اینچ", "per": "{0}/اینچ"},
+		Narrow: map[string]string{"other": "{0}in", "per": "{0}/اینچ"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} کیلوبیت", "per": "{0} در کیلوبیت"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} کیلوبایت", "per": "{0} در کیلوبایت"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} کیلوگرم", "per": "{0} در کیلوگرم"},
+		Short:  map[string]string{"other": "{0} کیلوگرم", "per": "{0}‎/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}‎/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} کیلومتر", "per": "{0} در کیلومتر"},
+		Short:  map[string]string{"other": "{0} کیلومتر", "per": "{0}/کیلومتر"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}‎/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} کیلومتر در ساعت"},
+		Short:  map[string]string{"other": "{0}‎ km/h"},
+		Narrow: map[string]string{"other": "{0}‎ km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} لیتر", "per": "{0} در لیتر"},
+		Short:  map[string]string{"other": "{0}L", "per": "{0}‎/L"},
+		Narrow: map[string]string{"other": "{0}L", "per": "{0}‎/L"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} لیتر در کیلومتر"},
+		Short:  map[string]string{"other": "{0} ل./ک.م."},
+		Narrow: map[string]string{"other": "{0} ل./ک.م."},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} مگابیت", "per": "{0} در مگابیت"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} مگابایت", "per": "{0} در مگابایت"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} متر", "per": "{0} در متر"},
+		Short:  map[string]string{"other": "{0}متر", "per": "{0}/متر"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}‎/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} متر در ثانیه"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} میکروثانیه", "per": "{0} در میکروثانیه"},
+		Short:  map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} مایل", "per": "{0} در مایل"},
+		Short:  map[string]string{"other": "{0} مایل", "per": "{0}/مایل"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} مایل در گالن"},
+		Short:  map[string]string{"other": "{0} مایل در گالن"},
+		Narrow: map[string]string{"other": "{0} مایل در گالن"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} مایل در ساعت"},
+		Short:  map[string]string{"other": "{0}‎ mph"},
+		Narrow: map[string]string{"other": "{0}mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} مایل اسکاندیناوی", "per": "{0} در مایل اسکاندیناوی"},
+		Short:  map[string]string{"other": "{0}‎ smi", "per": "{0}/‎ smi"},
+		Narrow: map[string]string{"other": "{0}‎ smi", "per": "{0}/‎ smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} میلی‌لیتر", "per": "{0} در میلی‌لیتر"},
+		Short:  map[string]string{"other": "{0} میلی‌لیتر", "per": "{0}/میلی‌لیتر"},
+		Narrow: map[string]string{"other": "{0}mL", "per": "{0}/mL"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} میلی‌متر", "per": "{0} در میلی‌متر"},
+		Short:  map[string]string{"other": "{0} میلی‌متر", "per": "{0}/میلی‌متر"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} میلی‌ثانیه", "per": "{0} در میلی‌ثانیه"},
+		Short:  map[string]string{"other": "{0} میلی‌ثانیه", "per": "{0}/میلی‌ثانیه"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} دقیقه", "per": "{0} در دقیقه"},
+		Short:  map[string]string{"other": "{0} دقیقه", "per": "{0} در دقیقه"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/دقیقه"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} ماه", "per": "{0} در ماه"},
+		Short:  map[string]string{"other": "{0} ماه", "per": "{0}/ماه"},
+		Narrow: map[string]string{"other": "{0} ماه", "per": "{0}/ماه"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} نانوثانیه", "per": "{0} در نانوثانیه"},
+		Short:  map[string]string{"other": "{0} نانوثانیه", "per": "{0}/نانوثانیه"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} اونس", "per": "{0} در اونس"},
+		Short:  map[string]string{"other": "{0} اونس", "per": "{0} در اونس"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} در {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} پتابایت", "per": "{0} در پتابایت"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} پوند", "per": "{0} در پوند"},
+		Short:  map[string]string{"other": "{0} پوند", "per": "{0} در پوند"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0} در پوند"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} ثانیه", "per": "{0} در ثانیه"},
+		Short:  map[string]string{"other": "{0} ثانیه"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} سانتی‌متر مربع", "per": "{0} در سانتی‌متر مربع"},
+		Short:  map[string]string{"other": "{0}‎ cm²", "per": "{0}‎/cm²"},
+		Narrow: map[string]string{"other": "{0}‎ cm²", "per": "{0}‎/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} فوت مربع"},
+		Short:  map[string]string{"other": "{0} فوت مربع"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} کیلومتر مربع", "per": "{0} در کیلومتر مربع"},
+		Short:  map[string]string{"other": "{0}‎ km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} متر مربع", "per": "{0} در متر مربع"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}‎/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}‎/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} مایل مربع", "per": "{0} در مایل مربع"},
+		Short:  map[string]string{"other": "{0} مایل مربع", "per": "{0}‎/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}‎/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} سنگ", "per": "{0} در سنگ"},
+		Short:  map[string]string{"other": "{0} سنگ", "per": "{0}/سنگ"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} ترابیت", "per": "{0} در ترابیت"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} ترابایت", "per": "{0} در ترابایت"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} هفته", "per": "{0} در هفته"},
+		Short:  map[string]string{"other": "{0} هفته", "per": "{0}/هفته"},
+		Narrow: map[string]string{"other": "{0} هفته", "per": "{0}/هفته"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} یارد", "per": "{0} در یارد"},
+		Short:  map[string]string{"other": "{0} یارد", "per": "{0}/یارد"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} سال", "per": "{0} در سال"},
+		Short:  map[string]string{"other": "{0} سال", "per": "{0}/سال"},
+		Narrow: map[string]string{"other": "{0} سال", "per": "{0}/سال"},
+	},
+}
@@ -179,3 +179,27 @@ func TestHumanizeFrOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeFrOrdinal(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "1er"},
+		{"3", "3e"},
+		{"21", "21e"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatOrdinal(tt.number, language.French, hc.Options{})
+		if err != nil {
+			t.Errorf("[ORDINAL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[ORDINAL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
@@ -46,6 +46,20 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-one": "n = 1"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	if i64 == 1 {
+		return "one"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.French,
 	data: hc.CldrData{
@@ -106,5 +120,84 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 Bn",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤;(#,##0.00 ¤)",
+			Short: map[string]string{
+				"1000-count-one":              "0 k ¤",
+				"1000-count-other":            "0 k ¤",
+				"10000-count-one":             "00 k ¤",
+				"10000-count-other":           "00 k ¤",
+				"100000-count-one":            "000 k ¤",
+				"100000-count-other":          "000 k ¤",
+				"1000000-count-one":           "0 M ¤",
+				"1000000-count-other":         "0 M ¤",
+				"10000000-count-one":          "00 M ¤",
+				"10000000-count-other":        "00 M ¤",
+				"100000000-count-one":         "000 M ¤",
+				"100000000-count-other":       "000 M ¤",
+				"1000000000-count-one":        "0 Md ¤",
+				"1000000000-count-other":      "0 Md ¤",
+				"10000000000-count-one":       "00 Md ¤",
+				"10000000000-count-other":     "00 Md ¤",
+				"100000000000-count-one":      "000 Md ¤",
+				"100000000000-count-other":    "000 Md ¤",
+				"1000000000000-count-one":     "0 Bn ¤",
+				"1000000000000-count-other":   "0 Bn ¤",
+				"10000000000000-count-one":    "00 Bn ¤",
+				"10000000000000-count-other":  "00 Bn ¤",
+				"100000000000000-count-one":   "000 Bn ¤",
+				"100000000000000-count-other": "000 Bn ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "$AU",
+				"BRL": "R$",
+				"CAD": "$CA",
+				"EUR": "€",
+				"GBP": "£GB",
+				"ILS": "₪",
+				"INR": "₹",
+				"KRW": "₩",
+				"MXN": "$MX",
+				"USD": "$US",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} et {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} et {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} et {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} et {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"one": "{0}er", "other": "{0}e"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "≃{0}",
+			AtLeast:       "≥{0}",
+		},
 	},
 }
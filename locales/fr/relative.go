@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} an", "other": "dans {0} ans"},
+			Past:     map[string]string{"one": "il y a {0} an", "other": "il y a {0} ans"},
+			Relative: map[string]string{"-1": "l’année dernière", "0": "cette année", "1": "l’année prochaine"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} a"},
+			Past:     map[string]string{"other": "il y a {0} a"},
+			Relative: map[string]string{"-1": "l’année dernière", "0": "cette année", "1": "l’année prochaine"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} a"},
+			Past:     map[string]string{"other": "-{0} a"},
+			Relative: map[string]string{"-1": "l’année dernière", "0": "cette année", "1": "l’année prochaine"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} trimestre", "other": "dans {0} trimestres"},
+			Past:     map[string]string{"one": "il y a {0} trimestre", "other": "il y a {0} trimestres"},
+			Relative: map[string]string{"-1": "le trimestre dernier", "0": "ce trimestre", "1": "le trimestre prochain"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} trim."},
+			Past:     map[string]string{"other": "il y a {0} trim."},
+			Relative: map[string]string{"-1": "le trimestre dernier", "0": "ce trimestre", "1": "le trimestre prochain"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} trim."},
+			Past:     map[string]string{"other": "-{0} trim."},
+			Relative: map[string]string{"-1": "le trimestre dernier", "0": "ce trimestre", "1": "le trimestre prochain"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} mois"},
+			Past:     map[string]string{"other": "il y a {0} mois"},
+			Relative: map[string]string{"-1": "le mois dernier", "0": "ce mois-ci", "1": "le mois prochain"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} m."},
+			Past:     map[string]string{"other": "il y a {0} m."},
+			Relative: map[string]string{"-1": "le mois dernier", "0": "ce mois-ci", "1": "le mois prochain"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} m."},
+			Past:     map[string]string{"other": "-{0} m."},
+			Relative: map[string]string{"-1": "le mois dernier", "0": "ce mois-ci", "1": "le mois prochain"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} semaine", "other": "dans {0} semaines"},
+			Past:     map[string]string{"one": "il y a {0} semaine", "other": "il y a {0} semaines"},
+			Relative: map[string]string{"-1": "la semaine dernière", "0": "cette semaine", "1": "la semaine prochaine"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} sem."},
+			Past:     map[string]string{"other": "il y a {0} sem."},
+			Relative: map[string]string{"-1": "la semaine dernière", "0": "cette semaine", "1": "la semaine prochaine"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} sem."},
+			Past:     map[string]string{"other": "-{0} sem."},
+			Relative: map[string]string{"-1": "la semaine dernière", "0": "cette semaine", "1": "la semaine prochaine"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} jour", "other": "dans {0} jours"},
+			Past:     map[string]string{"one": "il y a {0} jour", "other": "il y a {0} jours"},
+			Relative: map[string]string{"-2": "avant-hier", "-1": "hier", "0": "aujourd’hui", "1": "demain", "2": "après-demain"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} j"},
+			Past:     map[string]string{"other": "il y a {0} j"},
+			Relative: map[string]string{"-2": "avant-hier", "-1": "hier", "0": "aujourd’hui", "1": "demain", "2": "après-demain"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} j"},
+			Past:     map[string]string{"other": "-{0} j"},
+			Relative: map[string]string{"-2": "avant-hier", "-1": "hier", "0": "aujourd’hui", "1": "demain", "2": "après-demain"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} heure", "other": "dans {0} heures"},
+			Past:     map[string]string{"one": "il y a {0} heure", "other": "il y a {0} heures"},
+			Relative: map[string]string{"0": "cette heure-ci"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} h"},
+			Past:     map[string]string{"other": "il y a {0} h"},
+			Relative: map[string]string{"0": "cette heure-ci"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} h"},
+			Past:     map[string]string{"other": "-{0} h"},
+			Relative: map[string]string{"0": "cette heure-ci"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} minute", "other": "dans {0} minutes"},
+			Past:     map[string]string{"one": "il y a {0} minute", "other": "il y a {0} minutes"},
+			Relative: map[string]string{"0": "cette minute-ci"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} min"},
+			Past:     map[string]string{"other": "il y a {0} min"},
+			Relative: map[string]string{"0": "cette minute-ci"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} min"},
+			Past:     map[string]string{"other": "-{0} min"},
+			Relative: map[string]string{"0": "cette minute-ci"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "dans {0} seconde", "other": "dans {0} secondes"},
+			Past:     map[string]string{"one": "il y a {0} seconde", "other": "il y a {0} secondes"},
+			Relative: map[string]string{"0": "maintenant"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dans {0} s"},
+			Past:     map[string]string{"other": "il y a {0} s"},
+			Relative: map[string]string{"0": "maintenant"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} s"},
+			Past:     map[string]string{"other": "-{0} s"},
+			Relative: map[string]string{"0": "maintenant"},
+		},
+	},
+}
@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} acre anglo-saxonne", "other": "{0} acres anglo-saxonnes", "per": "{0} par acre anglo-saxonne"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+		Gender: "feminine",
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} bit", "other": "{0} bits", "per": "{0} par bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0}bit", "per": "{0}/bit"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} octet", "other": "{0} octets", "per": "{0} par octet"},
+		Short:  map[string]string{"other": "{0} o", "per": "{0}/o"},
+		Narrow: map[string]string{"other": "{0}o", "per": "{0}/o"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} degré Celsius", "other": "{0} degrés Celsius", "per": "{0} par degré Celsius"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "masculine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centimètre", "other": "{0} centimètres", "per": "{0} par centimètre"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} jour", "other": "{0} jours", "per": "{0} par jour"},
+		Short:  map[string]string{"other": "{0} j", "per": "{0}/j"},
+		Narrow: map[string]string{"other": "{0}j", "per": "{0}/j"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} degré", "other": "{0} degrés", "per": "{0} par degré"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "masculine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} degré Fahrenheit", "other": "{0} degrés Fahrenheit", "per": "{0} par degré Fahrenheit"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "masculine",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} once liquide", "other": "{0} onces liquides", "per": "{0} par once liquide"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+		Gender: "feminine",
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} pied", "other": "{0} pieds", "per": "{0} par pied"},
+		Short:  map[string]string{"other": "{0} pi", "per": "{0}/pi"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/pi"},
+		Gender: "masculine",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} gallon", "other": "{0} gallons", "per": "{0} par gallon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+		Gender: "masculine",
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} gigabit", "other": "{0} gigabits", "per": "{0} par gigabit"},
+		Short:  map[string]string{"other": "{0} Gbit", "per": "{0}/Gbit"},
+		Narrow: map[string]string{"other": "{0}Gbit", "per": "{0}/Gbit"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigaoctet", "other": "{0} gigaoctets", "per": "{0} par gigaoctet"},
+		Short:  map[string]string{"other": "{0} Go", "per": "{0}/Go"},
+		Narrow: map[string]string{"other": "{0}Go", "per": "{0}/Go"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} gramme", "other": "{0} grammes", "per": "{0} par gramme"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} hectare", "other": "{0} hectares", "per": "{0} par hectare"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} heure", "other": "{0} heures", "per": "{0} par heure"},
+		Short:  map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} pouce", "other": "{0} pouces", "per": "{0} par pouce"},
+		Short:  map[string]string{"other": "{0} po", "per": "{0}/po"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/po"},
+		Gender: "masculine",
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} kilobit", "other": "{0} kilobits", "per": "{0} par kilobit"},
+		Short:  map[string]string{"other": "{0} kbit", "per": "{0}/kbit"},
+		Narrow: map[string]string{"other": "{0}kbit", "per": "{0}/kbit"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilooctet", "other": "{0} kilooctets", "per": "{0} par kilooctet"},
+		Short:  map[string]string{"other": "{0} ko", "per": "{0}/ko"},
+		Narrow: map[string]string{"other": "{0}ko", "per": "{0}/ko"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} kilogramme", "other": "{0} kilogrammes", "per": "{0} par kilogramme"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} kilomètre", "other": "{0} kilomètres", "per": "{0} par kilomètre"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} kilomètre par heure", "other": "{0} kilomètres par heure"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} litre", "other": "{0} litres", "per": "{0} par litre"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0}l", "per": "{0}/l"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} litre au kilomètre", "other": "{0} litres au kilomètre"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0}l/km"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} mégabit", "other": "{0} mégabits", "per": "{0} par mégabit"},
+		Short:  map[string]string{"other": "{0} Mbit", "per": "{0}/Mbit"},
+		Narrow: map[string]string{"other": "{0}Mbit", "per": "{0}/Mbit"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} mégaoctet", "other": "{0} mégaoctets", "per": "{0} par mégaoctet"},
+		Short:  map[string]string{"other": "{0} Mo", "per": "{0}/Mo"},
+		Narrow: map[string]string{"other": "{0}Mo", "per": "{0}/Mo"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} mètre", "other": "{0} mètres", "per": "{0} par mètre"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} mètre par seconde", "other": "{0} mètres par seconde"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"one": "{0} m/s", "other": "{0}m/s"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} microseconde", "other": "{0} microsecondes", "per": "{0} par microseconde"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} mile", "other": "{0} miles", "per": "{0} par mile"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+		Gender: "masculine",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} mile par gallon", "other": "{0} miles par gallon"},
+		Short:  map[string]string{"other": "{0} mi/gal"},
+		Narrow: map[string]string{"other": "{0}mi/gal"},
+		Gender: "masculine",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} mile par heure", "other": "{0} miles par heure"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0} mi/h"},
+		Gender: "masculine",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} mille scandinave", "other": "{0} milles scandinaves", "per": "{0} par mille scandinave"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Gender: "masculine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} millilitre", "other": "{0} millilitres", "per": "{0} par millilitre"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0}ml", "per": "{0}/ml"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} millimètre", "other": "{0} millimètres", "per": "{0} par millimètre"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} milliseconde", "other": "{0} millisecondes", "per": "{0} par milliseconde"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minute", "other": "{0} minutes", "per": "{0} par minute"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0}min", "per": "{0}/min"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} mois", "per": "{0} par mois"},
+		Short:  map[string]string{"other": "{0} m.", "per": "{0}/m."},
+		Narrow: map[string]string{"other": "{0}m.", "per": "{0}/m."},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanoseconde", "other": "{0} nanosecondes", "per": "{0} par nanoseconde"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} once", "other": "{0} onces", "per": "{0} par once"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+		Gender: "feminine",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} par {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} pétaoctet", "other": "{0} pétaoctets", "per": "{0} par pétaoctet"},
+		Short:  map[string]string{"other": "{0} Po", "per": "{0}/Po"},
+		Narrow: map[string]string{"other": "{0}Po", "per": "{0}/Po"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} livre", "other": "{0} livres", "per": "{0} par livre"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+		Gender: "feminine",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} seconde", "other": "{0} secondes", "per": "{0} par seconde"},
+		Short:  map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} centimètre carré", "other": "{0} centimètres carrés", "per": "{0} par centimètre carré"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} pied carré", "other": "{0} pieds carrés"},
+		Short:  map[string]string{"other": "{0} pi²"},
+		Narrow: map[string]string{"other": "{0}pi²"},
+		Gender: "masculine",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} kilomètre carré", "other": "{0} kilomètres carrés", "per": "{0} par kilomètre carré"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} mètre carré", "other": "{0} mètres carrés", "per": "{0} par mètre carré"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} mille carré", "other": "{0} milles carrés", "per": "{0} par mille carré"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+		Gender: "masculine",
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} stone", "other": "{0} stones", "per": "{0} par stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} térabit", "other": "{0} térabits", "per": "{0} par térabit"},
+		Short:  map[string]string{"one": "{0} Tbit", "other": "{0} Tbit", "per": "{0}/Tbit"},
+		Narrow: map[string]string{"other": "{0}Tbit", "per": "{0}/Tbit"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} téraoctet", "other": "{0} téraoctets", "per": "{0} par téraoctet"},
+		Short:  map[string]string{"other": "{0} To", "per": "{0}/To"},
+		Narrow: map[string]string{"other": "{0}To", "per": "{0}/To"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} semaine", "other": "{0} semaines", "per": "{0} par semaine"},
+		Short:  map[string]string{"other": "{0} sem.", "per": "{0}/sem."},
+		Narrow: map[string]string{"other": "{0}sem.", "per": "{0}/sem."},
+		Gender: "feminine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} yard", "other": "{0} yards", "per": "{0} par yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+		Gender: "masculine",
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} an", "other": "{0} ans", "per": "{0} par an"},
+		Short:  map[string]string{"one": "{0} an", "other": "{0} ans", "per": "{0}/an"},
+		Narrow: map[string]string{"other": "{0}a", "per": "{0}/a"},
+		Gender: "masculine",
+	},
+}
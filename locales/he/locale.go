@@ -47,6 +47,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Hebrew,
 	data: hc.CldrData{
@@ -134,5 +139,93 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000T‏",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "‏#,##0.00 ‏¤",
+			Accounting: "‏#,##0.00 ‏¤;‏-#,##0.00 ‏¤",
+			Short: map[string]string{
+				"1000-count-one":              "¤0K‏",
+				"1000-count-other":            "¤0K‏",
+				"10000-count-one":             "¤00K‏",
+				"10000-count-other":           "¤00K‏",
+				"100000-count-one":            "¤000K‏",
+				"100000-count-other":          "¤000K‏",
+				"1000000-count-one":           "¤0M‏",
+				"1000000-count-other":         "¤0M‏",
+				"10000000-count-one":          "¤00M‏",
+				"10000000-count-other":        "¤00M‏",
+				"100000000-count-one":         "¤000M‏",
+				"100000000-count-other":       "¤000M‏",
+				"1000000000-count-one":        "¤0B‏",
+				"1000000000-count-other":      "¤0B‏",
+				"10000000000-count-one":       "¤00B‏",
+				"10000000000-count-other":     "¤00B‏",
+				"100000000000-count-one":      "¤000B‏",
+				"100000000000-count-other":    "¤000B‏",
+				"1000000000000-count-one":     "¤0T‏",
+				"1000000000000-count-other":   "¤0T‏",
+				"10000000000000-count-one":    "¤00T‏",
+				"10000000000000-count-other":  "¤00T‏",
+				"100000000000000-count-one":   "¤000T‏",
+				"100000000000000-count-other": "¤000T‏",
+			},
+			Symbols: map[string]string{
+				"AUD": "A$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"USD": "$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "‎+",
+			MinusSign:   "‎-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} ו-{1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥{0}",
+		},
+		PluralRanges: map[string]string{
+			"one-one":   "other",
+			"one-two":   "other",
+			"other-one": "other",
+			"other-two": "other",
+		},
 	},
 }
@@ -0,0 +1,359 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: אפס;
+1: אחת;
+2: שתיים;
+3: שלוש;
+4: ארבע;
+5: חמש;
+6: שש;
+7: שבע;
+8: שמונה;
+9: תשע;
+10: עשר;
+11: אחת עשרה;
+12: שתים עשרה;
+13: >%spellout-numbering> עשרה;
+20: עשרים;
+21: עשרים >%%and-feminine>;
+30: שלושים;
+31: שלושים >%%and-feminine>;
+40: ארבעים;
+41: ארבעים >%%and-feminine>;
+50: חמישים;
+51: חמישים >%%and-feminine>;
+60: שישים;
+61: שישים >%%and-feminine>;
+70: שבעים;
+71: שבעים >%%and-feminine>;
+80: שמונים;
+81: שמונים >%%and-feminine>;
+90: תשעים;
+91: תשעים >%%and-feminine>;
+100: מאה;
+101: מאה >%%and-feminine>;
+200: מאתיים;
+201: מאתיים >%%and-feminine>;
+300: <%spellout-numbering< מאות;
+301: <%spellout-numbering< מאות >%%and-feminine>;
+1000: אלף;
+1001: אלף >%%and-feminine>;
+2000: אלפיים;
+2001: אלפיים >%%and-feminine>;
+3000: <%%thousands< אלפים;
+3001: <%%thousands< אלפים >%%and-feminine>;
+11000/1000: <%%spellout-numbering-m< אלף;
+11001/1000: <%%spellout-numbering-m< אלף >%%and-feminine>;
+1000000: מיליון;
+1000001: מיליון >%%and-feminine>;
+2000000: שני מיליון;
+2000001: שני מיליון >%%and-feminine>;
+3000000: <%%spellout-numbering-m< מיליון;
+3000001: <%%spellout-numbering-m< מיליון >%%and-feminine>;
+1000000000: מיליארד;
+1000000001: מיליארד >%%and-feminine>;
+2000000000: שני מיליארד;
+2000000001: שני מיליארד >%%and-feminine>;
+3000000000: <%%spellout-numbering-m< מיליארד;
+3000000001: <%%spellout-numbering-m< מיליארד >%%and-feminine>;
+1000000000000: ביליון;
+1000000000001: ביליון >%%and-feminine>;
+2000000000000: שני ביליון;
+2000000000001: שני ביליון >%%and-feminine>;
+3000000000000: <%%spellout-numbering-m< ביליון;
+3000000000001: <%%spellout-numbering-m< ביליון >%%and-feminine>;
+1000000000000000: טריליון;
+1000000000000001: טריליון >%%and-feminine>;
+2000000000000000: שני טריליון;
+2000000000000001: שני טריליון >%%and-feminine>;
+3000000000000000: <%%spellout-numbering-m< טריליון;
+3000000000000001: <%%spellout-numbering-m< טריליון >%%and-feminine>;
+1000000000000000000: =#,##0=;
+-x: מינוס >%spellout-numbering>;
+x.x: <%spellout-numbering< נקודה >%spellout-numbering>;
+%%thousands:
+1: ERROR-=0=;
+3: =%spellout-numbering=ת;
+8: שמונת;
+9: =%spellout-numbering=ת;
+11: ERROR-=0=;
+%%and-masculine:
+1: ו=%%spellout-numbering-m=;
+20: עשרים;
+21: עשרים >%%and-masculine>;
+30: שלושים;
+31: שלושים >%%and-masculine>;
+40: ארבעים;
+41: ארבעים >%%and-masculine>;
+50: חמישים;
+51: חמישים >%%and-masculine>;
+60: שישים;
+61: שישים >%%and-masculine>;
+70: שבעים;
+71: שבעים >%%and-masculine>;
+80: שמונים;
+81: שמונים >%%and-masculine>;
+90: תשעים;
+91: תשעים >%%and-masculine>;
+100: מאה;
+101: מאה >%%and-masculine>;
+200: מאתיים;
+201: מאתיים >%%and-masculine>;
+300: שלוש מאות;
+301: שלוש מאות >%%and-masculine>;
+400: ארבע מאות;
+401: ארבע מאות >%%and-masculine>;
+500: חמש מאות;
+501: חמש מאות >%%and-masculine>;
+600: שש מאות;
+601: שש מאות >%%and-masculine>;
+700: שבע מאות;
+701: שבע מאות >%%and-masculine>;
+800: שמונה מאות;
+801: שמונה מאות >%%and-masculine>;
+900: תשע מאות;
+901: תשע מאות >%%and-masculine>;
+1000: אלף;
+1001: אלף >%%and-masculine>;
+2000: אלפיים;
+2001: אלפיים >%%and-masculine>;
+3000: ו<%%thousands< אלפים;
+3001: ו<%%thousands< אלפים >%%and-masculine>;
+11000/1000: <%%and-masculine< אלף;
+11001/1000: <%%and-masculine< אלף >%%and-masculine>;
+1000000: מיליון;
+1000001: מיליון >%%and-masculine>;
+2000000: שני מיליון;
+2000001: שני מיליון >%%and-masculine>;
+3000000: <%%and-masculine< מיליון;
+3000001: <%%and-masculine< מיליון >%%and-masculine>;
+1000000000: מיליארד;
+1000000001: מיליארד >%%and-masculine>;
+2000000000: שני מיליארד;
+2000000001: שני מיליארד >%%and-masculine>;
+3000000000: <%%and-masculine< מיליארד;
+3000000001: <%%and-masculine< מיליארד >%%and-masculine>;
+1000000000000: ביליון;
+1000000000001: ביליון >%%and-masculine>;
+2000000000000: שני ביליון;
+2000000000001: שני ביליון >%%and-masculine>;
+3000000000000: <%%and-masculine< ביליון;
+3000000000001: <%%and-masculine< ביליון >%%and-masculine>;
+1000000000000000000: =#,##0=;
+%%spellout-numbering-m:
+0: אפס;
+1: אחד;
+2: שניים;
+3: שלושה;
+4: ארבעה;
+5: חמישה;
+6: שישה;
+7: שבעה;
+8: שמונה;
+9: תשעה;
+10: עשרה;
+11: אחד עשר;
+12: שניים עשר;
+13: >%%spellout-numbering-m> עשר;
+20: עשרים;
+21: עשרים >%%and-masculine>;
+30: שלושים;
+31: שלושים >%%and-masculine>;
+40: ארבעים;
+41: ארבעים >%%and-masculine>;
+50: חמישים;
+51: חמישים >%%and-masculine>;
+60: שישים;
+61: שישים >%%and-masculine>;
+70: שבעים;
+71: שבעים >%%and-masculine>;
+80: שמונים;
+81: שמונים >%%and-masculine>;
+90: תשעים;
+91: תשעים >%%and-masculine>;
+100: מאה;
+101: מאה >%%and-masculine>;
+200: מאתיים;
+201: מאתיים >%%and-masculine>;
+300: <%spellout-numbering< מאות;
+301: <%spellout-numbering< מאות >%%and-masculine>;
+1000: אלף;
+1001: אלף >%%and-masculine>;
+2000: אלפיים;
+2001: אלפיים >%%and-masculine>;
+3000: <%%thousands< אלפים;
+3001: <%%thousands< אלפים >%%and-masculine>;
+11000/1000: <%%spellout-numbering-m< אלף;
+11001/1000: <%%spellout-numbering-m< אלף >%%and-masculine>;
+1000000: מיליון;
+1000001: מיליון >%%and-masculine>;
+2000000: שני מיליון;
+2000001: שני מיליון >%%and-masculine>;
+3000000: <%%spellout-numbering-m< מיליון;
+3000001: <%%spellout-numbering-m< מיליון >%%and-masculine>;
+1000000000: מיליארד;
+1000000001: מיליארד >%%and-masculine>;
+2000000000: שני מיליארד;
+2000000001: שני מיליארד >%%and-masculine>;
+3000000000: <%%spellout-numbering-m< מיליארד;
+3000000001: <%%spellout-numbering-m< מיליארד >%%and-masculine>;
+1000000000000: ביליון;
+1000000000001: ביליון >%%and-masculine>;
+2000000000000: שני ביליון;
+2000000000001: שני ביליון >%%and-masculine>;
+3000000000000: <%%spellout-numbering-m< ביליון;
+3000000000001: <%%spellout-numbering-m< ביליון >%%and-masculine>;
+1000000000000000: טריליון;
+1000000000000001: טריליון >%%and-masculine>;
+2000000000000000: שני טריליון;
+2000000000000001: שני טריליון >%%and-masculine>;
+3000000000000000: <%%spellout-numbering-m< טריליון;
+3000000000000001: <%%spellout-numbering-m< טריליון >%%and-masculine>;
+1000000000000000000: =#,##0=;
+%%and-feminine:
+1: ו=%spellout-numbering=;
+2: ושתיים;
+3: ו=%spellout-numbering=;
+20: עשרים;
+21: עשרים >%%and-feminine>;
+30: שלושים;
+31: שלושים >%%and-feminine>;
+40: ארבעים;
+41: ארבעים >%%and-feminine>;
+50: חמישים;
+51: חמישים >%%and-feminine>;
+60: שישים;
+61: שישים >%%and-feminine>;
+70: שבעים;
+71: שבעים >%%and-feminine>;
+80: שמונים;
+81: שמונים >%%and-feminine>;
+90: תשעים;
+91: תשעים >%%and-feminine>;
+100: מאה;
+101: מאה >%%and-feminine>;
+200: מאתיים;
+201: מאתיים >%%and-feminine>;
+300: שלוש מאות;
+301: שלוש מאות >%%and-feminine>;
+400: ארבע מאות;
+401: ארבע מאות >%%and-feminine>;
+500: חמש מאות;
+501: חמש מאות >%%and-feminine>;
+600: שש מאות;
+601: שש מאות >%%and-feminine>;
+700: שבע מאות;
+701: שבע מאות >%%and-feminine>;
+800: שמונה מאות;
+801: שמונה מאות >%%and-feminine>;
+900: תשע מאות;
+901: תשע מאות >%%and-feminine>;
+1000: אלף;
+1001: אלף >%%and-feminine>;
+2000: אלפיים;
+2001: אלפיים >%%and-feminine>;
+3000: ו<%%thousands< אלפים;
+3001: ו<%%thousands< אלפים >%%and-feminine>;
+11000/1000: <%%and-masculine< אלף;
+11001/1000: <%%and-masculine< אלף >%%and-feminine>;
+1000000: מיליון;
+1000001: מיליון >%%and-feminine>;
+2000000: שני מיליון;
+2000001: שני מיליון >%%and-feminine>;
+3000000: <%%and-masculine< מיליון;
+3000001: <%%and-masculine< מיליון >%%and-feminine>;
+1000000000: מיליארד;
+1000000001: מיליארד >%%and-feminine>;
+2000000000: שני מיליארד;
+2000000001: שני מיליארד >%%and-feminine>;
+3000000000: <%%and-masculine< מיליארד;
+3000000001: <%%and-masculine< מיליארד >%%and-feminine>;
+1000000000000: ביליון;
+1000000000001: ביליון >%%and-feminine>;
+2000000000000: שני ביליון;
+2000000000001: שני ביליון >%%and-feminine>;
+3000000000000: <%%and-masculine< ביליון;
+3000000000001: <%%and-masculine< ביליון >%%and-feminine>;
+1000000000000000000: =#,##0=;
+%spellout-cardinal-masculine:
+0: אפס;
+1: אחד;
+2: שני;
+3: שלושה;
+4: ארבעה;
+5: חמישה;
+6: שישה;
+7: שבעה;
+8: שמונה;
+9: תשעה;
+10: עשרה;
+11: אחד עשר;
+12: שניים עשר;
+13: >%spellout-cardinal-masculine> עשר;
+20: עשרים;
+21: עשרים >%%and-masculine>;
+30: שלושים;
+31: שלושים >%%and-masculine>;
+40: ארבעים;
+41: ארבעים >%%and-masculine>;
+50: חמישים;
+51: חמישים >%%and-masculine>;
+60: שישים;
+61: שישים >%%and-masculine>;
+70: שבעים;
+71: שבעים >%%and-masculine>;
+80: שמונים;
+81: שמונים >%%and-masculine>;
+90: תשעים;
+91: תשעים >%%and-masculine>;
+100: מאה;
+101: מאה >%%and-masculine>;
+200: מאתיים;
+201: מאתיים >%%and-masculine>;
+300: <%spellout-numbering< מאות;
+301: <%spellout-numbering< מאות >%%and-masculine>;
+1000: אלף;
+1001: אלף >%%and-masculine>;
+2000: אלפיים;
+2001: אלפיים >%%and-masculine>;
+3000: <%%thousands< אלפים;
+3001: <%%thousands< אלפים >%%and-masculine>;
+11000/1000: <%%spellout-numbering-m< אלף;
+11001/1000: <%%spellout-numbering-m< אלף >%%and-masculine>;
+1000000: מיליון;
+1000001: מיליון >%%and-masculine>;
+2000000: שני מיליון;
+2000001: שני מיליון >%%and-masculine>;
+3000000: <%%spellout-numbering-m< מיליון;
+3000001: <%%spellout-numbering-m< מיליון >%%and-masculine>;
+1000000000: מיליארד;
+1000000001: מיליארד >%%and-masculine>;
+2000000000: שני מיליארד;
+2000000001: שני מיליארד >%%and-masculine>;
+3000000000: <%%spellout-numbering-m< מיליארד;
+3000000001: <%%spellout-numbering-m< מיליארד >%%and-masculine>;
+1000000000000: ביליון;
+1000000000001: ביליון >%%and-masculine>;
+2000000000000: שני ביליון;
+2000000000001: שני ביליון >%%and-masculine>;
+3000000000000: <%%spellout-numbering-m< ביליון;
+3000000000001: <%%spellout-numbering-m< ביליון >%%and-masculine>;
+1000000000000000: טריליון;
+1000000000000001: טריליון >%%and-masculine>;
+2000000000000000: שני טריליון;
+2000000000000001: שני טריליון >%%and-masculine>;
+3000000000000000: <%%spellout-numbering-m< טריליון;
+3000000000000001: <%%spellout-numbering-m< טריליון >%%and-masculine>;
+1000000000000000000: =#,##0=;
+-x: מינוס >%spellout-cardinal-masculine>;
+x.x: <%%spellout-numbering-m< נקודה >%spellout-cardinal-masculine> ;
+%spellout-cardinal-feminine:
+0: =%spellout-numbering=;
+2: שתי;
+3: =%spellout-numbering=;
+-x: מינוס >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< נקודה >%spellout-cardinal-feminine>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שנה", "two": "בעוד שנתיים", "other": "בעוד {0} שנים"},
+			Past:     map[string]string{"one": "לפני שנה", "two": "לפני שנתיים", "other": "לפני {0} שנים"},
+			Relative: map[string]string{"-1": "השנה שעברה", "0": "השנה", "1": "השנה הבאה"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שנה", "two": "בעוד שנתיים", "other": "בעוד {0} שנים"},
+			Past:     map[string]string{"one": "לפני שנה", "two": "לפני שנתיים", "other": "לפני {0} שנים"},
+			Relative: map[string]string{"-1": "השנה שעברה", "0": "השנה", "1": "השנה הבאה"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שנה", "two": "בעוד שנתיים", "other": "בעוד {0} שנים"},
+			Past:     map[string]string{"one": "לפני שנה", "two": "לפני שנתיים", "other": "לפני {0} שנים"},
+			Relative: map[string]string{"-1": "השנה שעברה", "0": "השנה", "1": "השנה הבאה"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "ברבעון הבא", "two": "בעוד שני רבעונים", "other": "בעוד {0} רבעונים"},
+			Past:     map[string]string{"one": "ברבעון הקודם", "two": "לפני שני רבעונים", "other": "לפני {0} רבעונים"},
+			Relative: map[string]string{"-1": "הרבעון הקודם", "0": "רבעון זה", "1": "הרבעון הבא"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "ברבע׳ הבא", "two": "בעוד שני רבע׳", "other": "בעוד {0} רבע׳"},
+			Past:     map[string]string{"one": "ברבע׳ הקודם", "two": "לפני שני רבע׳", "other": "לפני {0} רבע׳"},
+			Relative: map[string]string{"-1": "הרבעון הקודם", "0": "רבעון זה", "1": "הרבעון הבא"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "ברבע׳ הבא", "two": "בעוד שני רבע׳", "other": "בעוד {0} רבע׳"},
+			Past:     map[string]string{"one": "ברבע׳ הקודם", "two": "לפני שני רבע׳", "other": "לפני {0} רבע׳"},
+			Relative: map[string]string{"-1": "הרבעון הקודם", "0": "רבעון זה", "1": "הרבעון הבא"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד חודש", "two": "בעוד חודשיים", "other": "בעוד {0} חודשים"},
+			Past:     map[string]string{"one": "לפני חודש", "two": "לפני חודשיים", "other": "לפני {0} חודשים"},
+			Relative: map[string]string{"-1": "החודש שעבר", "0": "החודש", "1": "החודש הבא"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד חודש", "two": "בעוד חודשיים", "other": "בעוד {0} חודשים"},
+			Past:     map[string]string{"one": "לפני חודש", "two": "לפני חודשיים", "other": "לפני {0} חודשים"},
+			Relative: map[string]string{"-1": "החודש שעבר", "0": "החודש", "1": "החודש הבא"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד חו׳", "two": "בעוד חודשיים", "other": "בעוד {0} חו׳"},
+			Past:     map[string]string{"one": "לפני חו׳", "two": "לפני חודשיים", "other": "לפני {0} חו׳"},
+			Relative: map[string]string{"-1": "החודש שעבר", "0": "החודש", "1": "החודש הבא"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שבוע", "two": "בעוד שבועיים", "other": "בעוד {0} שבועות"},
+			Past:     map[string]string{"one": "לפני שבוע", "two": "לפני שבועיים", "other": "לפני {0} שבועות"},
+			Relative: map[string]string{"-1": "השבוע שעבר", "0": "השבוע", "1": "השבוע הבא"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שב׳", "two": "בעוד שבועיים", "other": "בעוד {0} שב׳"},
+			Past:     map[string]string{"one": "לפני שב׳", "two": "לפני שבועיים", "other": "לפני {0} שב׳"},
+			Relative: map[string]string{"-1": "השבוע שעבר", "0": "השבוע", "1": "השבוע הבא"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שב׳", "two": "בעוד שבועיים", "other": "בעוד {0} שב׳"},
+			Past:     map[string]string{"one": "לפני שבוע", "two": "לפני שבועיים", "other": "לפני {0} שב׳"},
+			Relative: map[string]string{"-1": "השבוע שעבר", "0": "השבוע", "1": "השבוע הבא"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד יום {0}", "two": "בעוד יומיים", "other": "בעוד {0} ימים"},
+			Past:     map[string]string{"one": "לפני יום {0}", "two": "לפני יומיים", "other": "לפני {0} ימים"},
+			Relative: map[string]string{"-2": "שלשום", "-1": "אתמול", "0": "היום", "1": "מחר", "2": "מחרתיים"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "מחר", "two": "בעוד יומיים", "other": "בעוד {0} ימים"},
+			Past:     map[string]string{"one": "אתמול", "two": "לפני יומיים", "other": "לפני {0} ימים"},
+			Relative: map[string]string{"-2": "שלשום", "0": "היום", "2": "מחרתיים"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "מחר", "two": "בעוד יומיים", "other": "בעוד {0} ימים"},
+			Past:     map[string]string{"one": "אתמול", "two": "לפני יומיים", "other": "לפני {0} ימים"},
+			Relative: map[string]string{"-2": "שלשום", "0": "היום", "2": "מחרתיים"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שעה", "two": "בעוד שעתיים", "other": "בעוד {0} שעות"},
+			Past:     map[string]string{"one": "לפני שעה", "two": "לפני שעתיים", "other": "לפני {0} שעות"},
+			Relative: map[string]string{"0": "בשעה זו"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שעה", "two": "בעוד שעתיים", "other": "בעוד {0} שע׳"},
+			Past:     map[string]string{"one": "לפני שעה", "two": "לפני שעתיים", "other": "לפני {0} שע׳"},
+			Relative: map[string]string{"0": "בשעה זו"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שעה", "two": "בעוד שעתיים", "other": "בעוד {0} שע׳"},
+			Past:     map[string]string{"one": "לפני שעה", "two": "לפני שעתיים", "other": "לפני {0} שע׳"},
+			Relative: map[string]string{"0": "בשעה זו"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד דקה", "two": "בעוד שתי דקות", "other": "בעוד {0} דקות"},
+			Past:     map[string]string{"one": "לפני דקה", "two": "לפני שתי דקות", "other": "לפני {0} דקות"},
+			Relative: map[string]string{"0": "בדקה זו"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד דקה", "two": "בעוד שתי דק׳", "other": "בעוד {0} דק׳"},
+			Past:     map[string]string{"one": "לפני דקה", "other": "לפני {0} דק׳"},
+			Relative: map[string]string{"0": "דקה זו"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד דקה", "two": "בעוד שתי דק׳", "other": "בעוד {0} דק׳"},
+			Past:     map[string]string{"one": "לפני דקה", "two": "לפני שתי דק׳", "other": "לפני {0} דק׳"},
+			Relative: map[string]string{"0": "דקה זו"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שנייה", "two": "בעוד שתי שניות", "other": "בעוד {0} שניות"},
+			Past:     map[string]string{"one": "לפני שנייה", "two": "לפני שתי שניות", "other": "לפני {0} שניות"},
+			Relative: map[string]string{"0": "עכשיו"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שנ׳", "two": "בעוד שתי שנ׳", "other": "בעוד {0} שנ׳"},
+			Past:     map[string]string{"one": "לפני שנ׳", "two": "לפני שתי שנ׳", "other": "לפני {0} שנ׳"},
+			Relative: map[string]string{"0": "עכשיו"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "בעוד שנ׳", "two": "בעוד שתי שנ׳", "other": "בעוד {0} שנ׳"},
+			Past:     map[string]string{"one": "לפני שנ׳", "two": "לפני שתי שנ׳", "other": "לפני {0} שנ׳"},
+			Relative: map[string]string{"0": "עכשיו"},
+		},
+	},
+}
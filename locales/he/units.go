@@ -0,0 +1,321 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} אקר", "per": "{0} לאקר"},
+		Short:  map[string]string{"other": "{0} אקר", "per": "{0}/אקר"},
+		Narrow: map[string]string{"other": "{0} אקר", "per": "{0}/אקר"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} ביט", "per": "{0} לביט"},
+		Short:  map[string]string{"other": "{0} ביט", "per": "{0}/ביט"},
+		Narrow: map[string]string{"other": "{0} ביט", "per": "{0}/ביט"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} בייט", "per": "{0} לבייט"},
+		Short:  map[string]string{"other": "{0} בייט", "per": "{0}/בייט"},
+		Narrow: map[string]string{"other": "{0} בייט", "per": "{0}/בייט"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} מעלת צלזיוס", "other": "{0} מעלות צלזיוס", "per": "{0} למעלת צלזיוס"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "feminine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} סנטימטר", "other": "{0} סנטימטרים", "per": "{0} לסנטימטר"},
+		Short:  map[string]string{"other": "{0} ס״מ", "per": "{0}/ס״מ"},
+		Narrow: map[string]string{"other": "{0} ס״מ", "per": "{0}/ס״מ"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} יום", "two": "יומיים", "other": "{0} ימים", "per": "{0}/יום"},
+		Short:  map[string]string{"one": "{0} יום", "two": "יומיים", "other": "{0} ימ׳", "per": "{0}/יום"},
+		Narrow: map[string]string{"other": "{0} י׳", "per": "{0}/יום"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "מעלה אחת", "two": "שתי מעלות", "other": "{0} מעלות", "per": "{0} למעלה אחת"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "feminine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} מעלת פרנהייט", "other": "{0} מעלות פרנהייט", "per": "{0} למעלת פרנהייט"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} אונקיית נוזלים", "other": "{0} אונקיות נוזלים", "per": "{0} לאונקיית נוזלים"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} רגל", "per": "{0} לרגל"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0} ‎/ft"},
+		Narrow: map[string]string{"other": "{0} ft", "per": "{0} ‎/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} גלון", "other": "{0} גלונים", "per": "{0}/גלון"},
+		Short:  map[string]string{"other": "{0} גל׳", "per": "{0}/גל׳"},
+		Narrow: map[string]string{"other": "{0} גל׳", "per": "{0}/גל׳"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} ג׳יגה-ביט", "per": "{0} לג׳יגה-ביט"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} ג׳יגה-בייט", "per": "{0} לג׳יגה-בייט"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} גרם", "per": "{0}/גרם"},
+		Short:  map[string]string{"other": "{0} גר׳", "per": "{0}/גר׳"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/גר׳"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} הקטאר", "per": "{0} להקטאר"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} שעה", "two": "שעתיים", "other": "{0} שעות", "per": "{0} לשעה"},
+		Short:  map[string]string{"one": "{0} שעה", "two": "שעתיים", "other": "{0} שע׳", "per": "{0}/שעה"},
+		Narrow: map[string]string{"other": "{0} שע׳", "per": "{0}/שע׳"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} אינץ׳", "per": "{0} לאינץ׳"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0} ‎/in"},
+		Narrow: map[string]string{"other": "{0} in", "per": "{0} ‎/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} קילוביט", "per": "{0} לקילוביט"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} קילו-בייט", "per": "{0} לקילו-בייט"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} קילוגרם", "per": "{0}/קילוגרם"},
+		Short:  map[string]string{"other": "{0} ק״ג", "per": "{0}/ק״ג"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/ק״ג"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} קילומטר", "other": "{0} קילומטרים", "per": "{0} לקילומטר"},
+		Short:  map[string]string{"other": "{0} ק״מ", "per": "{0}/ק״מ"},
+		Narrow: map[string]string{"other": "{0} ק״מ", "per": "{0}/ק״מ"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} קילומטר לשעה"},
+		Short:  map[string]string{"other": "{0} קמ״ש"},
+		Narrow: map[string]string{"other": "{0} קמ״ש"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} ליטר", "per": "{0}/ליטר"},
+		Short:  map[string]string{"other": "{0} ל׳", "per": "{0}/ל׳"},
+		Narrow: map[string]string{"other": "{0} ל׳", "per": "{0}/ל׳"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} ליטר/קילומטר", "other": "{0} ליטרים/קילומטר"},
+		Short:  map[string]string{"other": "{0} ל׳/ק״מ"},
+		Narrow: map[string]string{"other": "{0} ל׳/ק״מ"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} מגה-ביט", "per": "{0} למגה-ביט"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} מגה-בייט", "per": "{0} למגה-בייט"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} מטר", "other": "{0} מטרים", "per": "{0} למטר"},
+		Short:  map[string]string{"other": "{0} מ׳", "per": "{0}/מ׳"},
+		Narrow: map[string]string{"other": "{0} מ׳", "per": "{0}/מ׳"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} מטר לשנייה"},
+		Short:  map[string]string{"other": "{0} מ׳/שנ׳"},
+		Narrow: map[string]string{"other": "{0} מ׳/שנ׳"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} מיליונית שנייה", "other": "{0} מיליוניות שנייה", "per": "{0} למיליונית שנייה"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} מייל", "per": "{0} למייל"},
+		Short:  map[string]string{"other": "{0} מייל", "per": "{0}/מייל"},
+		Narrow: map[string]string{"other": "{0} מייל", "per": "{0}/מייל"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} מייל/גלון"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} מייל לשעה"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"other": "{0} mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} מייל-סקנדינביה", "per": "{0} למייל-סקנדינביה"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Gender: "masculine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} מיליליטר", "per": "{0} למיליליטר"},
+		Short:  map[string]string{"other": "{0} מ״ל", "per": "{0}/מ״ל"},
+		Narrow: map[string]string{"other": "{0} מ״ל", "per": "{0}/מ״ל"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} מילימטר", "other": "{0} מילימטרים", "per": "{0} למילימטר"},
+		Short:  map[string]string{"one": "{0} מ″מ", "other": "{0} מ״מ", "per": "{0}/מ″מ"},
+		Narrow: map[string]string{"one": "{0} מ″מ", "other": "{0} מ״מ", "per": "{0}/מ″מ"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} אלפית שנייה", "other": "{0} אלפיות שנייה", "per": "{0} לאלפית שנייה"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} דקה", "two": "שתי דקות", "other": "{0} דקות", "per": "{0}/דקה"},
+		Short:  map[string]string{"other": "{0} דק׳", "per": "{0}/ד׳"},
+		Narrow: map[string]string{"other": "{0} דק׳", "per": "{0}/ד׳"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} חודש", "two": "חודשיים", "other": "{0} חודשים", "per": "{0} לחודש"},
+		Short:  map[string]string{"other": "{0} ח׳", "per": "{0}/חודש"},
+		Narrow: map[string]string{"other": "{0} ח׳", "per": "{0}/חודש"},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} ננו שנייה", "other": "{0} ננו שניות", "per": "{0} לננו שנייה"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} אונקיה", "other": "{0} אונקיות", "per": "{0}/אונקיה"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} ל{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} פטה-בייט", "per": "{0} לפטה-בייט"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} פאונד", "per": "{0}/פאונד"},
+		Short:  map[string]string{"one": "{0} lb", "other": "{0} lbs", "per": "{0}/lb"},
+		Narrow: map[string]string{"one": "{0} lb", "other": "{0} lbs", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} שניה", "two": "שתי שניות", "other": "{0} שניות"},
+		Short:  map[string]string{"other": "{0} שנ׳", "per": "{0}/שנ׳"},
+		Narrow: map[string]string{"other": "{0} שנ׳", "per": "{0}/שנ׳"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "סנטימטר רבוע {0}", "other": "{0} סנטימטר רבוע", "per": "{0} לסנטימטר רבוע"},
+		Short:  map[string]string{"other": "{0} סמ״ר", "per": "{0}/סמ״ר"},
+		Narrow: map[string]string{"other": "{0} סמ״ר", "per": "{0}/סמ״ר"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "רגל רבועה {0}", "other": "{0} רגל רבועה"},
+		Short:  map[string]string{"other": "‎{0} sq ft"},
+		Narrow: map[string]string{"one": "רגל רבועה {0}", "other": "{0} רגל רבועה"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "קילומטר רבוע {0}", "other": "{0} קילומטר רבוע", "per": "{0} לקילומטר רבוע"},
+		Short:  map[string]string{"other": "{0} קמ״ר", "per": "{0}/קמ״ר"},
+		Narrow: map[string]string{"one": "קמ״ר {0}", "other": "{0} קמ״ר", "per": "{0}/קמ״ר"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "מטר רבוע {0}", "other": "{0} מטר רבוע", "per": "{0} למטר רבוע"},
+		Short:  map[string]string{"one": "מ״ר {0}", "other": "{0} מ״ר", "per": "{0}/מ״ר"},
+		Narrow: map[string]string{"one": "מ״ר {0}", "other": "{0} מ״ר", "per": "{0}/מ״ר"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "מייל רבוע {0}", "other": "{0} מייל רבוע", "per": "{0} למייל רבוע"},
+		Short:  map[string]string{"other": "‎{0} sq mi", "per": "‎{0}/mi²"},
+		Narrow: map[string]string{"one": "מייל רבוע {0}", "other": "{0} מייל רבוע", "per": "‎{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} סטון", "per": "{0} לסטון"},
+		Short:  map[string]string{"other": "{0} סטון", "per": "{0}/סטון"},
+		Narrow: map[string]string{"other": "{0} סטון", "per": "{0}/סטון"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} טרה-ביט", "per": "{0} לטרה-ביט"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} טרה-בייט", "per": "{0} לטרה-בייט"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} שבוע", "two": "שבועיים", "other": "{0} שבועות", "per": "{0}/שבוע"},
+		Short:  map[string]string{"one": "{0} שבוע", "two": "שבועיים", "other": "{0} שבועות", "per": "{0}/שבוע"},
+		Narrow: map[string]string{"other": "{0} ש′", "per": "{0}/שב׳"},
+		Gender: "masculine",
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} יארד", "per": "{0} ליארד"},
+		Short:  map[string]string{"other": "{0} יארד", "per": "{0}/יארד"},
+		Narrow: map[string]string{"other": "{0} יארד", "per": "{0}/יארד"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} שנה", "other": "{0} שנים", "per": "{0} לשנה"},
+		Short:  map[string]string{"one": "{0} שנה", "other": "{0} שנים", "per": "{0}/שנה"},
+		Narrow: map[string]string{"other": "{0} ש′", "per": "{0}/שנה"},
+		Gender: "feminine",
+	},
+}
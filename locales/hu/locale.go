@@ -33,6 +33,20 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-one": "n = 1,5"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	if i64 == 1 || i64 == 5 {
+		return "one"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Hungarian,
 	data: hc.CldrData{
@@ -92,5 +106,76 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 B",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 E ¤",
+				"1000-count-other":            "0 E ¤",
+				"10000-count-one":             "00 E ¤",
+				"10000-count-other":           "00 E ¤",
+				"100000-count-one":            "000 E ¤",
+				"100000-count-other":          "000 E ¤",
+				"1000000-count-one":           "0 M ¤",
+				"1000000-count-other":         "0 M ¤",
+				"10000000-count-one":          "00 M ¤",
+				"10000000-count-other":        "00 M ¤",
+				"100000000-count-one":         "000 M ¤",
+				"100000000-count-other":       "000 M ¤",
+				"1000000000-count-one":        "0 Mrd ¤",
+				"1000000000-count-other":      "0 Mrd ¤",
+				"10000000000-count-one":       "00 Mrd ¤",
+				"10000000000-count-other":     "00 Mrd ¤",
+				"100000000000-count-one":      "000 Mrd ¤",
+				"100000000000-count-other":    "000 Mrd ¤",
+				"1000000000000-count-one":     "0 B ¤",
+				"1000000000000-count-other":   "0 B ¤",
+				"10000000000000-count-one":    "00 B ¤",
+				"10000000000000-count-other":  "00 B ¤",
+				"100000000000000-count-one":   "000 B ¤",
+				"100000000000000-count-other": "000 B ¤",
+			},
+			Symbols: map[string]string{
+				"HUF": "Ft",
+				"JPY": "¥",
+			},
+		},
+		PercentFormat:         "#,##0%",
+		MinimumGroupingDigits: 2,
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} és {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} és {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} és {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} és {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} és {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} és {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
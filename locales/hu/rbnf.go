@@ -0,0 +1,59 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%spellout-cardinal:
+0: nulla;
+1: egy;
+2: kettő;
+3: három;
+4: négy;
+5: öt;
+6: hat;
+7: hét;
+8: nyolc;
+9: kilenc;
+10: tíz;
+11: tizen­>%spellout-cardinal>;
+20: húsz;
+21: huszon­>%spellout-cardinal>;
+30: harminc;
+31: harminc­>%spellout-cardinal>;
+40: negyven;
+41: negyven­>%spellout-cardinal>;
+50: ötven;
+51: ötven­>%spellout-cardinal>;
+60: hatvan;
+61: hatvan­>%spellout-cardinal>;
+70: hetven;
+71: hetven­>%spellout-cardinal>;
+80: nyolcvan;
+81: nyolcvan­>%spellout-cardinal>;
+90: kilencven;
+91: kilencven­>%spellout-cardinal>;
+100: száz;
+101: száz­>%spellout-cardinal>;
+200: <%%spellout-cardinal-initial<­száz;
+201: <%%spellout-cardinal-initial<­száz­>%spellout-cardinal>;
+1000: ezer;
+1001: ezer­>%spellout-cardinal>;
+2000: <%%spellout-cardinal-initial<­ezer;
+2001: <%%spellout-cardinal-initial<­ezer­>%spellout-cardinal>;
+1000000: <%%spellout-cardinal-initial<­millió;
+1000001: <%%spellout-cardinal-initial<­millió­>%spellout-cardinal>;
+1000000000: <%%spellout-cardinal-initial<­milliárd;
+1000000001: <%%spellout-cardinal-initial<­milliárd­>%spellout-cardinal>;
+1000000000000: <%%spellout-cardinal-initial<­billió;
+1000000000001: <%%spellout-cardinal-initial<­billió­>%spellout-cardinal>;
+1000000000000000: <%%spellout-cardinal-initial<­billiárd;
+1000000000000001: <%%spellout-cardinal-initial<­billiárd­>%spellout-cardinal>;
+1000000000000000000: =#,##0=;
+-x: mínusz >%spellout-cardinal>;
+x.x: <%spellout-cardinal< egész >%spellout-cardinal>;
+%%spellout-cardinal-initial:
+1: egy;
+2: két;
+3: =%spellout-cardinal=;
+`
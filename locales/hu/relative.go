@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} év múlva"},
+			Past:     map[string]string{"other": "{0} évvel ezelőtt"},
+			Relative: map[string]string{"-1": "előző év", "0": "ez az év", "1": "következő év"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} év múlva"},
+			Past:     map[string]string{"other": "{0} évvel ezelőtt"},
+			Relative: map[string]string{"-1": "előző év", "0": "ez az év", "1": "következő év"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} év múlva"},
+			Past:     map[string]string{"other": "{0} éve"},
+			Relative: map[string]string{"-1": "előző év", "0": "ez az év", "1": "következő év"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} negyedév múlva"},
+			Past:     map[string]string{"other": "{0} negyedévvel ezelőtt"},
+			Relative: map[string]string{"-1": "előző negyedév", "0": "ez a negyedév", "1": "következő negyedév"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} negyedév múlva"},
+			Past:     map[string]string{"other": "{0} negyedévvel ezelőtt"},
+			Relative: map[string]string{"-1": "előző negyedév", "0": "ez a negyedév", "1": "következő negyedév"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} n.év múlva"},
+			Past:     map[string]string{"other": "{0} negyedévvel ezelőtt"},
+			Relative: map[string]string{"-1": "előző negyedév", "0": "ez a negyedév", "1": "következő negyedév"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hónap múlva"},
+			Past:     map[string]string{"other": "{0} hónappal ezelőtt"},
+			Relative: map[string]string{"-1": "előző hónap", "0": "ez a hónap", "1": "következő hónap"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hónap múlva"},
+			Past:     map[string]string{"other": "{0} hónappal ezelőtt"},
+			Relative: map[string]string{"-1": "előző hónap", "0": "ez a hónap", "1": "következő hónap"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hónap múlva"},
+			Past:     map[string]string{"other": "{0} hónapja"},
+			Relative: map[string]string{"-1": "előző hónap", "0": "ez a hónap", "1": "következő hónap"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hét múlva"},
+			Past:     map[string]string{"other": "{0} héttel ezelőtt"},
+			Relative: map[string]string{"-1": "előző hét", "0": "ez a hét", "1": "következő hét"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hét múlva"},
+			Past:     map[string]string{"other": "{0} héttel ezelőtt"},
+			Relative: map[string]string{"-1": "előző hét", "0": "ez a hét", "1": "következő hét"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hét múlva"},
+			Past:     map[string]string{"other": "{0} hete"},
+			Relative: map[string]string{"-1": "előző hét", "0": "ez a hét", "1": "következő hét"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} nap múlva"},
+			Past:     map[string]string{"other": "{0} nappal ezelőtt"},
+			Relative: map[string]string{"-2": "tegnapelőtt", "-1": "tegnap", "0": "ma", "1": "holnap", "2": "holnapután"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} nap múlva"},
+			Past:     map[string]string{"other": "{0} napja"},
+			Relative: map[string]string{"-2": "tegnapelőtt", "-1": "tegnap", "0": "ma", "1": "holnap", "2": "holnapután"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} nap múlva"},
+			Past:     map[string]string{"other": "{0} napja"},
+			Relative: map[string]string{"-2": "tegnapelőtt", "-1": "tegnap", "0": "ma", "1": "holnap", "2": "holnapután"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} óra múlva"},
+			Past:     map[string]string{"other": "{0} órával ezelőtt"},
+			Relative: map[string]string{"0": "ebben az órában"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} óra múlva"},
+			Past:     map[string]string{"other": "{0} órával ezelőtt"},
+			Relative: map[string]string{"0": "ebben az órában"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} óra múlva"},
+			Past:     map[string]string{"other": "{0} órája"},
+			Relative: map[string]string{"0": "ebben az órában"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} perc múlva"},
+			Past:     map[string]string{"other": "{0} perccel ezelőtt"},
+			Relative: map[string]string{"0": "ebben a percben"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} perc múlva"},
+			Past:     map[string]string{"other": "{0} perccel ezelőtt"},
+			Relative: map[string]string{"0": "ebben a percben"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} perc múlva"},
+			Past:     map[string]string{"other": "{0} perce"},
+			Relative: map[string]string{"0": "ebben a percben"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} másodperc múlva"},
+			Past:     map[string]string{"other": "{0} másodperccel ezelőtt"},
+			Relative: map[string]string{"0": "most"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} másodperc múlva"},
+			Past:     map[string]string{"other": "{0} másodperccel ezelőtt"},
+			Relative: map[string]string{"0": "most"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} másodperc múlva"},
+			Past:     map[string]string{"other": "{0} m.perce"},
+			Relative: map[string]string{"0": "most"},
+		},
+	},
+}
@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} hold", "per": "{0} per hold"},
+		Short:  map[string]string{"other": "{0} kh", "per": "{0}/kh"},
+		Narrow: map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0} per bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} bájt", "per": "{0} per bájt"},
+		Short:  map[string]string{"other": "{0} bájt", "per": "{0}/bájt"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} Celsius-fok", "per": "{0} per Celsius-fok"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} centiméter", "per": "{0}/centimeter"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} nap", "per": "{0}/nap"},
+		Short:  map[string]string{"other": "{0} nap", "per": "{0}/nap"},
+		Narrow: map[string]string{"other": "{0} nap", "per": "{0}/nap"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} fok", "per": "{0} per fok"},
+		Short:  map[string]string{"other": "{0} fok", "per": "{0}/fok"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} Fahrenheit-fok", "per": "{0} per Fahrenheit-fok"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} folyadékuncia", "per": "{0} per folyadékuncia"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} láb", "per": "{0}/láb"},
+		Short:  map[string]string{"other": "{0} láb", "per": "{0}/láb"},
+		Narrow: map[string]string{"other": "{0} láb", "per": "{0}/láb"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} gallon", "per": "{0}/gallon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0} per gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} gigabájt", "per": "{0} per gigabájt"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} gramm", "per": "{0}/gramm"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} hektár", "per": "{0} per hektár"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} óra", "per": "{0}/óra"},
+		Short:  map[string]string{"other": "{0} ó", "per": "{0}/ó"},
+		Narrow: map[string]string{"other": "{0} ó", "per": "{0}/ó"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} hüvelyk", "per": "{0}/hüvelyk"},
+		Short:  map[string]string{"other": "{0} hüvelyk", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0} hüvelyk", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0} per kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} kilobájt", "per": "{0} per kilobájt"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} kilogramm", "per": "{0}/kilogramm"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} kilométer", "per": "{0}/kilométer"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} kilométer per óra"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0} km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} liter", "per": "{0}/liter"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} liter per kilométer"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0} per megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} megabájt", "per": "{0} per megabájt"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} méter", "per": "{0}/méter"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} méter per másodperc"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} mikroszekundum", "per": "{0} per mikroszekundum"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} mérföld", "per": "{0} per mérföld"},
+		Short:  map[string]string{"other": "{0} mf", "per": "{0}/mf"},
+		Narrow: map[string]string{"other": "{0} mf", "per": "{0}/mf"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} mérföld per gallon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} mérföld per óra"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"other": "{0} mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} svéd mérföld", "per": "{0} per svéd mérföld"},
+		Short:  map[string]string{"other": "{0} mil", "per": "{0}/mil"},
+		Narrow: map[string]string{"other": "{0} mil", "per": "{0}/mil"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} milliliter", "per": "{0} per milliliter"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} milliméter", "per": "{0} per milliméter"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} ezredmásodperc", "per": "{0} per ezredmásodperc"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} perc", "per": "{0}/perc"},
+		Short:  map[string]string{"other": "{0} p", "per": "{0}/p"},
+		Narrow: map[string]string{"other": "{0} p", "per": "{0}/p"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} hónap", "per": "{0}/hónap"},
+		Short:  map[string]string{"other": "{0} hónap", "per": "{0}/hó"},
+		Narrow: map[string]string{"other": "{0} h.", "per": "{0}/hó"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} nanoszekundum", "per": "{0} per nanoszekundum"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} uncia", "per": "{0}/uncia"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"one": "{0} oz", "other": "{0} uncia", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} per {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} petabájt", "per": "{0} per petabájt"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} font", "per": "{0}/font"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"one": "{0} lb", "other": "{0} font", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} másodperc", "per": "{0} per másodperc"},
+		Short:  map[string]string{"other": "{0} mp", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} mp", "per": "{0}/s"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} négyzetcentiméter", "per": "{0}/négyzetcentiméter"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} négyzetláb"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} négyzetkilométer", "per": "{0}/km²"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} négyzetméter", "per": "{0}/négyzetméter"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} négyzetmérföld", "per": "{0}/négyzetmérföld"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} stone", "per": "{0} per stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0} per terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} terabájt", "per": "{0} per terabájt"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} hét", "per": "{0}/hét"},
+		Short:  map[string]string{"other": "{0} hét", "per": "{0}/hét"},
+		Narrow: map[string]string{"other": "{0} hét", "per": "{0}/hét"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} yard", "per": "{0} per yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} év", "per": "{0}/év"},
+		Short:  map[string]string{"other": "{0} év", "per": "{0}/év"},
+		Narrow: map[string]string{"other": "{0} év", "per": "{0}/év"},
+	},
+}
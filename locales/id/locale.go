@@ -24,6 +24,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Indonesian,
 	data: hc.CldrData{
@@ -59,5 +64,76 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 T",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "¤#,##0.00",
+			Accounting: "¤#,##0.00",
+			Short: map[string]string{
+				"1000-count-other":            "¤0 rb",
+				"10000-count-other":           "¤00 rb",
+				"100000-count-other":          "¤000 rb",
+				"1000000-count-other":         "¤0 jt",
+				"10000000-count-other":        "¤00 jt",
+				"100000000-count-other":       "¤000 jt",
+				"1000000000-count-other":      "¤0 M",
+				"10000000000-count-other":     "¤00 M",
+				"100000000000-count-other":    "¤000 M",
+				"1000000000000-count-other":   "¤0 T",
+				"10000000000000-count-other":  "¤00 T",
+				"100000000000000-count-other": "¤000 T",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"IDR": "Rp",
+				"ILS": "₪",
+				"INR": "Rs",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "ke-{0}"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥{0}",
+		},
 	},
 }
@@ -0,0 +1,42 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%spellout-cardinal:
+0: kosong;
+1: satu;
+2: dua;
+3: tiga;
+4: empat;
+5: lima;
+6: enam;
+7: tujuh;
+8: delapan;
+9: sembilan;
+10: sepuluh;
+11: sebelas;
+12: >%spellout-cardinal> belas;
+20: <%spellout-cardinal< puluh;
+21: <%spellout-cardinal< puluh >%spellout-cardinal>;
+100: seratus;
+101: seratus >%spellout-cardinal>;
+200: <%spellout-cardinal< ratus;
+201: <%spellout-cardinal< ratus >%spellout-cardinal>;
+1000: seribu;
+1001: seribu >%spellout-cardinal>;
+2000: <%spellout-cardinal< ribu;
+2001: <%spellout-cardinal< ribu >%spellout-cardinal>;
+1000000: <%spellout-cardinal< juta;
+1000001: <%spellout-cardinal< juta >%spellout-cardinal>;
+1000000000: <%spellout-cardinal< miliar;
+1000000001: <%spellout-cardinal< miliar >%spellout-cardinal>;
+1000000000000: <%spellout-cardinal< triliun;
+1000000000001: <%spellout-cardinal< triliun >%spellout-cardinal>;
+1000000000000000: <%spellout-cardinal< kuadriliun;
+1000000000000001: <%spellout-cardinal< kuadriliun >%spellout-cardinal>;
+1000000000000000000: =#,##0.#=;
+-x: negatif >%spellout-cardinal>;
+x.x: <%spellout-cardinal< titik >%spellout-cardinal>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} tahun"},
+			Past:     map[string]string{"other": "{0} tahun yang lalu"},
+			Relative: map[string]string{"-1": "tahun lalu", "0": "tahun ini", "1": "tahun depan"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} thn"},
+			Past:     map[string]string{"other": "{0} thn lalu"},
+			Relative: map[string]string{"-1": "thn lalu", "0": "thn ini", "1": "thn depan"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} thn"},
+			Past:     map[string]string{"other": "{0} thn lalu"},
+			Relative: map[string]string{"-1": "thn lalu", "0": "thn ini", "1": "thn depan"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} kuartal"},
+			Past:     map[string]string{"other": "{0} kuartal yang lalu"},
+			Relative: map[string]string{"-1": "Kuartal lalu", "0": "kuartal ini", "1": "kuartal berikutnya"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} krtl."},
+			Past:     map[string]string{"other": "{0} krtl. lalu"},
+			Relative: map[string]string{"-1": "krtl lalu", "0": "krtl ini", "1": "krtl berikutnya"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} krtl."},
+			Past:     map[string]string{"other": "{0} krtl. lalu"},
+			Relative: map[string]string{"-1": "krtl lalu", "0": "krtl ini", "1": "krtl berikutnya"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} bulan"},
+			Past:     map[string]string{"other": "{0} bulan yang lalu"},
+			Relative: map[string]string{"-1": "bulan lalu", "0": "bulan ini", "1": "bulan depan"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} bln"},
+			Past:     map[string]string{"other": "{0} bln lalu"},
+			Relative: map[string]string{"-1": "bln lalu", "0": "bln ini", "1": "bln berikutnya"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} bln"},
+			Past:     map[string]string{"other": "{0} bln lalu"},
+			Relative: map[string]string{"-1": "bln lalu", "0": "bln ini", "1": "bln berikutnya"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} minggu"},
+			Past:     map[string]string{"other": "{0} minggu yang lalu"},
+			Relative: map[string]string{"-1": "minggu lalu", "0": "minggu ini", "1": "minggu depan"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} mgg"},
+			Past:     map[string]string{"other": "{0} mgg lalu"},
+			Relative: map[string]string{"-1": "mgg lalu", "0": "mgg ini", "1": "mgg depan"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} mgg"},
+			Past:     map[string]string{"other": "{0} mgg lalu"},
+			Relative: map[string]string{"-1": "mgg lalu", "0": "mgg ini", "1": "mgg depan"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} hari"},
+			Past:     map[string]string{"other": "{0} hari yang lalu"},
+			Relative: map[string]string{"-2": "kemarin dulu", "-1": "kemarin", "0": "hari ini", "1": "besok", "2": "lusa"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} h"},
+			Past:     map[string]string{"other": "{0} h lalu"},
+			Relative: map[string]string{"-2": "selumbari", "-1": "kemarin", "0": "hari ini", "1": "besok", "2": "lusa"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} h"},
+			Past:     map[string]string{"other": "{0} h lalu"},
+			Relative: map[string]string{"-2": "selumbari", "-1": "kemarin", "0": "hari ini", "1": "besok", "2": "lusa"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} jam"},
+			Past:     map[string]string{"other": "{0} jam yang lalu"},
+			Relative: map[string]string{"0": "jam ini"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} jam"},
+			Past:     map[string]string{"other": "{0} jam lalu"},
+			Relative: map[string]string{"0": "jam ini"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} jam"},
+			Past:     map[string]string{"other": "{0} jam lalu"},
+			Relative: map[string]string{"0": "jam ini"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} menit"},
+			Past:     map[string]string{"other": "{0} menit yang lalu"},
+			Relative: map[string]string{"0": "menit ini"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} mnt"},
+			Past:     map[string]string{"other": "{0} mnt lalu"},
+			Relative: map[string]string{"0": "mnt ini"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} mnt"},
+			Past:     map[string]string{"other": "{0} mnt lalu"},
+			Relative: map[string]string{"0": "mnt ini"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dalam {0} detik"},
+			Past:     map[string]string{"other": "{0} detik yang lalu"},
+			Relative: map[string]string{"0": "sekarang"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} dtk"},
+			Past:     map[string]string{"other": "{0} dtk lalu"},
+			Relative: map[string]string{"0": "sekarang"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "dlm {0} dtk"},
+			Past:     map[string]string{"other": "{0} dtk lalu"},
+			Relative: map[string]string{"0": "sekarang"},
+		},
+	},
+}
@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} ekar", "per": "{0} per ekar"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0} per bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} byte", "per": "{0} per byte"},
+		Short:  map[string]string{"other": "{0} byte", "per": "{0}/byte"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} derajat Celsius", "per": "{0} per derajat Celsius"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} sentimeter", "per": "{0} per sentimeter"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} hari", "per": "{0} per hari"},
+		Short:  map[string]string{"other": "{0} hr", "per": "{0}/hr"},
+		Narrow: map[string]string{"other": "{0} hr", "per": "{0}/hr"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} derajat", "per": "{0} per derajat"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} derajat Fahrenheit", "per": "{0} per derajat Fahrenheit"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} fluid ounce", "per": "{0} per fluid ounce"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} kaki", "per": "{0} per kaki"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} galon", "per": "{0} per galon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0} per gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} gigabyte", "per": "{0} per gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} gram", "per": "{0} per gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} hektare", "per": "{0} per hektare"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} jam", "per": "{0} per jam"},
+		Short:  map[string]string{"other": "{0} j", "per": "{0}/j"},
+		Narrow: map[string]string{"other": "{0} j", "per": "{0}/j"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} inci", "per": "{0} per inci"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0} per kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} kilobyte", "per": "{0} per kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} kilogram", "per": "{0} per kilogram"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} kilometer", "per": "{0} per kilometer"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} kilometer per jam"},
+		Short:  map[string]string{"other": "{0} km/j"},
+		Narrow: map[string]string{"other": "{0}km/j"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} liter", "per": "{0} per liter"},
+		Short:  map[string]string{"other": "{0} L", "per": "{0}/L"},
+		Narrow: map[string]string{"other": "{0} L", "per": "{0}/L"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} liter per kilometer"},
+		Short:  map[string]string{"other": "{0} L/km"},
+		Narrow: map[string]string{"other": "{0} L/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0} per megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} megabyte", "per": "{0} per megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} meter", "per": "{0} per meter"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} meter per detik"},
+		Short:  map[string]string{"other": "{0} m/dtk"},
+		Narrow: map[string]string{"other": "{0} m/dtk"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} mikrodetik", "per": "{0} per mikrodetik"},
+		Short:  map[string]string{"other": "{0} μd", "per": "{0}/μd"},
+		Narrow: map[string]string{"other": "{0} μd", "per": "{0}/μd"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} mil", "per": "{0} per mil"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} mil per galon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} mil per jam"},
+		Short:  map[string]string{"other": "{0} mpj"},
+		Narrow: map[string]string{"other": "{0} mpj"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} mil skandinavia", "per": "{0} per mil skandinavia"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} mililiter", "per": "{0} per mililiter"},
+		Short:  map[string]string{"other": "{0} mL", "per": "{0}/mL"},
+		Narrow: map[string]string{"other": "{0} mL", "per": "{0}/mL"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} milimeter", "per": "{0} per milimeter"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} milidetik", "per": "{0} per milidetik"},
+		Short:  map[string]string{"other": "{0} md", "per": "{0}/md"},
+		Narrow: map[string]string{"other": "{0} md", "per": "{0}/md"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} menit", "per": "{0} per menit"},
+		Short:  map[string]string{"other": "{0} mnt", "per": "{0}/mnt"},
+		Narrow: map[string]string{"other": "{0} mnt", "per": "{0}/mnt"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} bulan", "per": "{0} per bulan"},
+		Short:  map[string]string{"other": "{0} bln", "per": "{0}/bln"},
+		Narrow: map[string]string{"other": "{0} bln", "per": "{0}/bln"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} nanodetik", "per": "{0} per nanodetik"},
+		Short:  map[string]string{"other": "{0} ndtk", "per": "{0}/ndtk"},
+		Narrow: map[string]string{"other": "{0} ndtk", "per": "{0}/ndtk"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} ounce", "per": "{0} per ounce"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} per {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} petabyte", "per": "{0} per petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} pound", "per": "{0} per pound"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}#", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} detik", "per": "{0} per detik"},
+		Short:  map[string]string{"other": "{0} dtk", "per": "{0}/dtk"},
+		Narrow: map[string]string{"other": "{0} dtk", "per": "{0}/dtk"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} sentimeter persegi", "per": "{0} per sentimeter persegi"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} kaki persegi"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} kilometer persegi", "per": "{0} per kilometer persegi"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} meter persegi", "per": "{0} per meter persegi"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} mil persegi", "per": "{0} per mil persegi"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} stone", "per": "{0} per stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0} per terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} terabyte", "per": "{0} per terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} minggu", "per": "{0} per minggu"},
+		Short:  map[string]string{"other": "{0} mgg", "per": "{0}/mgg"},
+		Narrow: map[string]string{"other": "{0} mgg", "per": "{0}/mgg"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} yard", "per": "{0} per yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} tahun", "per": "{0} per tahun"},
+		Short:  map[string]string{"other": "{0} thn", "per": "{0}/thn"},
+		Narrow: map[string]string{"other": "{0} thn", "per": "{0}/thn"},
+	},
+}
@@ -39,6 +39,20 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-many": "n = 11,8,80,800"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	if i64 == 11 || i64 == 8 || i64 == 80 || i64 == 800 {
+		return "many"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Italian,
 	data: hc.CldrData{
@@ -98,5 +112,81 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 Bln",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0",
+				"1000-count-other":            "0",
+				"10000-count-one":             "0",
+				"10000-count-other":           "0",
+				"100000-count-one":            "0",
+				"100000-count-other":          "0",
+				"1000000-count-one":           "0 Mln ¤",
+				"1000000-count-other":         "0 Mln ¤",
+				"10000000-count-one":          "00 Mln ¤",
+				"10000000-count-other":        "00 Mln ¤",
+				"100000000-count-one":         "000 Mln ¤",
+				"100000000-count-other":       "000 Mln ¤",
+				"1000000000-count-one":        "0 Mld ¤",
+				"1000000000-count-other":      "0 Mld ¤",
+				"10000000000-count-one":       "00 Mld ¤",
+				"10000000000-count-other":     "00 Mld ¤",
+				"100000000000-count-one":      "000 Mld ¤",
+				"100000000000-count-other":    "000 Mld ¤",
+				"1000000000000-count-one":     "0 Bln ¤",
+				"1000000000000-count-other":   "0 Bln ¤",
+				"10000000000000-count-one":    "00 Bln ¤",
+				"10000000000000-count-other":  "00 Bln ¤",
+				"100000000000000-count-one":   "000 Bln ¤",
+				"100000000000000-count-other": "000 Bln ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "A$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"THB": "฿",
+			},
+		},
+		PercentFormat:         "#,##0%",
+		MinimumGroupingDigits: 2,
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} e {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} e {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} e {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} e {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}º"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}-{1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥{0}",
+		},
 	},
 }
@@ -0,0 +1,213 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: zero;
+1: uno;
+2: due;
+3: tre;
+4: quattro;
+5: cinque;
+6: sei;
+7: sette;
+8: otto;
+9: nove;
+10: dieci;
+11: undici;
+12: dodici;
+13: tredici;
+14: quattordici;
+15: quindici;
+16: sedici;
+17: diciassette;
+18: diciotto;
+19: diciannove;
+20: vent>%%msco-with-i>;
+30: trent>%%msco-with-a>;
+40: quarant>%%msco-with-a>;
+50: cinquant>%%msco-with-a>;
+60: sessant>%%msco-with-a>;
+70: settant>%%msco-with-a>;
+80: ottant>%%msco-with-a>;
+90: novant>%%msco-with-a>;
+100: cent>%%msco-with-o>;
+200: <%spellout-numbering<­cent>%%msco-with-o>;
+1000: mille;
+1001: mille­>%spellout-numbering>;
+2000: <%%msc-no-final<­mila;
+2001: <%%msc-no-final<­mila­>%spellout-numbering>;
+1000000: un milione;
+1000001: un milione >%spellout-numbering>;
+2000000: <%spellout-cardinal-masculine< milioni;
+2000001: <%spellout-cardinal-masculine< milioni >%spellout-numbering>;
+1000000000: un miliardo;
+1000000001: un miliardo >%spellout-numbering>;
+2000000000: <%spellout-cardinal-masculine< miliardi;
+2000000001: <%spellout-cardinal-masculine< miliardi >%spellout-numbering>;
+1000000000000: un bilione;
+1000000000001: un bilione >%spellout-numbering>;
+2000000000000: <%spellout-cardinal-masculine< bilioni;
+2000000000001: <%spellout-cardinal-masculine< bilioni >%spellout-numbering>;
+1000000000000000: un biliardo;
+1000000000000001: un biliardo >%spellout-numbering>;
+2000000000000000: <%spellout-cardinal-masculine< biliardi;
+2000000000000001: <%spellout-cardinal-masculine< biliardi >%spellout-numbering>;
+1000000000000000000: =#,##0=;
+-x: meno >%spellout-numbering>;
+x.x: <%spellout-numbering< virgola >%spellout-numbering>;
+%%msco-with-i:
+0: i;
+1: ­uno;
+2: i­due;
+3: i­tré;
+4: i­=%spellout-numbering=;
+8: ­otto;
+9: i­nove;
+%%msco-with-a:
+0: a;
+1: ­uno;
+2: a­due;
+3: a­tré;
+4: a­=%spellout-numbering=;
+8: ­otto;
+9: a­nove;
+%%msco-with-o:
+0: o;
+1: o­uno;
+2: o­due;
+3: o­tré;
+4: o­=%spellout-numbering=;
+8: ­otto;
+9: o­=%spellout-numbering=;
+80: ­=%spellout-numbering=;
+90: o­=%spellout-numbering=;
+%spellout-cardinal-masculine:
+0: zero;
+1: un;
+2: =%spellout-numbering=;
+20: vent>%%msc-with-i>;
+30: trent>%%msc-with-a>;
+40: quarant>%%msc-with-a>;
+50: cinquant>%%msc-with-a>;
+60: sessant>%%msc-with-a>;
+70: settant>%%msc-with-a>;
+80: ottant>%%msc-with-a>;
+90: novant>%%msc-with-a>;
+100: cent>%%msc-with-o>;
+200: <%spellout-cardinal-masculine<­cent>%%msc-with-o>;
+1000: mille;
+1001: mille­>%spellout-cardinal-masculine>;
+2000: <%%msc-no-final<­mila;
+2001: <%%msc-no-final<­mila­>%spellout-cardinal-masculine>;
+1000000: un milione;
+1000001: un milione >%spellout-cardinal-masculine>;
+2000000: <%spellout-cardinal-masculine< milioni;
+2000001: <%spellout-cardinal-masculine< milioni >%spellout-cardinal-masculine>;
+1000000000: un miliardo;
+1000000001: un miliardo >%spellout-cardinal-masculine>;
+2000000000: <%spellout-cardinal-masculine< miliardi;
+2000000001: <%spellout-cardinal-masculine< miliardi >%spellout-cardinal-masculine>;
+1000000000000: un bilione;
+1000000000001: un bilione >%spellout-cardinal-masculine>;
+2000000000000: <%spellout-cardinal-masculine< bilioni;
+2000000000001: <%spellout-cardinal-masculine< bilioni >%spellout-cardinal-masculine>;
+1000000000000000: un biliardo;
+1000000000000001: un biliardo >%spellout-cardinal-masculine>;
+2000000000000000: <%spellout-cardinal-masculine< biliardi;
+2000000000000001: <%spellout-cardinal-masculine< biliardi >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: meno >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< virgola >%spellout-cardinal-masculine>;
+%%msc-with-i:
+0: i;
+1: ­un;
+2: =%%msco-with-i=;
+%%msc-with-a:
+0: a;
+1: ­un;
+2: =%%msco-with-a=;
+%%msc-with-o:
+0: o;
+1: o­uno;
+2: o­due;
+3: o­tré;
+4: o­=%spellout-numbering=;
+8: ­otto;
+9: o­=%spellout-numbering=;
+80: ­=%spellout-numbering=;
+90: o­=%spellout-numbering=;
+%%msc-no-final:
+0: =%spellout-cardinal-masculine=;
+20: vent>%%msc-with-i-nofinal>;
+30: trent>%%msc-with-a-nofinal>;
+40: quarant>%%msc-with-a-nofinal>;
+50: cinquant>%%msc-with-a-nofinal>;
+60: sessant>%%msc-with-a-nofinal>;
+70: settant>%%msc-with-a-nofinal>;
+80: ottant>%%msc-with-a-nofinal>;
+90: novant>%%msc-with-a-nofinal>;
+100: cent>%%msc-with-o-nofinal>;
+200: <%%msc-no-final<­cent>%%msc-with-o-nofinal>;
+%%msc-with-i-nofinal:
+0: =%%msc-with-i=;
+3: i­tre;
+4: =%%msc-with-i=;
+%%msc-with-a-nofinal:
+0: =%%msc-with-a=;
+3: a­tre;
+4: =%%msc-with-a=;
+%%msc-with-o-nofinal:
+0: =%%msc-with-o=;
+3: o­tre;
+4: =%%msc-with-o=;
+%spellout-cardinal-feminine:
+0: zero;
+1: una;
+2: =%spellout-numbering=;
+20: vent>%%fem-with-i>;
+30: trent>%%fem-with-a>;
+40: quarant>%%fem-with-a>;
+50: cinquant>%%fem-with-a>;
+60: sessant>%%fem-with-a>;
+70: settant>%%fem-with-a>;
+80: ottant>%%fem-with-a>;
+90: novant>%%fem-with-a>;
+100: cent>%%fem-with-o>;
+200: <%spellout-cardinal-feminine<­cent>%%fem-with-o>;
+1000: mille;
+1001: mille­>%spellout-cardinal-feminine>;
+2000: <%%msc-no-final<­mila;
+2001: <%%msc-no-final<­mila­>%spellout-cardinal-feminine>;
+1000000: un milione;
+1000001: un milione >%spellout-cardinal-feminine>;
+2000000: <%spellout-cardinal-masculine< milioni;
+2000001: <%spellout-cardinal-masculine< milioni >%spellout-cardinal-feminine>;
+1000000000: un miliardo;
+1000000001: un miliardo >%spellout-cardinal-feminine>;
+2000000000: <%spellout-cardinal-masculine< miliardi;
+2000000001: <%spellout-cardinal-masculine< miliardi >%spellout-cardinal-feminine>;
+1000000000000: un bilione;
+1000000000001: un bilione >%spellout-cardinal-feminine>;
+2000000000000: <%spellout-cardinal-masculine< bilioni;
+2000000000001: <%spellout-cardinal-masculine< bilioni >%spellout-cardinal-feminine>;
+1000000000000000: un biliardo;
+1000000000000001: un biliardo >%spellout-cardinal-feminine>;
+2000000000000000: <%spellout-cardinal-masculine< biliardi;
+2000000000000001: <%spellout-cardinal-masculine< biliardi >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: meno >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< virgola >%spellout-cardinal-feminine>;
+%%fem-with-i:
+0: i;
+1: ­una;
+2: =%%msco-with-i=;
+%%fem-with-a:
+0: a;
+1: ­una;
+2: =%%msco-with-a=;
+%%fem-with-o:
+0: o;
+1: o­una;
+2: =%%msco-with-o=;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+			Past:     map[string]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+			Relative: map[string]string{"-1": "anno scorso", "0": "quest’anno", "1": "anno prossimo"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+			Past:     map[string]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+			Relative: map[string]string{"-1": "anno scorso", "0": "quest’anno", "1": "anno prossimo"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} anno", "other": "tra {0} anni"},
+			Past:     map[string]string{"one": "{0} anno fa", "other": "{0} anni fa"},
+			Relative: map[string]string{"-1": "anno scorso", "0": "quest’anno", "1": "anno prossimo"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} trimestre", "other": "tra {0} trimestri"},
+			Past:     map[string]string{"one": "{0} trimestre fa", "other": "{0} trimestri fa"},
+			Relative: map[string]string{"-1": "trimestre scorso", "0": "questo trimestre", "1": "trimestre prossimo"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} trim."},
+			Past:     map[string]string{"other": "{0} trim. fa"},
+			Relative: map[string]string{"-1": "trim. scorso", "0": "questo trim.", "1": "trim. prossimo"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} trim."},
+			Past:     map[string]string{"other": "{0} trim. fa"},
+			Relative: map[string]string{"-1": "trim. scorso", "0": "questo trim.", "1": "trim. prossimo"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+			Past:     map[string]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+			Relative: map[string]string{"-1": "mese scorso", "0": "questo mese", "1": "mese prossimo"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+			Past:     map[string]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+			Relative: map[string]string{"-1": "mese scorso", "0": "questo mese", "1": "mese prossimo"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} mese", "other": "tra {0} mesi"},
+			Past:     map[string]string{"one": "{0} mese fa", "other": "{0} mesi fa"},
+			Relative: map[string]string{"-1": "mese scorso", "0": "questo mese", "1": "mese prossimo"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} settimana", "other": "tra {0} settimane"},
+			Past:     map[string]string{"one": "{0} settimana fa", "other": "{0} settimane fa"},
+			Relative: map[string]string{"-1": "settimana scorsa", "0": "questa settimana", "1": "settimana prossima"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} sett."},
+			Past:     map[string]string{"other": "{0} sett. fa"},
+			Relative: map[string]string{"-1": "sett. scorsa", "0": "questa sett.", "1": "sett. prossima"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} sett."},
+			Past:     map[string]string{"other": "{0} sett. fa"},
+			Relative: map[string]string{"-1": "sett. scorsa", "0": "questa sett.", "1": "sett. prossima"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} giorno", "other": "tra {0} giorni"},
+			Past:     map[string]string{"one": "{0} giorno fa", "other": "{0} giorni fa"},
+			Relative: map[string]string{"-2": "l’altro ieri", "-1": "ieri", "0": "oggi", "1": "domani", "2": "dopodomani"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} g", "other": "tra {0} gg"},
+			Past:     map[string]string{"one": "{0} g fa", "other": "{0} gg fa"},
+			Relative: map[string]string{"-2": "l’altro ieri", "-1": "ieri", "0": "oggi", "1": "domani", "2": "dopodomani"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} g", "other": "tra {0} gg"},
+			Past:     map[string]string{"one": "{0} g fa", "other": "{0} gg fa"},
+			Relative: map[string]string{"-2": "l’altro ieri", "-1": "ieri", "0": "oggi", "1": "domani", "2": "dopodomani"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} ora", "other": "tra {0} ore"},
+			Past:     map[string]string{"one": "{0} ora fa", "other": "{0} ore fa"},
+			Relative: map[string]string{"0": "quest’ora"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} h"},
+			Past:     map[string]string{"other": "{0} h fa"},
+			Relative: map[string]string{"0": "quest’ora"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} h"},
+			Past:     map[string]string{"other": "{0} h fa"},
+			Relative: map[string]string{"0": "quest’ora"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} minuto", "other": "tra {0} minuti"},
+			Past:     map[string]string{"one": "{0} minuto fa", "other": "{0} minuti fa"},
+			Relative: map[string]string{"0": "questo minuto"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} min"},
+			Past:     map[string]string{"other": "{0} min fa"},
+			Relative: map[string]string{"0": "questo minuto"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} min"},
+			Past:     map[string]string{"other": "{0} min fa"},
+			Relative: map[string]string{"0": "questo minuto"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "tra {0} secondo", "other": "tra {0} secondi"},
+			Past:     map[string]string{"one": "{0} secondo fa", "other": "{0} secondi fa"},
+			Relative: map[string]string{"0": "ora"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} sec."},
+			Past:     map[string]string{"other": "{0} sec. fa"},
+			Relative: map[string]string{"0": "ora"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "tra {0} s"},
+			Past:     map[string]string{"other": "{0} s fa"},
+			Relative: map[string]string{"0": "ora"},
+		},
+	},
+}
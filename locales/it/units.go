@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} acro", "other": "{0} acri", "per": "{0} al acro"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+		Gender: "masculine",
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0} al bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0}bit", "per": "{0}/bit"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} byte", "per": "{0} al byte"},
+		Short:  map[string]string{"other": "{0} byte", "per": "{0}/byte"},
+		Narrow: map[string]string{"other": "{0}B", "per": "{0}/B"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} grado Celsius", "other": "{0} gradi Celsius", "per": "{0} al grado Celsius"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "masculine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centimetro", "other": "{0} centimetri", "per": "{0} per centimetro"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} giorno", "other": "{0} giorni", "per": "{0} al giorno"},
+		Short:  map[string]string{"one": "{0} giorno", "other": "{0} giorni", "per": "{0}/giorno"},
+		Narrow: map[string]string{"one": "{0}g", "other": "{0}gg", "per": "{0}/g"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} grado", "other": "{0} gradi", "per": "{0} al grado"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "masculine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} grado Fahrenheit", "other": "{0} gradi Fahrenheit", "per": "{0} al grado Fahrenheit"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "masculine",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} oncia liquida", "other": "{0} once liquide", "per": "{0} al oncia liquida"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+		Gender: "feminine",
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} piede", "other": "{0} piedi", "per": "{0} per piede"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}ft", "per": "{0}/ft"},
+		Gender: "masculine",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} gallone", "other": "{0} galloni", "per": "{0} per gallone"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+		Gender: "masculine",
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0} al gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} gigabyte", "per": "{0} al gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} grammo", "other": "{0} grammi", "per": "{0} per grammo"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} ettaro", "other": "{0} ettari", "per": "{0} al ettaro"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} ora", "other": "{0} ore", "per": "{0} all’ora"},
+		Short:  map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} pollice", "other": "{0} pollici", "per": "{0} per pollice"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+		Gender: "masculine",
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0} al kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} kilobyte", "per": "{0} al kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} chilogrammo", "other": "{0} chilogrammi", "per": "{0} per chilogrammo"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} chilometro", "other": "{0} chilometri", "per": "{0} per chilometro"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} chilometro orario", "other": "{0} chilometri orari"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} litro", "other": "{0} litri", "per": "{0} per litro"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0}l", "per": "{0}/l"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} litro per chilometro", "other": "{0} litri per chilometro"},
+		Short:  map[string]string{"other": "{0} L/km"},
+		Narrow: map[string]string{"other": "{0}L/km"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0} al megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} megabyte", "per": "{0} al megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} metro", "other": "{0} metri", "per": "{0} per metro"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} metro al secondo", "other": "{0} metri al secondo"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} microsecondo", "other": "{0} microsecondi", "per": "{0} al microsecondo"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Gender: "masculine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} miglio", "other": "{0} miglia", "per": "{0} al miglio"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+		Gender: "feminine",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} miglio per gallone", "other": "{0} miglia per gallone"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+		Gender: "feminine",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} miglio all’ora", "other": "{0} miglia all’ora"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0}mi/h"},
+		Gender: "feminine",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} miglio scandinavo", "other": "{0} miglia scandinave", "per": "{0} al miglio scandinavo"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} millilitro", "other": "{0} millilitri", "per": "{0} al millilitro"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0}ml", "per": "{0}/ml"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} millimetro", "other": "{0} millimetri", "per": "{0} al millimetro"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} millisecondo", "other": "{0} millisecondi", "per": "{0} al millisecondo"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+		Gender: "masculine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minuto", "other": "{0} minuti", "per": "{0} al minuto"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0}min", "per": "{0}/min"},
+		Gender: "masculine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} mese", "other": "{0} mesi", "per": "{0} al mese"},
+		Short:  map[string]string{"one": "{0} mese", "other": "{0} mesi", "per": "{0}/mese"},
+		Narrow: map[string]string{"one": "{0} mese", "other": "{0} mesi", "per": "{0}/mese"},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosecondo", "other": "{0} nanosecondi", "per": "{0} al nanosecondo"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+		Gender: "masculine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} oncia", "other": "{0} once", "per": "{0} per oncia"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+		Gender: "feminine",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} al {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} petabyte", "per": "{0} al petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} libbra", "other": "{0} libbre", "per": "{0} per libbra"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+		Gender: "feminine",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} secondo", "other": "{0} secondi", "per": "{0} al secondo"},
+		Short:  map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+		Gender: "masculine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} centimetro quadrato", "other": "{0} centimetri quadrati", "per": "{0} per centimetro quadrato"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} piede quadrato", "other": "{0} piedi quadrati"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+		Gender: "masculine",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} chilometro quadrato", "other": "{0} chilometri quadrati", "per": "{0} per chilometro quadrato"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} metro quadrato", "other": "{0} metri quadrati", "per": "{0} per metro quadrato"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} miglio quadrato", "other": "{0} miglia quadrate", "per": "{0} per miglio quadrato"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+		Gender: "feminine",
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} stone", "per": "{0} al stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0} al terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} terabyte", "per": "{0} al terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} settimana", "other": "{0} settimane", "per": "{0} alla settimana"},
+		Short:  map[string]string{"other": "{0} sett.", "per": "{0}/settimana"},
+		Narrow: map[string]string{"other": "{0}sett.", "per": "{0}/sett."},
+		Gender: "feminine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} iarda", "other": "{0} iarde", "per": "{0} al iarda"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+		Gender: "feminine",
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} anno", "other": "{0} anni", "per": "{0} all’anno"},
+		Short:  map[string]string{"one": "{0} anno", "other": "{0} anni", "per": "{0}/anno"},
+		Narrow: map[string]string{"one": "{0}anno", "other": "{0}anni", "per": "{0}/anno"},
+		Gender: "masculine",
+	},
+}
@@ -1,10 +1,12 @@
 package locale_test
 
 import (
+	"strings"
 	"testing"
 
 	hc "github.com/dejurin/humanizecompact"
 	locale "github.com/dejurin/humanizecompact/locales/ja"
+	"github.com/govalues/decimal"
 	"golang.org/x/text/language"
 )
 
@@ -75,3 +77,266 @@ func TestHumanizeJaOptionShort(t *testing.T) {
 		}
 	}
 }
+
+// TestHumanizeJaPrecisionPolicy checks that exact compact numbers keep two
+// fraction digits, with and without a region subtag.
+func TestHumanizeJaPrecisionPolicy(t *testing.T) {
+	tests := []struct {
+		tag      language.Tag
+		number   string
+		expected string
+	}{
+		{language.Japanese, "12500", "1.25万"},
+		{language.Japanese, "12345", "12345"},
+		{language.MustParse("ja-JP"), "12500", "1.25万"},
+		{language.MustParse("ja-JP"), "12345", "12345"},
+		{language.MustParse("ja-JP"), "120000", "12万"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimal(decimal.MustParse(tt.number), tt.tag)
+		if err != nil {
+			t.Errorf("[POLICY] %s number %q => unexpected error: %v", tt.tag, tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[POLICY] %s number %q => got %q, want %q", tt.tag, tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeJaOrdinal(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "第1"},
+		{"10000", "第10,000"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatOrdinal(tt.number, language.Japanese, hc.Options{})
+		if err != nil {
+			t.Errorf("[ORDINAL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[ORDINAL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeJaApproximately(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"12000", "1.2万"},
+		{"12345", "約1.2万"},
+		{"123456789", "約1.2億"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1), Approximately: true}
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.Japanese, opts)
+		if err != nil {
+			t.Errorf("[APPROX] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[APPROX] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeJaComposite(t *testing.T) {
+	tests := []struct {
+		number   string
+		maxUnits int
+		expected string
+	}{
+		{"123456789", 0, "1億2345万6789"},
+		{"123456789", 1, "1億"},
+		{"123456789", 2, "1億2346万"},
+		{"100000000", 0, "1億"},
+		{"100020000", 0, "1億2万"},
+		{"1234567", 0, "123万4567"},
+		{"12345.67", 0, "1万2345.67"},
+		{"199996000", 2, "2億"},
+		{"-123456789", 0, "-1億2345万6789"},
+		{"9999", 0, "9999"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		opts := hc.Options{Composite: true, MaxUnits: tt.maxUnits}
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.Japanese, opts)
+		if err != nil {
+			t.Errorf("[COMPOSITE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[COMPOSITE] number %q (%d) => got %q, want %q", tt.number, tt.maxUnits, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeJaNumberingSystem(t *testing.T) {
+	tests := []struct {
+		tag       string
+		number    string
+		composite bool
+		expected  string
+	}{
+		{"ja-u-nu-hanidec", "12000", false, "一.二万"},
+		{"ja-u-nu-hanidec", "123456789", true, "一億二三四五万六七八九"},
+		{"ja-u-nu-hanidec", "-2500", false, "-二,五〇〇"},
+		{"ja-u-nu-jpan", "12000", false, "一・二万"},
+		{"ja-u-nu-jpan", "12000", true, "一万二千"},
+		{"ja-u-nu-jpan", "123456789", true, "一億二千三百四十五万六千七百八十九"},
+		{"ja-u-nu-jpanfin", "12000", true, "壱万弐千"},
+		{"ja-u-nu-jpanfin", "-2500", false, "-弐千伍百"},
+		{"ja-u-nu-latn", "12000", false, "1.2万"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		opts := hc.Options{Composite: tt.composite, CompactThreshold: 10000}
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.MustParse(tt.tag), opts)
+		if err != nil {
+			t.Errorf("[NU] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[NU] number %q (%s) => got %q, want %q", tt.number, tt.tag, res, tt.expected)
+		}
+	}
+
+	res, _, err := h.FormatDecimal(decimal.MustParse("12000"), language.MustParse("ja-u-nu-jpan"))
+	if err != nil || res != "一・二万" {
+		t.Errorf("[NU] decimal %q => got %q, %v, want %q", "12000", res, err, "一・二万")
+	}
+}
+
+func TestHumanizeJaFit(t *testing.T) {
+	tests := []struct {
+		number   string
+		width    int
+		expected string
+	}{
+		{"123456789", 12, "123,456,789"},
+		{"123456789", 6, "1.23億"},
+		{"123456789", 5, "1.2億"},
+		{"123456789", 3, "1億"},
+		{"1234567", 5, "123万"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatFit(tt.number, language.Japanese, tt.width)
+		if err != nil {
+			t.Errorf("[FIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[FIT] number %q (%d) => got %q, want %q", tt.number, tt.width, res, tt.expected)
+		}
+	}
+
+	// Only the cap one magnitude below the number is tried.
+	if res, err := h.FormatFit("1000000000000000000", language.Japanese, 4); err == nil {
+		t.Errorf("[FIT] width 4 => got %q, expected an error", res)
+	}
+
+	if w := hc.DisplayWidth("1.2億"); w != 5 {
+		t.Errorf("[FIT] width of %q => got %d, want 5", "1.2億", w)
+	}
+}
+
+func TestHumanizeJaTicks(t *testing.T) {
+	tests := []struct {
+		min, max string
+		count    int
+		expected []string
+	}{
+		{"0", "75000000", 4, []string{"0", "2500万", "5000万", "7500万"}},
+		{"1000000", "1000100", 5, []string{"100.0000万", "100.0025万", "100.0050万", "100.0075万", "100.0100万"}},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		ticks, err := h.FormatTicks(tt.min, tt.max, tt.count, language.Japanese)
+		if err != nil {
+			t.Errorf("[TICKS] %s–%s => unexpected error: %v", tt.min, tt.max, err)
+			continue
+		}
+		labels := make([]string, len(ticks))
+		for i, tick := range ticks {
+			labels[i] = tick.Label
+		}
+		if strings.Join(labels, " ") != strings.Join(tt.expected, " ") {
+			t.Errorf("[TICKS] %s–%s => got %q, want %q", tt.min, tt.max, labels, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeJaUnit(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		expected string
+	}{
+		{hc.Short, "20000", "square-meter", "2万 m²"},
+		{hc.Long, "20000", "square-meter", "2万 平方メートル"},
+		{hc.Narrow, "35000000", "square-kilometer", "3500万km²"},
+		{hc.Short, "1200", "square-meter", "1,200 m²"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatUnit(tt.number, tt.unit, language.Japanese, hc.Options{Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[UNIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[UNIT] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeJaFixedScale(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"120000000", "1.2億"},
+		{"95000000", "0.95億"},
+		{"-50000", "-0億"},
+		{"50000", "0億"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.Japanese, hc.Options{FixedScale: 8})
+		if err != nil {
+			t.Errorf("[SCALE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SCALE] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%spellout-cardinal:
+0: 〇;
+1: 一;
+2: 二;
+3: 三;
+4: 四;
+5: 五;
+6: 六;
+7: 七;
+8: 八;
+9: 九;
+10: 十;
+11: 十>%spellout-cardinal>;
+20: <%spellout-cardinal<十;
+21: <%spellout-cardinal<十>%spellout-cardinal>;
+100: 百;
+101: 百>%spellout-cardinal>;
+200: <%spellout-cardinal<百;
+201: <%spellout-cardinal<百>%spellout-cardinal>;
+1000: 千;
+1001: 千>%spellout-cardinal>;
+2000: <%spellout-cardinal<千;
+2001: <%spellout-cardinal<千>%spellout-cardinal>;
+10000: <%spellout-cardinal<万;
+10001: <%spellout-cardinal<万>%spellout-cardinal>;
+100000000: <%spellout-cardinal<億;
+100000001: <%spellout-cardinal<億>%spellout-cardinal>;
+1000000000000: <%spellout-cardinal<兆;
+1000000000001: <%spellout-cardinal<兆>%spellout-cardinal>;
+10000000000000000: <%spellout-cardinal<京;
+10000000000000001: <%spellout-cardinal<京>%spellout-cardinal>;
+1000000000000000000: =#,##0=;
+-x: マイナス>%spellout-cardinal>;
+x.x: <%spellout-cardinal<・>>>;
+%spellout-cardinal-financial:
+0: 零;
+1: 壱;
+2: 弐;
+3: 参;
+4: 四;
+5: 伍;
+6: 六;
+7: 七;
+8: 八;
+9: 九;
+10: 拾;
+11: 拾>%spellout-cardinal-financial>;
+20: <%spellout-cardinal-financial<拾;
+21: <%spellout-cardinal-financial<拾>%spellout-cardinal-financial>;
+100: <%spellout-cardinal-financial<百;
+101: <%spellout-cardinal-financial<百>%spellout-cardinal-financial>;
+1000: <%spellout-cardinal-financial<千;
+1001: <%spellout-cardinal-financial<千>%spellout-cardinal-financial>;
+10000: <%spellout-cardinal-financial<萬;
+10001: <%spellout-cardinal-financial<萬>%spellout-cardinal-financial>;
+100000000: <%spellout-cardinal-financial<億;
+100000001: <%spellout-cardinal-financial<億>%spellout-cardinal-financial>;
+1000000000000: <%spellout-cardinal-financial<兆;
+1000000000001: <%spellout-cardinal-financial<兆>%spellout-cardinal-financial>;
+10000000000000000: <%spellout-cardinal-financial<京;
+10000000000000001: <%spellout-cardinal-financial<京>%spellout-cardinal-financial>;
+1000000000000000000: =#,##0=;
+-x: マイナス>%spellout-cardinal-financial>;
+x.x: <%spellout-cardinal-financial<点>%spellout-cardinal-financial>;
+`
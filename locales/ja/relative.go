@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 年後"},
+			Past:     map[string]string{"other": "{0} 年前"},
+			Relative: map[string]string{"-1": "昨年", "0": "今年", "1": "来年"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 年後"},
+			Past:     map[string]string{"other": "{0} 年前"},
+			Relative: map[string]string{"-1": "昨年", "0": "今年", "1": "来年"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}年後"},
+			Past:     map[string]string{"other": "{0}年前"},
+			Relative: map[string]string{"-1": "昨年", "0": "今年", "1": "来年"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 四半期後"},
+			Past:     map[string]string{"other": "{0} 四半期前"},
+			Relative: map[string]string{"-1": "前四半期", "0": "今四半期", "1": "翌四半期"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 四半期後"},
+			Past:     map[string]string{"other": "{0} 四半期前"},
+			Relative: map[string]string{"-1": "前四半期", "0": "今四半期", "1": "翌四半期"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}四半期後"},
+			Past:     map[string]string{"other": "{0}四半期前"},
+			Relative: map[string]string{"-1": "前四半期", "0": "今四半期", "1": "翌四半期"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} か月後"},
+			Past:     map[string]string{"other": "{0} か月前"},
+			Relative: map[string]string{"-1": "先月", "0": "今月", "1": "来月"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} か月後"},
+			Past:     map[string]string{"other": "{0} か月前"},
+			Relative: map[string]string{"-1": "先月", "0": "今月", "1": "来月"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}か月後"},
+			Past:     map[string]string{"other": "{0}か月前"},
+			Relative: map[string]string{"-1": "先月", "0": "今月", "1": "来月"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 週間後"},
+			Past:     map[string]string{"other": "{0} 週間前"},
+			Relative: map[string]string{"-1": "先週", "0": "今週", "1": "来週"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 週間後"},
+			Past:     map[string]string{"other": "{0} 週間前"},
+			Relative: map[string]string{"-1": "先週", "0": "今週", "1": "来週"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}週間後"},
+			Past:     map[string]string{"other": "{0}週間前"},
+			Relative: map[string]string{"-1": "先週", "0": "今週", "1": "来週"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 日後"},
+			Past:     map[string]string{"other": "{0} 日前"},
+			Relative: map[string]string{"-2": "一昨日", "-1": "昨日", "0": "今日", "1": "明日", "2": "明後日"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 日後"},
+			Past:     map[string]string{"other": "{0} 日前"},
+			Relative: map[string]string{"-2": "一昨日", "-1": "昨日", "0": "今日", "1": "明日", "2": "明後日"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}日後"},
+			Past:     map[string]string{"other": "{0}日前"},
+			Relative: map[string]string{"-2": "一昨日", "-1": "昨日", "0": "今日", "1": "明日", "2": "明後日"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 時間後"},
+			Past:     map[string]string{"other": "{0} 時間前"},
+			Relative: map[string]string{"0": "1 時間以内"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 時間後"},
+			Past:     map[string]string{"other": "{0} 時間前"},
+			Relative: map[string]string{"0": "1 時間以内"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}時間後"},
+			Past:     map[string]string{"other": "{0}時間前"},
+			Relative: map[string]string{"0": "1 時間以内"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 分後"},
+			Past:     map[string]string{"other": "{0} 分前"},
+			Relative: map[string]string{"0": "1 分以内"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 分後"},
+			Past:     map[string]string{"other": "{0} 分前"},
+			Relative: map[string]string{"0": "1 分以内"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}分後"},
+			Past:     map[string]string{"other": "{0}分前"},
+			Relative: map[string]string{"0": "1 分以内"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 秒後"},
+			Past:     map[string]string{"other": "{0} 秒前"},
+			Relative: map[string]string{"0": "今"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} 秒後"},
+			Past:     map[string]string{"other": "{0} 秒前"},
+			Relative: map[string]string{"0": "今"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}秒後"},
+			Past:     map[string]string{"other": "{0}秒前"},
+			Relative: map[string]string{"0": "今"},
+		},
+	},
+}
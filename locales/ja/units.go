@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} エーカー", "per": "{0}毎エーカー"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} ビット", "per": "{0}毎ビット"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0}b", "per": "{0}/b"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} バイト", "per": "{0}毎バイト"},
+		Short:  map[string]string{"other": "{0} byte", "per": "{0}/byte"},
+		Narrow: map[string]string{"other": "{0}B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "摂氏 {0} 度", "per": "{0}毎摂氏  度"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} センチメートル", "per": "{0}/センチメートル"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} 日", "per": "{0}/日"},
+		Short:  map[string]string{"other": "{0} 日", "per": "{0}/日"},
+		Narrow: map[string]string{"other": "{0}d", "per": "{0}/日"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} 度", "per": "{0}毎度"},
+		Short:  map[string]string{"other": "{0} 度", "per": "{0}/度"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "華氏 {0} 度", "per": "{0}毎華氏  度"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} 液量オンス", "per": "{0}毎液量オンス"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} フィート", "per": "{0}/フィート"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} ガロン", "per": "{0}/ガロン"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} ギガビット", "per": "{0}毎ギガビット"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} ギガバイト", "per": "{0}毎ギガバイト"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} グラム", "per": "{0}/グラム"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} ヘクタール", "per": "{0}毎ヘクタール"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} 時間", "per": "{0}/時間"},
+		Short:  map[string]string{"other": "{0} 時間", "per": "{0}/時間"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/時間"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} インチ", "per": "{0}/インチ"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} キロビット", "per": "{0}毎キロビット"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} キロバイト", "per": "{0}毎キロバイト"},
+		Short:  map[string]string{"other": "{0} KB", "per": "{0}/KB"},
+		Narrow: map[string]string{"other": "{0}KB", "per": "{0}/KB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} キログラム", "per": "{0}/キログラム"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} キロメートル", "per": "{0}/キロメートル"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "時速 {0} キロメートル"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} リットル", "per": "{0}/リットル"},
+		Short:  map[string]string{"other": "{0} L", "per": "{0}/L"},
+		Narrow: map[string]string{"other": "{0}L", "per": "{0}/L"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} リットル毎キロメートル"},
+		Short:  map[string]string{"other": "{0} L/km"},
+		Narrow: map[string]string{"other": "{0}L/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} メガビット", "per": "{0}毎メガビット"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} メガバイト", "per": "{0}毎メガバイト"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} メートル", "per": "{0}/メートル"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "秒速 {0} メートル"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} マイクロ秒", "per": "{0}毎マイクロ秒"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} マイル", "per": "{0}毎マイル"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} マイル毎ガロン"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "時速 {0} マイル"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"other": "{0}mi/h"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} スカンジナビアマイル", "per": "{0}毎スカンジナビアマイル"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} ミリリットル", "per": "{0}毎ミリリットル"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0}ml", "per": "{0}/ml"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} ミリメートル", "per": "{0}毎ミリメートル"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} ミリ秒", "per": "{0}毎ミリ秒"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} 分", "per": "{0}/分"},
+		Short:  map[string]string{"other": "{0} 分", "per": "{0}/分"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/分"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} か月", "per": "{0}/月"},
+		Short:  map[string]string{"other": "{0} か月", "per": "{0}/月"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/月"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} ナノ秒", "per": "{0}毎ナノ秒"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} オンス", "per": "{0}/オンス"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0}毎{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} ペタバイト", "per": "{0}毎ペタバイト"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} ポンド", "per": "{0}/ポンド"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} 秒", "per": "秒速 {0}"},
+		Short:  map[string]string{"other": "{0} 秒", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} 平方センチメートル", "per": "{0}/平方センチメートル"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} 平方フィート"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} 平方キロメートル", "per": "{0}/平方キロメートル"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} 平方メートル", "per": "{0}/平方メートル"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} 平方マイル", "per": "{0}/平方マイル"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} ストーン", "per": "{0}毎ストーン"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} テラビット", "per": "{0}毎テラビット"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} テラバイト", "per": "{0}毎テラバイト"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} 週間", "per": "{0}/週"},
+		Short:  map[string]string{"other": "{0} 週間", "per": "{0}/週"},
+		Narrow: map[string]string{"other": "{0}w", "per": "{0}/週"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} ヤード", "per": "{0}毎ヤード"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} 年", "per": "{0}/年"},
+		Short:  map[string]string{"other": "{0} 年", "per": "{0}/年"},
+		Narrow: map[string]string{"other": "{0}y", "per": "{0}/年"},
+	},
+}
@@ -5,6 +5,7 @@ import (
 
 	hc "github.com/dejurin/humanizecompact"
 	locale "github.com/dejurin/humanizecompact/locales/ko"
+	"github.com/govalues/decimal"
 	"golang.org/x/text/language"
 )
 
@@ -113,3 +114,60 @@ func TestHumanizeKoOptionShort(t *testing.T) {
 		}
 	}
 }
+
+// TestHumanizeKoPrecisionPolicy checks that exact compact numbers keep two
+// fraction digits, with and without a region subtag.
+func TestHumanizeKoPrecisionPolicy(t *testing.T) {
+	tests := []struct {
+		tag      language.Tag
+		number   string
+		expected string
+	}{
+		{language.Korean, "12500", "1.25만"},
+		{language.Korean, "12345", "12345"},
+		{language.MustParse("ko-KR"), "12500", "1.25만"},
+		{language.MustParse("ko-KR"), "12345", "12345"},
+		{language.MustParse("ko-KR"), "120000", "12만"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimal(decimal.MustParse(tt.number), tt.tag)
+		if err != nil {
+			t.Errorf("[POLICY] %s number %q => unexpected error: %v", tt.tag, tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[POLICY] %s number %q => got %q, want %q", tt.tag, tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeKoComposite(t *testing.T) {
+	tests := []struct {
+		number   string
+		maxUnits int
+		expected string
+	}{
+		{"123456789", 0, "1억 2345만 6789"},
+		{"123456789", 2, "1억 2346만"},
+		{"1234567", 0, "123만 4567"},
+		{"1234567", 1, "123만"},
+		{"5000", 0, "5천"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		opts := hc.Options{Composite: true, MaxUnits: tt.maxUnits}
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.Korean, opts)
+		if err != nil {
+			t.Errorf("[COMPOSITE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[COMPOSITE] number %q (%d) => got %q, want %q", tt.number, tt.maxUnits, res, tt.expected)
+		}
+	}
+}
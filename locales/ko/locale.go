@@ -24,6 +24,17 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
+// CompactFractionDigits allows two fraction digits in exact compact
+// numbers, since the 10^4-based scales are coarser, e.g. "1.25만".
+func (l Locale) CompactFractionDigits() int {
+	return 2
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Korean,
 	data: hc.CldrData{
@@ -59,5 +70,74 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000조",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "¤#,##0.00",
+			Accounting: "¤#,##0.00;(¤#,##0.00)",
+			Short: map[string]string{
+				"1000-count-other":            "¤0천",
+				"10000-count-other":           "¤0만",
+				"100000-count-other":          "¤00만",
+				"1000000-count-other":         "¤000만",
+				"10000000-count-other":        "¤0000만",
+				"100000000-count-other":       "¤0억",
+				"1000000000-count-other":      "¤00억",
+				"10000000000-count-other":     "¤000억",
+				"100000000000-count-other":    "¤0000억",
+				"1000000000000-count-other":   "¤0조",
+				"10000000000000-count-other":  "¤00조",
+				"100000000000000-count-other": "¤000조",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}번째"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}~{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
@@ -0,0 +1,46 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: 공;
+1: =%spellout-cardinal-sinokorean=;
+x.x: <%spellout-numbering<점>>>;
+0.x: <%spellout-cardinal-sinokorean<점>%spellout-numbering>;
+%spellout-cardinal-sinokorean:
+0: 영;
+1: 일;
+2: 이;
+3: 삼;
+4: 사;
+5: 오;
+6: 육;
+7: 칠;
+8: 팔;
+9: 구;
+10: 십;
+11: 십>%spellout-cardinal-sinokorean>;
+20: <%spellout-cardinal-sinokorean<십;
+21: <%spellout-cardinal-sinokorean<십>%spellout-cardinal-sinokorean>;
+100: 백;
+101: 백>%spellout-cardinal-sinokorean>;
+200: <%spellout-cardinal-sinokorean<백;
+201: <%spellout-cardinal-sinokorean<백>%spellout-cardinal-sinokorean>;
+1000: 천;
+1001: 천>%spellout-cardinal-sinokorean>;
+2000: <%spellout-cardinal-sinokorean<천;
+2001: <%spellout-cardinal-sinokorean<천>%spellout-cardinal-sinokorean>;
+10000: 만;
+10001: 만 >%spellout-cardinal-sinokorean>;
+20000: <%spellout-cardinal-sinokorean<만;
+20001: <%spellout-cardinal-sinokorean<만 >%spellout-cardinal-sinokorean>;
+100000000: <%spellout-cardinal-sinokorean<억;
+100000001: <%spellout-cardinal-sinokorean<억 >%spellout-cardinal-sinokorean>;
+1000000000000: <%spellout-cardinal-sinokorean<조;
+1000000000001: <%spellout-cardinal-sinokorean<조 >%spellout-cardinal-sinokorean>;
+10000000000000000: <%spellout-cardinal-sinokorean<경;
+10000000000000001: <%spellout-cardinal-sinokorean<경 >%spellout-cardinal-sinokorean>;
+1000000000000000000: =#,##0=;
+-x: 마이너스 >%spellout-cardinal-sinokorean>;
+x.x: <%spellout-cardinal-sinokorean<점>>>;
+`
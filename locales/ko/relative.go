@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}년 후"},
+			Past:     map[string]string{"other": "{0}년 전"},
+			Relative: map[string]string{"-1": "작년", "0": "올해", "1": "내년"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}년 후"},
+			Past:     map[string]string{"other": "{0}년 전"},
+			Relative: map[string]string{"-1": "작년", "0": "올해", "1": "내년"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}년 후"},
+			Past:     map[string]string{"other": "{0}년 전"},
+			Relative: map[string]string{"-1": "작년", "0": "올해", "1": "내년"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}분기 후"},
+			Past:     map[string]string{"other": "{0}분기 전"},
+			Relative: map[string]string{"-1": "지난 분기", "0": "이번 분기", "1": "다음 분기"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}분기 후"},
+			Past:     map[string]string{"other": "{0}분기 전"},
+			Relative: map[string]string{"-1": "지난 분기", "0": "이번 분기", "1": "다음 분기"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}분기 후"},
+			Past:     map[string]string{"other": "{0}분기 전"},
+			Relative: map[string]string{"-1": "지난 분기", "0": "이번 분기", "1": "다음 분기"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}개월 후"},
+			Past:     map[string]string{"other": "{0}개월 전"},
+			Relative: map[string]string{"-1": "지난달", "0": "이번 달", "1": "다음 달"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}개월 후"},
+			Past:     map[string]string{"other": "{0}개월 전"},
+			Relative: map[string]string{"-1": "지난달", "0": "이번 달", "1": "다음 달"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}개월 후"},
+			Past:     map[string]string{"other": "{0}개월 전"},
+			Relative: map[string]string{"-1": "지난달", "0": "이번 달", "1": "다음 달"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}주 후"},
+			Past:     map[string]string{"other": "{0}주 전"},
+			Relative: map[string]string{"-1": "지난주", "0": "이번 주", "1": "다음 주"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}주 후"},
+			Past:     map[string]string{"other": "{0}주 전"},
+			Relative: map[string]string{"-1": "지난주", "0": "이번 주", "1": "다음 주"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}주 후"},
+			Past:     map[string]string{"other": "{0}주 전"},
+			Relative: map[string]string{"-1": "지난주", "0": "이번 주", "1": "다음 주"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}일 후"},
+			Past:     map[string]string{"other": "{0}일 전"},
+			Relative: map[string]string{"-2": "그저께", "-1": "어제", "0": "오늘", "1": "내일", "2": "모레"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}일 후"},
+			Past:     map[string]string{"other": "{0}일 전"},
+			Relative: map[string]string{"-2": "그저께", "-1": "어제", "0": "오늘", "1": "내일", "2": "모레"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}일 후"},
+			Past:     map[string]string{"other": "{0}일 전"},
+			Relative: map[string]string{"-2": "그저께", "-1": "어제", "0": "오늘", "1": "내일", "2": "모레"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}시간 후"},
+			Past:     map[string]string{"other": "{0}시간 전"},
+			Relative: map[string]string{"0": "현재 시간"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}시간 후"},
+			Past:     map[string]string{"other": "{0}시간 전"},
+			Relative: map[string]string{"0": "현재 시간"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}시간 후"},
+			Past:     map[string]string{"other": "{0}시간 전"},
+			Relative: map[string]string{"0": "현재 시간"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}분 후"},
+			Past:     map[string]string{"other": "{0}분 전"},
+			Relative: map[string]string{"0": "현재 분"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}분 후"},
+			Past:     map[string]string{"other": "{0}분 전"},
+			Relative: map[string]string{"0": "현재 분"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}분 후"},
+			Past:     map[string]string{"other": "{0}분 전"},
+			Relative: map[string]string{"0": "현재 분"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}초 후"},
+			Past:     map[string]string{"other": "{0}초 전"},
+			Relative: map[string]string{"0": "지금"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}초 후"},
+			Past:     map[string]string{"other": "{0}초 전"},
+			Relative: map[string]string{"0": "지금"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}초 후"},
+			Past:     map[string]string{"other": "{0}초 전"},
+			Relative: map[string]string{"0": "지금"},
+		},
+	},
+}
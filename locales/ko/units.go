@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0}에이커", "per": "에이커당 {0}"},
+		Short:  map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0}비트", "per": "비트당 {0}"},
+		Short:  map[string]string{"other": "{0}bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0}bit", "per": "{0}/bit"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0}바이트", "per": "바이트당 {0}"},
+		Short:  map[string]string{"other": "{0}byte", "per": "{0}/byte"},
+		Narrow: map[string]string{"other": "{0}byte", "per": "{0}/byte"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "섭씨 {0}도", "per": "섭씨 도당 {0}"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0}센티미터", "per": "센티미터당 {0}"},
+		Short:  map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0}일", "per": "일당 {0}"},
+		Short:  map[string]string{"other": "{0}일", "per": "{0}/일"},
+		Narrow: map[string]string{"other": "{0}일", "per": "{0}/일"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0}도", "per": "도당 {0}"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "화씨 {0}도", "per": "화씨 도당 {0}"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0}액량 온스", "per": "액량 온스당 {0}"},
+		Short:  map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0}피트", "per": "피트당 {0}"},
+		Short:  map[string]string{"other": "{0}ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0}갤런", "per": "갤런당 {0}"},
+		Short:  map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0}기가비트", "per": "기가비트당 {0}"},
+		Short:  map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0}기가바이트", "per": "기가바이트당 {0}"},
+		Short:  map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0}그램", "per": "그램당 {0}"},
+		Short:  map[string]string{"other": "{0}g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0}헥타르", "per": "헥타르당 {0}"},
+		Short:  map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0}시간", "per": "시간당 {0}"},
+		Short:  map[string]string{"other": "{0}시간", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0}시간", "per": "{0}/시간"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0}인치", "per": "인치당 {0}"},
+		Short:  map[string]string{"other": "{0}in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0}킬로비트", "per": "킬로비트당 {0}"},
+		Short:  map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0}킬로바이트", "per": "킬로바이트당 {0}"},
+		Short:  map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0}킬로그램", "per": "킬로그램당 {0}"},
+		Short:  map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0}킬로미터", "per": "킬로미터당 {0}"},
+		Short:  map[string]string{"other": "{0}km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "시속 {0}킬로미터"},
+		Short:  map[string]string{"other": "{0}km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0}리터", "per": "리터당 {0}"},
+		Short:  map[string]string{"other": "{0}L", "per": "{0}/L"},
+		Narrow: map[string]string{"other": "{0}L", "per": "{0}/L"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "킬로미터당 {0}리터"},
+		Short:  map[string]string{"other": "{0}L/km"},
+		Narrow: map[string]string{"other": "{0}L/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0}메가비트", "per": "메가비트당 {0}"},
+		Short:  map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0}메가바이트", "per": "메가바이트당 {0}"},
+		Short:  map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0}미터", "per": "미터당 {0}"},
+		Short:  map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "초속 {0}미터"},
+		Short:  map[string]string{"other": "{0}m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0}마이크로초", "per": "마이크로초당 {0}"},
+		Short:  map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0}마일", "per": "마일당 {0}"},
+		Short:  map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "갤런당 {0}마일"},
+		Short:  map[string]string{"other": "{0}mpg"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "시속 {0}마일"},
+		Short:  map[string]string{"other": "{0}mi/h"},
+		Narrow: map[string]string{"other": "{0}mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0}스칸디나비아 마일", "per": "스칸디나비아 마일당 {0}"},
+		Short:  map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0}밀리리터", "per": "밀리리터당 {0}"},
+		Short:  map[string]string{"other": "{0}mL", "per": "{0}/mL"},
+		Narrow: map[string]string{"other": "{0}mL", "per": "{0}/mL"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0}밀리미터", "per": "밀리미터당 {0}"},
+		Short:  map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0}밀리초", "per": "밀리초당 {0}"},
+		Short:  map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0}분", "per": "분당 {0}"},
+		Short:  map[string]string{"other": "{0}분", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0}분", "per": "{0}/분"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0}개월", "per": "월당 {0}"},
+		Short:  map[string]string{"other": "{0}개월", "per": "{0}/월"},
+		Narrow: map[string]string{"other": "{0}개월", "per": "{0}/월"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0}나노초", "per": "나노초당 {0}"},
+		Short:  map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0}온스", "per": "온스당 {0}"},
+		Short:  map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0}/{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0}페타바이트", "per": "페타바이트당 {0}"},
+		Short:  map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0}파운드", "per": "파운드당 {0}"},
+		Short:  map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0}초", "per": "초속 {0}"},
+		Short:  map[string]string{"other": "{0}초", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}초", "per": "{0}/s"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0}제곱센티미터", "per": "제곱센티미터당 {0}"},
+		Short:  map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0}제곱피트"},
+		Short:  map[string]string{"other": "{0}ft²"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0}제곱킬로미터", "per": "제곱킬로미터당 {0}"},
+		Short:  map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0}제곱미터", "per": "제곱미터당 {0}"},
+		Short:  map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0}제곱마일", "per": "제곱마일당 {0}"},
+		Short:  map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0}스톤", "per": "스톤당 {0}"},
+		Short:  map[string]string{"other": "{0}st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0}테라비트", "per": "테라비트당 {0}"},
+		Short:  map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0}테라바이트", "per": "테라바이트당 {0}"},
+		Short:  map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0}주", "per": "주당 {0}"},
+		Short:  map[string]string{"other": "{0}주", "per": "{0}/주"},
+		Narrow: map[string]string{"other": "{0}주", "per": "{0}/주"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0}야드", "per": "야드당 {0}"},
+		Short:  map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0}년", "per": "연당 {0}"},
+		Short:  map[string]string{"other": "{0}년", "per": "{0}/년"},
+		Narrow: map[string]string{"other": "{0}년", "per": "{0}/년"},
+	},
+}
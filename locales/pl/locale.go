@@ -53,6 +53,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Polish,
 	data: hc.CldrData{
@@ -136,5 +141,77 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 bln",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤;(#,##0.00 ¤)",
+			Short: map[string]string{
+				"1000-count-one":              "0 tys. ¤",
+				"1000-count-other":            "0 tys. ¤",
+				"10000-count-one":             "00 tys. ¤",
+				"10000-count-other":           "00 tys. ¤",
+				"100000-count-one":            "000 tys. ¤",
+				"100000-count-other":          "000 tys. ¤",
+				"1000000-count-one":           "0 mln ¤",
+				"1000000-count-other":         "0 mln ¤",
+				"10000000-count-one":          "00 mln ¤",
+				"10000000-count-other":        "00 mln ¤",
+				"100000000-count-one":         "000 mln ¤",
+				"100000000-count-other":       "000 mln ¤",
+				"1000000000-count-one":        "0 mld ¤",
+				"1000000000-count-other":      "0 mld ¤",
+				"10000000000-count-one":       "00 mld ¤",
+				"10000000000-count-other":     "00 mld ¤",
+				"100000000000-count-one":      "000 mld ¤",
+				"100000000000-count-other":    "000 mld ¤",
+				"1000000000000-count-one":     "0 bln ¤",
+				"1000000000000-count-other":   "0 bln ¤",
+				"10000000000000-count-one":    "00 bln ¤",
+				"10000000000000-count-other":  "00 bln ¤",
+				"100000000000000-count-one":   "000 bln ¤",
+				"100000000000000-count-other": "000 bln ¤",
+			},
+			Symbols: map[string]string{
+				"BRL": "R$",
+				"EUR": "€",
+				"PLN": "zł",
+			},
+		},
+		PercentFormat:         "#,##0%",
+		MinimumGroupingDigits: 2,
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} i {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} i {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} i {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} i {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} i {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} i {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥{0}",
+		},
 	},
 }
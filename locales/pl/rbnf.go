@@ -0,0 +1,221 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: zero;
+1: jeden;
+2: dwa;
+3: trzy;
+4: cztery;
+5: pięć;
+6: sześć;
+7: siedem;
+8: osiem;
+9: dziewięć;
+10: dziesięć;
+11: jedenaście;
+12: dwanaście;
+13: trzynaście;
+14: czternaście;
+15: piętnaście;
+16: szesnaście;
+17: siedemnaście;
+18: osiemnaście;
+19: dziewiętnaście;
+20: <%%spellout-cardinal-tens<;
+21: <%%spellout-cardinal-tens< >%spellout-cardinal-masculine>;
+100: sto;
+101: sto >%spellout-cardinal-masculine>;
+200: dwieście;
+201: dwieście >%spellout-cardinal-masculine>;
+300: <%spellout-cardinal-feminine<sta;
+301: <%spellout-cardinal-feminine<sta >%spellout-cardinal-masculine>;
+500: <%spellout-cardinal-feminine<set;
+501: <%spellout-cardinal-feminine<set >%spellout-cardinal-masculine>;
+1000: tysiąc;
+1001: tysiąc >%spellout-cardinal-masculine>;
+2000: <%spellout-cardinal-masculine< $(cardinal,few{tysiące}other{tysięcy})$;
+2001: <%spellout-cardinal-masculine< $(cardinal,few{tysiące}other{tysięcy})$ >%spellout-cardinal-masculine>;
+1000000: milion;
+1000001: milion >%spellout-cardinal-masculine>;
+2000000: <%spellout-cardinal-masculine< $(cardinal,few{miliony}other{milionów})$;
+2000001: <%spellout-cardinal-masculine< $(cardinal,few{miliony}other{milionów})$ >%spellout-cardinal-masculine>;
+1000000000: miliard;
+1000000001: miliard >%spellout-cardinal-masculine>;
+2000000000: <%spellout-cardinal-masculine< $(cardinal,few{miliardy}other{miliardów})$;
+2000000001: <%spellout-cardinal-masculine< $(cardinal,few{miliardy}other{miliardów})$ >%spellout-cardinal-masculine>;
+1000000000000: bilion;
+1000000000001: bilion >%spellout-cardinal-masculine>;
+2000000000000: <%spellout-cardinal-masculine< $(cardinal,few{biliony}other{bilionów})$;
+2000000000001: <%spellout-cardinal-masculine< $(cardinal,few{biliony}other{bilionów})$ >%spellout-cardinal-masculine>;
+1000000000000000: biliard;
+1000000000000001: biliard >%spellout-cardinal-masculine>;
+2000000000000000: <%spellout-cardinal-masculine< $(cardinal,few{biliardy}other{biliardów})$;
+2000000000000001: <%spellout-cardinal-masculine< $(cardinal,few{biliardy}other{biliardów})$ >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< przecinek >%%spellout-fraction>;
+%spellout-cardinal-feminine:
+0: zero;
+1: jedna;
+2: dwie;
+3: trzy;
+4: cztery;
+5: pięć;
+6: sześć;
+7: siedem;
+8: osiem;
+9: dziewięć;
+10: dziesięć;
+11: jedenaście;
+12: dwanaście;
+13: trzynaście;
+14: czternaście;
+15: piętnaście;
+16: szesnaście;
+17: siedemnaście;
+18: osiemnaście;
+19: dziewiętnaście;
+20: <%%spellout-cardinal-tens<;
+21: <%%spellout-cardinal-tens< >%%spellout-cardinal-feminine-ones>;
+100: sto;
+101: sto >%%spellout-cardinal-feminine-ones>;
+200: dwieście;
+201: dwieście >%%spellout-cardinal-feminine-ones>;
+300: <%spellout-cardinal-feminine<sta;
+301: <%spellout-cardinal-feminine<sta >%%spellout-cardinal-feminine-ones>;
+500: <%spellout-cardinal-feminine<set;
+501: <%spellout-cardinal-feminine<set >%%spellout-cardinal-feminine-ones>;
+1000: tysiąc;
+1001: tysiąc >%%spellout-cardinal-feminine-ones>;
+2000: <%spellout-cardinal-masculine< $(cardinal,few{tysiące}other{tysięcy})$;
+2001: <%spellout-cardinal-masculine< $(cardinal,few{tysiące}other{tysięcy})$ >%%spellout-cardinal-feminine-ones>;
+1000000: milion;
+1000001: milion >%%spellout-cardinal-feminine-ones>;
+2000000: <%spellout-cardinal-masculine< $(cardinal,few{miliony}other{milionów})$;
+2000001: <%spellout-cardinal-masculine< $(cardinal,few{miliony}other{milionów})$ >%%spellout-cardinal-feminine-ones>;
+1000000000: miliard;
+1000000001: miliard >%spellout-cardinal-feminine>;
+2000000000: <%spellout-cardinal-masculine< $(cardinal,few{miliardy}other{miliardów})$;
+2000000001: <%spellout-cardinal-masculine< $(cardinal,few{miliardy}other{miliardów})$ >%%spellout-cardinal-feminine-ones>;
+1000000000000: bilion;
+1000000000001: bilion >%spellout-cardinal-feminine>;
+2000000000000: <%spellout-cardinal-masculine< $(cardinal,few{biliony}other{bilionów})$;
+2000000000001: <%spellout-cardinal-masculine< $(cardinal,few{biliony}other{bilionów})$ >%%spellout-cardinal-feminine-ones>;
+1000000000000000: biliard;
+1000000000000001: biliard >%%spellout-cardinal-masculine-genitive-ones>;
+2000000000000000: <%spellout-cardinal-masculine< $(cardinal,few{biliardy}other{biliardów})$;
+2000000000000001: <%spellout-cardinal-masculine< $(cardinal,few{biliardy}other{biliardów})$ >%%spellout-cardinal-feminine-ones>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< przecinek >%%spellout-fraction>;
+%spellout-cardinal-neuter:
+0: zero;
+1: jedno;
+2: =%spellout-cardinal-masculine=;
+-x: minus >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-neuter< przecinek >%%spellout-fraction>;
+%spellout-cardinal-masculine-genitive:
+0: zera;
+1: jednego;
+2: dwóch;
+3: trzech;
+4: czterech;
+5: pięciu;
+6: sześciu;
+7: siedmiu;
+8: ośmiu;
+9: dziewięciu;
+10: dziesięciu;
+11: jedenastu;
+12: dwunastu;
+13: trzynastu;
+14: czternastu;
+15: piętnastu;
+16: szesnastu;
+17: siedemnastu;
+18: osiemnastu;
+19: dziewiętnastu;
+20: <%%spellout-cardinal-genitive-tens<;
+21: <%%spellout-cardinal-genitive-tens< >%%spellout-cardinal-masculine-genitive-ones>;
+100: stu;
+101: stu >%%spellout-cardinal-masculine-genitive-ones>;
+200: dwustu;
+201: dwustu >%%spellout-cardinal-masculine-genitive-ones>;
+300: <%spellout-cardinal-feminine<stu;
+301: <%spellout-cardinal-feminine<stu >%%spellout-cardinal-masculine-genitive-ones>;
+500: <%spellout-cardinal-feminine-genitive<set;
+501: <%spellout-cardinal-feminine-genitive<set >%%spellout-cardinal-masculine-genitive-ones>;
+1000: tysiąca;
+1001: tysiąca >%%spellout-cardinal-masculine-genitive-ones>;
+2000: <%spellout-cardinal-masculine-genitive< tysięcy;
+2001: <%spellout-cardinal-masculine-genitive< tysięcy >%%spellout-cardinal-masculine-genitive-ones>;
+1000000: miliona;
+1000001: miliona >%%spellout-cardinal-masculine-genitive-ones>;
+2000000: <%spellout-cardinal-masculine-genitive< milionów;
+2000001: <%spellout-cardinal-masculine-genitive< milionów >%%spellout-cardinal-masculine-genitive-ones>;
+1000000000: miliarda;
+1000000001: miliarda >%%spellout-cardinal-masculine-genitive-ones>;
+2000000000: <%spellout-cardinal-masculine-genitive< miliardów;
+2000000001: <%spellout-cardinal-masculine-genitive< miliardów >%%spellout-cardinal-masculine-genitive-ones>;
+1000000000000: biliona;
+1000000000001: biliona >%%spellout-cardinal-masculine-genitive-ones>;
+2000000000000: <%spellout-cardinal-masculine-genitive< bilionów;
+2000000000001: <%spellout-cardinal-masculine-genitive< bilionów >%%spellout-cardinal-masculine-genitive-ones>;
+1000000000000000: biliarda;
+1000000000000001: biliarda >%%spellout-cardinal-masculine-genitive-ones>;
+2000000000000000: <%spellout-cardinal-masculine-genitive< biliardów;
+2000000000000001: <%spellout-cardinal-masculine-genitive< biliardów >%%spellout-cardinal-masculine-genitive-ones>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-masculine-genitive>;
+x.x: <%spellout-cardinal-masculine-genitive< przecinek >%spellout-cardinal-masculine-genitive>;
+%spellout-cardinal-feminine-genitive:
+0: zera;
+1: jednej;
+2: =%spellout-cardinal-masculine-genitive=;
+-x: minus >%spellout-cardinal-feminine-genitive>;
+x.x: <%spellout-cardinal-feminine-genitive< przecinek >%spellout-cardinal-feminine-genitive>;
+%%spellout-cardinal-masculine-genitive-ones:
+1: jeden;
+2: =%spellout-cardinal-masculine-genitive=;
+%%spellout-cardinal-feminine-ones:
+1: jeden;
+2: =%spellout-cardinal-feminine=;
+%%spellout-cardinal-tens:
+1: dziesięć;
+2: dwadzieścia;
+3: trzydzieści;
+4: czterdzieści;
+5: pięćdziesiąt;
+6: sześćdziesiąt;
+7: siedemdziesiąt;
+8: osiemdziesiąt;
+9: dziewięćdziesiąt;
+%%spellout-cardinal-genitive-tens:
+1: dziesięciu;
+2: dwudziestu;
+3: trzydziestu;
+4: czterdziestu;
+5: pięćdziesięciu;
+6: sześćdziesięciu;
+7: siedemdziesięciu;
+8: osiemdziesięciu;
+9: dziewięćdziesięciu;
+%%spellout-fraction:
+10: <%spellout-cardinal-masculine<<;
+100: <%spellout-cardinal-masculine<<;
+1000: <%spellout-cardinal-masculine<<;
+10000: <%%spellout-fraction-digits<<;
+100000: <%%spellout-fraction-digits<<;
+1000000: <%%spellout-fraction-digits<<;
+10000000: <%%spellout-fraction-digits<<;
+100000000: <%%spellout-fraction-digits<<;
+1000000000: <%%spellout-fraction-digits<<;
+10000000000: <0<;
+%%spellout-fraction-digits:
+0: =%spellout-cardinal-masculine=;
+10: <%%spellout-fraction-digits< >%%spellout-fraction-digits>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} rok", "few": "za {0} lata", "many": "za {0} lat", "other": "za {0} roku"},
+			Past:     map[string]string{"one": "{0} rok temu", "few": "{0} lata temu", "many": "{0} lat temu", "other": "{0} roku temu"},
+			Relative: map[string]string{"-1": "w zeszłym roku", "0": "w tym roku", "1": "w przyszłym roku"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} rok", "few": "za {0} lata", "many": "za {0} lat", "other": "za {0} roku"},
+			Past:     map[string]string{"one": "{0} rok temu", "few": "{0} lata temu", "many": "{0} lat temu", "other": "{0} roku temu"},
+			Relative: map[string]string{"-1": "w zeszłym roku", "0": "w tym roku", "1": "w przyszłym roku"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} rok", "few": "za {0} lata", "many": "za {0} lat", "other": "za {0} roku"},
+			Past:     map[string]string{"one": "{0} rok temu", "few": "{0} lata temu", "many": "{0} lat temu", "other": "{0} roku temu"},
+			Relative: map[string]string{"-1": "w zeszłym roku", "0": "w tym roku", "1": "w przyszłym roku"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} kwartał", "few": "za {0} kwartały", "many": "za {0} kwartałów", "other": "za {0} kwartału"},
+			Past:     map[string]string{"one": "{0} kwartał temu", "few": "{0} kwartały temu", "many": "{0} kwartałów temu", "other": "{0} kwartału temu"},
+			Relative: map[string]string{"-1": "w zeszłym kwartale", "0": "w tym kwartale", "1": "w przyszłym kwartale"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} kw."},
+			Past:     map[string]string{"other": "{0} kw. temu"},
+			Relative: map[string]string{"-1": "w zeszłym kwartale", "0": "w tym kwartale", "1": "w przyszłym kwartale"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} kw."},
+			Past:     map[string]string{"other": "{0} kw. temu"},
+			Relative: map[string]string{"-1": "w zeszłym kwartale", "0": "w tym kwartale", "1": "w przyszłym kwartale"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} miesiąc", "few": "za {0} miesiące", "many": "za {0} miesięcy", "other": "za {0} miesiąca"},
+			Past:     map[string]string{"one": "{0} miesiąc temu", "few": "{0} miesiące temu", "many": "{0} miesięcy temu", "other": "{0} miesiąca temu"},
+			Relative: map[string]string{"-1": "w zeszłym miesiącu", "0": "w tym miesiącu", "1": "w przyszłym miesiącu"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} mies."},
+			Past:     map[string]string{"other": "{0} mies. temu"},
+			Relative: map[string]string{"-1": "w zeszłym mies.", "0": "w tym mies.", "1": "w przyszłym mies."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} mies."},
+			Past:     map[string]string{"other": "{0} mies. temu"},
+			Relative: map[string]string{"-1": "w zeszłym mies.", "0": "w tym mies.", "1": "w przyszłym mies."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} tydzień", "few": "za {0} tygodnie", "many": "za {0} tygodni", "other": "za {0} tygodnia"},
+			Past:     map[string]string{"one": "{0} tydzień temu", "few": "{0} tygodnie temu", "many": "{0} tygodni temu", "other": "{0} tygodnia temu"},
+			Relative: map[string]string{"-1": "w zeszłym tygodniu", "0": "w tym tygodniu", "1": "w przyszłym tygodniu"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} tydz.", "other": "za {0} tyg."},
+			Past:     map[string]string{"one": "{0} tydz. temu", "other": "{0} tyg. temu"},
+			Relative: map[string]string{"-1": "w zeszłym tyg.", "0": "w tym tyg.", "1": "w przyszłym tyg."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} tydz.", "other": "za {0} tyg."},
+			Past:     map[string]string{"one": "{0} tydz. temu", "other": "{0} tyg. temu"},
+			Relative: map[string]string{"-1": "w zeszłym tyg.", "0": "w tym tyg.", "1": "w przyszłym tyg."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} dzień", "few": "za {0} dni", "many": "za {0} dni", "other": "za {0} dnia"},
+			Past:     map[string]string{"one": "{0} dzień temu", "few": "{0} dni temu", "many": "{0} dni temu", "other": "{0} dnia temu"},
+			Relative: map[string]string{"-2": "przedwczoraj", "-1": "wczoraj", "0": "dzisiaj", "1": "jutro", "2": "pojutrze"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} dzień", "few": "za {0} dni", "many": "za {0} dni", "other": "za {0} dnia"},
+			Past:     map[string]string{"one": "{0} dzień temu", "few": "{0} dni temu", "many": "{0} dni temu", "other": "{0} dnia temu"},
+			Relative: map[string]string{"-2": "przedwczoraj", "-1": "wczoraj", "0": "dzisiaj", "1": "jutro", "2": "pojutrze"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} dzień", "few": "za {0} dni", "many": "za {0} dni", "other": "za {0} dnia"},
+			Past:     map[string]string{"one": "{0} dzień temu", "few": "{0} dni temu", "many": "{0} dni temu", "other": "{0} dnia temu"},
+			Relative: map[string]string{"-2": "przedwczoraj", "-1": "wcz.", "0": "dziś", "1": "jutro", "2": "pojutrze"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} godzinę", "many": "za {0} godzin", "other": "za {0} godziny"},
+			Past:     map[string]string{"one": "{0} godzinę temu", "many": "{0} godzin temu", "other": "{0} godziny temu"},
+			Relative: map[string]string{"0": "ta godzina"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} godz."},
+			Past:     map[string]string{"other": "{0} godz. temu"},
+			Relative: map[string]string{"0": "ta godzina"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} g."},
+			Past:     map[string]string{"other": "{0} g. temu"},
+			Relative: map[string]string{"0": "ta godzina"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} minutę", "many": "za {0} minut", "other": "za {0} minuty"},
+			Past:     map[string]string{"one": "{0} minutę temu", "many": "{0} minut temu", "other": "{0} minuty temu"},
+			Relative: map[string]string{"0": "ta minuta"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} min"},
+			Past:     map[string]string{"other": "{0} min temu"},
+			Relative: map[string]string{"0": "ta minuta"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} min"},
+			Past:     map[string]string{"other": "{0} min temu"},
+			Relative: map[string]string{"0": "ta minuta"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "za {0} sekundę", "many": "za {0} sekund", "other": "za {0} sekundy"},
+			Past:     map[string]string{"one": "{0} sekundę temu", "many": "{0} sekund temu", "other": "{0} sekundy temu"},
+			Relative: map[string]string{"0": "teraz"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} sek."},
+			Past:     map[string]string{"other": "{0} sek. temu"},
+			Relative: map[string]string{"0": "teraz"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "za {0} s"},
+			Past:     map[string]string{"other": "{0} s temu"},
+			Relative: map[string]string{"0": "teraz"},
+		},
+	},
+}
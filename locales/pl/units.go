@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} akr", "few": "{0} akry", "many": "{0} akrów", "other": "{0} akra", "per": "{0} na akr"},
+		Short:  map[string]string{"one": "{0} akr", "few": "{0} akry", "many": "{0} akrów", "other": "{0} akra", "per": "{0}/akr"},
+		Narrow: map[string]string{"one": "{0} akr", "few": "{0} akry", "many": "{0} akrów", "other": "{0} akra", "per": "{0}/akr"},
+		Gender: "inanimate",
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} bit", "few": "{0} bity", "many": "{0} bitów", "other": "{0} bita", "per": "{0} na bit"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Gender: "inanimate",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} bajt", "few": "{0} bajty", "many": "{0} bajtów", "other": "{0} bajta", "per": "{0} na bajt"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Gender: "inanimate",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} stopień Celsjusza", "few": "{0} stopnie Celsjusza", "many": "{0} stopni Celsjusza", "other": "{0} stopnia Celsjusza", "per": "{0} na stopień Celsjusza"},
+		Short:  map[string]string{"other": "{0} st. C", "per": "{0}/st. C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "inanimate",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centymetr", "few": "{0} centymetry", "many": "{0} centymetrów", "other": "{0} centymetra", "per": "{0} na centymetr"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Gender: "inanimate",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} dzień", "few": "{0} dni", "many": "{0} dni", "other": "{0} dnia", "per": "{0} na dobę"},
+		Short:  map[string]string{"one": "{0} dzień", "few": "{0} dni", "many": "{0} dni", "other": "{0} dnia", "per": "{0}/dobę"},
+		Narrow: map[string]string{"other": "{0} d.", "per": "{0}/d."},
+		Gender: "feminine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} stopień", "few": "{0} stopnie", "many": "{0} stopni", "other": "{0} stopnia", "per": "{0} na stopień"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "inanimate",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} stopień Fahrenheita", "few": "{0} stopnie Fahrenheita", "many": "{0} stopni Fahrenheita", "other": "{0} stopnia Fahrenheita", "per": "{0} na stopień Fahrenheita"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "inanimate",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} uncja płynu amerykańska", "few": "{0} uncje płynu amerykańskie", "many": "{0} uncji płynu amerykańskich", "other": "{0} uncji płynu amerykańskiej", "per": "{0} na uncja płynu amerykańska"},
+		Short:  map[string]string{"other": "{0} fl oz am.", "per": "{0}/fl oz am."},
+		Narrow: map[string]string{"other": "{0} fl oz am.", "per": "{0}/fl oz am."},
+		Gender: "feminine",
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} stopa", "many": "{0} stóp", "other": "{0} stopy", "per": "{0} na stopę"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Gender: "feminine",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} galon amerykański", "few": "{0} galony amerykańskie", "many": "{0} galonów amerykańskich", "other": "{0} galona amerykańskiego", "per": "{0} na galon amerykański"},
+		Short:  map[string]string{"other": "{0} gal am.", "per": "{0}/gal am."},
+		Narrow: map[string]string{"other": "{0} gal am.", "per": "{0}/gal am."},
+		Gender: "inanimate",
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} gigabit", "few": "{0} gigabity", "many": "{0} gigabitów", "other": "{0} gigabita", "per": "{0} na gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Gender: "inanimate",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigabajt", "few": "{0} gigabajty", "many": "{0} gigabajtów", "other": "{0} gigabajta", "per": "{0} na gigabajt"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Gender: "inanimate",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} gram", "few": "{0} gramy", "many": "{0} gramów", "other": "{0} grama", "per": "{0} na gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Gender: "inanimate",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} hektar", "few": "{0} hektary", "many": "{0} hektarów", "other": "{0} hektara", "per": "{0} na hektar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Gender: "inanimate",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} godzina", "many": "{0} godzin", "other": "{0} godziny", "per": "{0} na godzinę"},
+		Short:  map[string]string{"other": "{0} godz.", "per": "{0}/godz."},
+		Narrow: map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} cal", "few": "{0} cale", "many": "{0} cali", "other": "{0} cala", "per": "{0} na cal"},
+		Short:  map[string]string{"one": "{0} cal", "few": "{0} cale", "many": "{0} cali", "other": "{0} cala", "per": "{0}/cal"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/cal"},
+		Gender: "inanimate",
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} kilobit", "few": "{0} kilobity", "many": "{0} kilobitów", "other": "{0} kilobita", "per": "{0} na kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Gender: "inanimate",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilobajt", "few": "{0} kilobajty", "many": "{0} kilobajtów", "other": "{0} kilobajta", "per": "{0} na kilobajt"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Gender: "inanimate",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} kilogram", "few": "{0} kilogramy", "many": "{0} kilogramów", "other": "{0} kilograma", "per": "{0} na kilogram"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Gender: "inanimate",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} kilometr", "few": "{0} kilometry", "many": "{0} kilometrów", "other": "{0} kilometra", "per": "{0} na kilometr"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Gender: "inanimate",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} kilometr na godzinę", "few": "{0} kilometry na godzinę", "many": "{0} kilometrów na godzinę", "other": "{0} kilometra na godzinę"},
+		Short:  map[string]string{"other": "{0} km/godz."},
+		Narrow: map[string]string{"one": "{0} km/h", "few": "{0} km/h", "many": "{0} km/h", "other": "{0}km/h"},
+		Gender: "inanimate",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} litr", "few": "{0} litry", "many": "{0} litrów", "other": "{0} litra", "per": "{0} na litr"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Gender: "inanimate",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} litr na kilometr", "few": "{0} litry na kilometr", "many": "{0} litrów na kilometr", "other": "{0} litra na kilometr"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+		Gender: "inanimate",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} megabit", "few": "{0} megabity", "many": "{0} megabitów", "other": "{0} megabita", "per": "{0} na megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Gender: "inanimate",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} megabajt", "few": "{0} megabajty", "many": "{0} megabajtów", "other": "{0} megabajta", "per": "{0} na megabajt"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Gender: "inanimate",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} metr", "few": "{0} metry", "many": "{0} metrów", "other": "{0} metra", "per": "{0} na metr"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "inanimate",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} metr na sekundę", "few": "{0} metry na sekundę", "many": "{0} metrów na sekundę", "other": "{0} metra na sekundę"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+		Gender: "inanimate",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} mikrosekunda", "many": "{0} mikrosekund", "other": "{0} mikrosekundy", "per": "{0} na mikrosekunda"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} mila", "few": "{0} mile", "many": "{0} mil", "other": "{0} mili", "per": "{0} na mila"},
+		Short:  map[string]string{"one": "{0} mila", "few": "{0} mile", "many": "{0} mil", "other": "{0} mili", "per": "{0}/mila"},
+		Narrow: map[string]string{"one": "{0} mila", "few": "{0} mile", "many": "{0} mil", "other": "{0} mili", "per": "{0}/mila"},
+		Gender: "feminine",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} mila na galon", "few": "{0} mile na galon", "many": "{0} mil na galon", "other": "{0} mili na galon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+		Gender: "feminine",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} mila na godzinę", "few": "{0} mile na godzinę", "many": "{0} mil na godzinę", "other": "{0} mili na godzinę"},
+		Short:  map[string]string{"one": "{0} mila/h", "few": "{0} mile/h", "many": "{0} mil/h", "other": "{0} mili/h"},
+		Narrow: map[string]string{"other": "{0} mph"},
+		Gender: "feminine",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} mila skandynawska", "few": "{0} mile skandynawskie", "many": "{0} mil skandynawskich", "other": "{0} mili skandynawskiej", "per": "{0} na mila skandynawska"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} mililitr", "few": "{0} mililitry", "many": "{0} mililitrów", "other": "{0} mililitra", "per": "{0} na mililitr"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Gender: "inanimate",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} milimetr", "few": "{0} milimetry", "many": "{0} milimetrów", "other": "{0} milimetra", "per": "{0} na milimetr"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Gender: "inanimate",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} milisekunda", "many": "{0} milisekund", "other": "{0} milisekundy", "per": "{0} na milisekunda"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minuta", "many": "{0} minut", "other": "{0} minuty", "per": "{0} na minutę"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} miesiąc", "few": "{0} miesiące", "many": "{0} miesięcy", "other": "{0} miesiąca", "per": "{0} na miesiąc"},
+		Short:  map[string]string{"other": "{0} mies.", "per": "{0}/mies."},
+		Narrow: map[string]string{"one": "{0} m-c", "few": "{0} m-ce", "many": "{0} m-cy", "other": "{0} m-ca", "per": "{0}/m-c"},
+		Gender: "inanimate",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosekunda", "many": "{0} nanosekund", "other": "{0} nanosekundy", "per": "{0} na nanosekunda"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} uncja", "few": "{0} uncje", "other": "{0} uncji", "per": "{0} na uncję"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Gender: "feminine",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} na {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} petabajt", "few": "{0} petabajty", "many": "{0} petabajtów", "other": "{0} petabajta", "per": "{0} na petabajt"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Gender: "inanimate",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} funt", "few": "{0} funty", "many": "{0} funtów", "other": "{0} funta", "per": "{0} na funt"},
+		Short:  map[string]string{"one": "{0} funt", "few": "{0} funty", "many": "{0} funtów", "other": "{0} funta", "per": "{0}/funt"},
+		Narrow: map[string]string{"one": "{0} funt", "few": "{0} funty", "many": "{0} funtów", "other": "{0} funta", "per": "{0}/funt"},
+		Gender: "inanimate",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} sekunda", "many": "{0} sekund", "other": "{0} sekundy", "per": "{0} na sekundę"},
+		Short:  map[string]string{"other": "{0} sek.", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} centymetr kwadratowy", "few": "{0} centymetry kwadratowe", "many": "{0} centymetrów kwadratowych", "other": "{0} centymetra kwadratowego", "per": "{0} na centymetr kwadratowy"},
+		Short:  map[string]string{"one": "{0} centymetr kwadratowy", "few": "{0} centymetry kwadratowe", "many": "{0} centymetrów kwadratowych", "other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"one": "{0} centymetr kwadratowy", "few": "{0} centymetry kwadratowe", "many": "{0} centymetrów kwadratowych", "other": "{0} cm²", "per": "{0}/cm²"},
+		Gender: "inanimate",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} stopa kwadratowa", "few": "{0} stopy kwadratowe", "many": "{0} stóp kwadratowych", "other": "{0} stopy kwadratowej"},
+		Short:  map[string]string{"one": "{0} stopa kw.", "many": "{0} stóp kw.", "other": "{0} stopy kw."},
+		Narrow: map[string]string{"other": "{0} ft²"},
+		Gender: "feminine",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} kilometr kwadratowy", "few": "{0} kilometry kwadratowe", "many": "{0} kilometrów kwadratowych", "other": "{0} kilometra kwadratowego", "per": "{0} na kilometr kwadratowy"},
+		Short:  map[string]string{"one": "{0} kilometr kwadratowy", "few": "{0} kilometry kwadratowe", "many": "{0} kilometrów kwadratowych", "other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"one": "{0} kilometr kwadratowy", "few": "{0} kilometry kwadratowe", "many": "{0} kilometrów kwadratowych", "other": "{0} km²", "per": "{0}/km²"},
+		Gender: "inanimate",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} metr kwadratowy", "few": "{0} metry kwadratowe", "many": "{0} metrów kwadratowych", "other": "{0} metra kwadratowego", "per": "{0} na metr kwadratowy"},
+		Short:  map[string]string{"one": "{0} metr kwadratowy", "few": "{0} metry kwadratowe", "many": "{0} metrów kwadratowych", "other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"one": "{0} metr kwadratowy", "few": "{0} metry kwadratowe", "many": "{0} metrów kwadratowych", "other": "{0} m²", "per": "{0}/m²"},
+		Gender: "inanimate",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} mila kwadratowa", "few": "{0} mile kwadratowe", "many": "{0} mil kwadratowych", "other": "{0} mili kwadratowej", "per": "{0} na milę kwadratową"},
+		Short:  map[string]string{"one": "{0} mila kw.", "few": "{0} mile kw.", "many": "{0} mil kw.", "other": "{0} mili kw.", "per": "{0}/milę kw."},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Gender: "feminine",
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} kamień", "few": "{0} kamienie", "many": "{0} kamieni", "other": "{0} kamienia", "per": "{0} na kamień"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} terabit", "few": "{0} terabity", "many": "{0} terabitów", "other": "{0} terabita", "per": "{0} na terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Gender: "inanimate",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} terabajt", "few": "{0} terabajty", "many": "{0} terabajtów", "other": "{0} terabajta", "per": "{0} na terabajt"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Gender: "inanimate",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} tydzień", "few": "{0} tygodnie", "many": "{0} tygodni", "other": "{0} tygodnia", "per": "{0} na tydzień"},
+		Short:  map[string]string{"one": "{0} tydz.", "other": "{0} tyg.", "per": "{0}/tydz."},
+		Narrow: map[string]string{"other": "{0} t.", "per": "{0}/t."},
+		Gender: "inanimate",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} jard", "few": "{0} jardy", "many": "{0} jardów", "other": "{0} jarda", "per": "{0} na jard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Gender: "inanimate",
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} rok", "few": "{0} lata", "many": "{0} lat", "other": "{0} roku", "per": "{0} na rok"},
+		Short:  map[string]string{"one": "{0} rok", "few": "{0} lata", "many": "{0} lat", "other": "{0} roku", "per": "{0}/rok"},
+		Narrow: map[string]string{"few": "{0} l.", "many": "{0} l.", "other": "{0} r.", "per": "{0}/rok"},
+		Gender: "inanimate",
+	},
+}
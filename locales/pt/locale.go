@@ -40,6 +40,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Portuguese,
 	data: hc.CldrData{
@@ -99,5 +104,87 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 tri",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "¤ #,##0.00",
+			Accounting: "¤ #,##0.00",
+			Short: map[string]string{
+				"1000-count-one":              "¤ 0 mil",
+				"1000-count-other":            "¤ 0 mil",
+				"10000-count-one":             "¤ 00 mil",
+				"10000-count-other":           "¤ 00 mil",
+				"100000-count-one":            "¤ 000 mil",
+				"100000-count-other":          "¤ 000 mil",
+				"1000000-count-one":           "¤ 0 mi",
+				"1000000-count-other":         "¤ 0 mi",
+				"10000000-count-one":          "¤ 00 mi",
+				"10000000-count-other":        "¤ 00 mi",
+				"100000000-count-one":         "¤ 000 mi",
+				"100000000-count-other":       "¤ 000 mi",
+				"1000000000-count-one":        "¤ 0 bi",
+				"1000000000-count-other":      "¤ 0 bi",
+				"10000000000-count-one":       "¤ 00 bi",
+				"10000000000-count-other":     "¤ 00 bi",
+				"100000000000-count-one":      "¤ 000 bi",
+				"100000000000-count-other":    "¤ 000 bi",
+				"1000000000000-count-one":     "¤ 0 tri",
+				"1000000000000-count-other":   "¤ 0 tri",
+				"10000000000000-count-one":    "¤ 00 tri",
+				"10000000000000-count-other":  "¤ 00 tri",
+				"100000000000000-count-one":   "¤ 000 tri",
+				"100000000000000-count-other": "¤ 000 tri",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} e {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} e {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} e {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} e {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}º"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "+{0}",
+		},
 	},
 }
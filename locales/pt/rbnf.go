@@ -0,0 +1,140 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-masculine=;
+%%optional-e:
+0: ' e ;
+1: ' ;
+%%spellout-cardinal-masculine-with-e:
+0: ' e =%spellout-cardinal-masculine=;
+100: >%%optional-e>=%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: zero;
+1: um;
+2: dois;
+3: três;
+4: quatro;
+5: cinco;
+6: seis;
+7: sete;
+8: oito;
+9: nove;
+10: dez;
+11: onze;
+12: doze;
+13: treze;
+14: catorze;
+15: quinze;
+16: dezesseis;
+17: dezessete;
+18: dezoito;
+19: dezenove;
+20: vinte;
+21: vinte e >%spellout-cardinal-masculine>;
+30: trinta;
+31: trinta e >%spellout-cardinal-masculine>;
+40: quarenta;
+41: quarenta e >%spellout-cardinal-masculine>;
+50: cinquenta;
+51: cinquenta e >%spellout-cardinal-masculine>;
+60: sessenta;
+61: sessenta e >%spellout-cardinal-masculine>;
+70: setenta;
+71: setenta e >%spellout-cardinal-masculine>;
+80: oitenta;
+81: oitenta e >%spellout-cardinal-masculine>;
+90: noventa;
+91: noventa e >%spellout-cardinal-masculine>;
+100: cem;
+101: cento e >%spellout-cardinal-masculine>;
+200: duzentos;
+201: duzentos e >%spellout-cardinal-masculine>;
+300: trezentos;
+301: trezentos e >%spellout-cardinal-masculine>;
+400: quatrocentos;
+401: quatrocentos e >%spellout-cardinal-masculine>;
+500: quinhentos;
+501: quinhentos e >%spellout-cardinal-masculine>;
+600: seiscentos;
+601: seiscentos e >%spellout-cardinal-masculine>;
+700: setecentos;
+701: setecentos e >%spellout-cardinal-masculine>;
+800: oitocentos;
+801: oitocentos e >%spellout-cardinal-masculine>;
+900: novecentos;
+901: novecentos e >%spellout-cardinal-masculine>;
+1000: mil;
+1001: mil>%%spellout-cardinal-masculine-with-e>;
+2000: <%spellout-cardinal-masculine< mil;
+2001: <%spellout-cardinal-masculine< mil>%%spellout-cardinal-masculine-with-e>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{milhão}other{milhões})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{milhão}other{milhões})$>%%spellout-cardinal-masculine-with-e>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{bilhão}other{bilhões})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{bilhão}other{bilhões})$>%%spellout-cardinal-masculine-with-e>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{trilhão}other{trilhões})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{trilhão}other{trilhões})$>%%spellout-cardinal-masculine-with-e>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{quatrilhão}other{quatrilhões})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{quatrilhão}other{quatrilhões})$>%%spellout-cardinal-masculine-with-e>;
+1000000000000000000: =#,##0=;
+-x: menos >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< vírgula >%spellout-cardinal-masculine>;
+%%spellout-cardinal-feminine-with-e:
+0: ' e =%spellout-cardinal-feminine=;
+100: >%%optional-e>=%spellout-cardinal-feminine=;
+%spellout-cardinal-feminine:
+0: zero;
+1: uma;
+2: duas;
+3: =%spellout-cardinal-masculine=;
+20: vinte;
+21: vinte e >%spellout-cardinal-feminine>;
+30: trinta;
+31: trinta e >%spellout-cardinal-feminine>;
+40: quarenta;
+41: quarenta e >%spellout-cardinal-feminine>;
+50: cinquenta;
+51: cinquenta e >%spellout-cardinal-feminine>;
+60: sessenta;
+61: sessenta e >%spellout-cardinal-feminine>;
+70: setenta;
+71: setenta e >%spellout-cardinal-feminine>;
+80: oitenta;
+81: oitenta e >%spellout-cardinal-feminine>;
+90: noventa;
+91: noventa e >%spellout-cardinal-feminine>;
+100: cem;
+101: cento e >%spellout-cardinal-feminine>;
+200: duzentas;
+201: duzentas e >%spellout-cardinal-feminine>;
+300: trezentas;
+301: trezentas e >%spellout-cardinal-feminine>;
+400: quatrocentas;
+401: quatrocentas e >%spellout-cardinal-feminine>;
+500: quinhentas;
+501: quinhentas e >%spellout-cardinal-feminine>;
+600: seiscentas;
+601: seiscentas e >%spellout-cardinal-feminine>;
+700: setecentas;
+701: setecentas e >%spellout-cardinal-feminine>;
+800: oitocentas;
+801: oitocentas e >%spellout-cardinal-feminine>;
+900: novecentas;
+901: novecentas e >%spellout-cardinal-feminine>;
+1000: mil;
+1001: mil>%%spellout-cardinal-feminine-with-e>;
+2000: <%spellout-cardinal-feminine< mil;
+2001: <%spellout-cardinal-feminine< mil>%%spellout-cardinal-feminine-with-e>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{milhão}other{milhões})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{milhão}other{milhões})$>%%spellout-cardinal-feminine-with-e>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{bilhão}other{bilhões})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{bilhão}other{bilhões})$>%%spellout-cardinal-feminine-with-e>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{trilhão}other{trilhões})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{trilhão}other{trilhões})$>%%spellout-cardinal-feminine-with-e>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{quatrilhão}other{quatrilhões})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{quatrilhão}other{quatrilhões})$>%%spellout-cardinal-feminine-with-e>;
+1000000000000000000: =#,##0=;
+-x: menos >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< vírgula >%spellout-cardinal-feminine>;
+`
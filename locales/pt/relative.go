@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} ano", "other": "em {0} anos"},
+			Past:     map[string]string{"one": "há {0} ano", "other": "há {0} anos"},
+			Relative: map[string]string{"-1": "ano passado", "0": "este ano", "1": "próximo ano"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} ano", "other": "em {0} anos"},
+			Past:     map[string]string{"one": "há {0} ano", "other": "há {0} anos"},
+			Relative: map[string]string{"-1": "ano passado", "0": "este ano", "1": "próximo ano"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} ano", "other": "em {0} anos"},
+			Past:     map[string]string{"one": "há {0} ano", "other": "há {0} anos"},
+			Relative: map[string]string{"-1": "ano passado", "0": "este ano", "1": "próximo ano"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} trimestre", "other": "em {0} trimestres"},
+			Past:     map[string]string{"one": "há {0} trimestre", "other": "há {0} trimestres"},
+			Relative: map[string]string{"-1": "último trimestre", "0": "este trimestre", "1": "próximo trimestre"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} trim."},
+			Past:     map[string]string{"other": "há {0} trim."},
+			Relative: map[string]string{"-1": "último trimestre", "0": "este trimestre", "1": "próximo trimestre"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} trim."},
+			Past:     map[string]string{"other": "há {0} trim."},
+			Relative: map[string]string{"-1": "último trimestre", "0": "este trimestre", "1": "próximo trimestre"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} mês", "other": "em {0} meses"},
+			Past:     map[string]string{"one": "há {0} mês", "other": "há {0} meses"},
+			Relative: map[string]string{"-1": "mês passado", "0": "este mês", "1": "próximo mês"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} mês", "other": "em {0} meses"},
+			Past:     map[string]string{"one": "há {0} mês", "other": "há {0} meses"},
+			Relative: map[string]string{"-1": "mês passado", "0": "este mês", "1": "próximo mês"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} mês", "other": "em {0} meses"},
+			Past:     map[string]string{"one": "há {0} mês", "other": "há {0} meses"},
+			Relative: map[string]string{"-1": "mês passado", "0": "este mês", "1": "próximo mês"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} semana", "other": "em {0} semanas"},
+			Past:     map[string]string{"one": "há {0} semana", "other": "há {0} semanas"},
+			Relative: map[string]string{"-1": "semana passada", "0": "esta semana", "1": "próxima semana"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} sem."},
+			Past:     map[string]string{"other": "há {0} sem."},
+			Relative: map[string]string{"-1": "semana passada", "0": "esta semana", "1": "próxima semana"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} sem."},
+			Past:     map[string]string{"other": "há {0} sem."},
+			Relative: map[string]string{"-1": "semana passada", "0": "esta semana", "1": "próxima semana"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} dia", "other": "em {0} dias"},
+			Past:     map[string]string{"one": "há {0} dia", "other": "há {0} dias"},
+			Relative: map[string]string{"-2": "anteontem", "-1": "ontem", "0": "hoje", "1": "amanhã", "2": "depois de amanhã"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} dia", "other": "em {0} dias"},
+			Past:     map[string]string{"one": "há {0} dia", "other": "há {0} dias"},
+			Relative: map[string]string{"-2": "anteontem", "-1": "ontem", "0": "hoje", "1": "amanhã", "2": "depois de amanhã"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} dia", "other": "em {0} dias"},
+			Past:     map[string]string{"one": "há {0} dia", "other": "há {0} dias"},
+			Relative: map[string]string{"-2": "anteontem", "-1": "ontem", "0": "hoje", "1": "amanhã", "2": "depois de amanhã"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} hora", "other": "em {0} horas"},
+			Past:     map[string]string{"one": "há {0} hora", "other": "há {0} horas"},
+			Relative: map[string]string{"0": "esta hora"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} h"},
+			Past:     map[string]string{"other": "há {0} h"},
+			Relative: map[string]string{"0": "esta hora"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} h"},
+			Past:     map[string]string{"other": "há {0} h"},
+			Relative: map[string]string{"0": "esta hora"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} minuto", "other": "em {0} minutos"},
+			Past:     map[string]string{"one": "há {0} minuto", "other": "há {0} minutos"},
+			Relative: map[string]string{"0": "este minuto"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} min."},
+			Past:     map[string]string{"other": "há {0} min."},
+			Relative: map[string]string{"0": "este minuto"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} min."},
+			Past:     map[string]string{"other": "há {0} min."},
+			Relative: map[string]string{"0": "este minuto"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "em {0} segundo", "other": "em {0} segundos"},
+			Past:     map[string]string{"one": "há {0} segundo", "other": "há {0} segundos"},
+			Relative: map[string]string{"0": "agora"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} seg."},
+			Past:     map[string]string{"other": "há {0} seg."},
+			Relative: map[string]string{"0": "agora"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "em {0} seg."},
+			Past:     map[string]string{"other": "há {0} seg."},
+			Relative: map[string]string{"0": "agora"},
+		},
+	},
+}
@@ -47,6 +47,20 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-one": "n = 1"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	if i64 == 1 {
+		return "one"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Romanian,
 	data: hc.CldrData{
@@ -118,5 +132,74 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 tril.",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤;(#,##0.00 ¤)",
+			Short: map[string]string{
+				"1000-count-one":              "0 mie ¤",
+				"1000-count-other":            "0 mii ¤",
+				"10000-count-one":             "00 mii ¤",
+				"10000-count-other":           "00 mii ¤",
+				"100000-count-one":            "000 mii ¤",
+				"100000-count-other":          "000 mii ¤",
+				"1000000-count-one":           "0 mil. ¤",
+				"1000000-count-other":         "0 mil. ¤",
+				"10000000-count-one":          "00 mil. ¤",
+				"10000000-count-other":        "00 mil. ¤",
+				"100000000-count-one":         "000 mil. ¤",
+				"100000000-count-other":       "000 mil. ¤",
+				"1000000000-count-one":        "0 mld. ¤",
+				"1000000000-count-other":      "0 mld. ¤",
+				"10000000000-count-one":       "00 mld. ¤",
+				"10000000000-count-other":     "00 mld. ¤",
+				"100000000000-count-one":      "000 mld. ¤",
+				"100000000000-count-other":    "000 mld. ¤",
+				"1000000000000-count-one":     "0 tril. ¤",
+				"1000000000000-count-other":   "0 tril. ¤",
+				"10000000000000-count-one":    "00 tril. ¤",
+				"10000000000000-count-other":  "00 tril. ¤",
+				"100000000000000-count-one":   "000 tril. ¤",
+				"100000000000000-count-other": "000 tril. ¤",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} și {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}a"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0} - {1}",
+			Approximately: "~{0}",
+			AtLeast:       "≥{0}",
+		},
+		PluralRanges: map[string]string{
+			"few-one": "few",
+		},
 	},
 }
@@ -0,0 +1,118 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: zero;
+1: unu;
+2: doi;
+3: trei;
+4: patru;
+5: cinci;
+6: şase;
+7: şapte;
+8: opt;
+9: nouă;
+10: zece;
+11: unsprezece;
+12: >%spellout-cardinal-masculine>sprezece;
+20: <%spellout-cardinal-feminine<zeci;
+21: <%spellout-cardinal-feminine<zeci şi >%spellout-cardinal-masculine>;
+100: una sută;
+101: una sută >%spellout-cardinal-masculine>;
+200: <%spellout-cardinal-feminine< sute;
+201: <%spellout-cardinal-feminine< sute >%spellout-cardinal-masculine>;
+1000: una mie;
+1001: una mie >%spellout-cardinal-masculine>;
+2000: <%spellout-cardinal-feminine< mii;
+2001: <%spellout-cardinal-feminine< mii >%spellout-cardinal-masculine>;
+1000000: <%spellout-cardinal-neuter< milion;
+1000001: <%spellout-cardinal-neuter< milion >%spellout-cardinal-masculine>;
+2000000: <%spellout-cardinal-neuter< milioane;
+2000001: <%spellout-cardinal-neuter< milioane >%spellout-cardinal-masculine>;
+1000000000: <%spellout-cardinal-neuter< miliard;
+1000000001: <%spellout-cardinal-neuter< miliard >%spellout-cardinal-masculine>;
+2000000000: <%spellout-cardinal-neuter< miliarde;
+2000000001: <%spellout-cardinal-neuter< miliarde >%spellout-cardinal-masculine>;
+1000000000000: <%spellout-cardinal-neuter< bilion;
+1000000000001: <%spellout-cardinal-neuter< bilion >%spellout-cardinal-masculine>;
+2000000000000: <%spellout-cardinal-neuter< bilioane;
+2000000000001: <%spellout-cardinal-neuter< bilioane >%spellout-cardinal-masculine>;
+1000000000000000: <%spellout-cardinal-neuter< biliard;
+1000000000000001: <%spellout-cardinal-neuter< biliard >%spellout-cardinal-masculine>;
+2000000000000000: <%spellout-cardinal-neuter< biliarde;
+2000000000000001: <%spellout-cardinal-neuter< biliarde >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< virgulă >%spellout-cardinal-masculine>;
+%spellout-cardinal-feminine:
+0: zero;
+1: una;
+2: două;
+3: =%spellout-cardinal-masculine=;
+12: >%spellout-cardinal-feminine>sprezece;
+20: <%spellout-cardinal-feminine<zeci;
+21: <%spellout-cardinal-feminine<zeci şi >%spellout-cardinal-feminine>;
+100: una sută;
+101: una sută >%spellout-cardinal-feminine>;
+200: <%spellout-cardinal-feminine< sute;
+201: <%spellout-cardinal-feminine< sute >%spellout-cardinal-feminine>;
+1000: una mie;
+1001: una mie >%spellout-cardinal-feminine>;
+2000: <%spellout-cardinal-feminine< mii;
+2001: <%spellout-cardinal-feminine< mii >%spellout-cardinal-feminine>;
+1000000: <%spellout-cardinal-neuter< milion;
+1000001: <%spellout-cardinal-neuter< milion >%spellout-cardinal-feminine>;
+2000000: <%spellout-cardinal-neuter< milioane;
+2000001: <%spellout-cardinal-neuter< milioane >%spellout-cardinal-feminine>;
+1000000000: <%spellout-cardinal-neuter< miliard;
+1000000001: <%spellout-cardinal-neuter< miliard >%spellout-cardinal-feminine>;
+2000000000: <%spellout-cardinal-neuter< miliarde;
+2000000001: <%spellout-cardinal-neuter< miliarde >%spellout-cardinal-feminine>;
+1000000000000: <%spellout-cardinal-neuter< bilion;
+1000000000001: <%spellout-cardinal-neuter< bilion >%spellout-cardinal-feminine>;
+2000000000000: <%spellout-cardinal-neuter< bilioane;
+2000000000001: <%spellout-cardinal-neuter< bilioane >%spellout-cardinal-feminine>;
+1000000000000000: <%spellout-cardinal-neuter< biliard;
+1000000000000001: <%spellout-cardinal-neuter< biliard >%spellout-cardinal-feminine>;
+2000000000000000: <%spellout-cardinal-neuter< biliarde;
+2000000000000001: <%spellout-cardinal-neuter< biliarde >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< virgulă >%spellout-cardinal-feminine>;
+%spellout-cardinal-neuter:
+0: zero;
+1: unu;
+2: =%spellout-cardinal-feminine=;
+20: <%spellout-cardinal-feminine<zeci;
+21: <%spellout-cardinal-feminine<zeci şi >%spellout-cardinal-neuter>;
+100: una sută;
+101: una sută >%spellout-cardinal-neuter>;
+200: <%spellout-cardinal-feminine< sute;
+201: <%spellout-cardinal-feminine< sute >%spellout-cardinal-neuter>;
+1000: una mie;
+1001: una mie >%spellout-cardinal-neuter>;
+2000: <%spellout-cardinal-feminine< mii;
+2001: <%spellout-cardinal-feminine< mii >%spellout-cardinal-neuter>;
+1000000: <%spellout-cardinal-neuter< milion;
+1000001: <%spellout-cardinal-neuter< milion >%spellout-cardinal-neuter>;
+2000000: <%spellout-cardinal-neuter< milioane;
+2000001: <%spellout-cardinal-neuter< milioane >%spellout-cardinal-neuter>;
+1000000000: <%spellout-cardinal-neuter< miliard;
+1000000001: <%spellout-cardinal-neuter< miliard >%spellout-cardinal-neuter>;
+2000000000: <%spellout-cardinal-neuter< miliarde;
+2000000001: <%spellout-cardinal-neuter< miliarde >%spellout-cardinal-neuter>;
+1000000000000: <%spellout-cardinal-neuter< bilion;
+1000000000001: <%spellout-cardinal-neuter< bilion >%spellout-cardinal-neuter>;
+2000000000000: <%spellout-cardinal-neuter< bilioane;
+2000000000001: <%spellout-cardinal-neuter< bilioane >%spellout-cardinal-neuter>;
+1000000000000000: <%spellout-cardinal-neuter< biliard;
+1000000000000001: <%spellout-cardinal-neuter< biliard >%spellout-cardinal-neuter>;
+2000000000000000: <%spellout-cardinal-neuter< biliarde;
+2000000000000001: <%spellout-cardinal-neuter< biliarde >%spellout-cardinal-neuter>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-neuter< virgulă >%spellout-cardinal-neuter>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} an", "few": "peste {0} ani", "other": "peste {0} de ani"},
+			Past:     map[string]string{"one": "acum {0} an", "few": "acum {0} ani", "other": "acum {0} de ani"},
+			Relative: map[string]string{"-1": "anul trecut", "0": "anul acesta", "1": "anul viitor"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} an", "few": "peste {0} ani", "other": "peste {0} de ani"},
+			Past:     map[string]string{"one": "acum {0} an", "few": "acum {0} ani", "other": "acum {0} de ani"},
+			Relative: map[string]string{"-1": "anul trecut", "0": "anul acesta", "1": "anul viitor"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "+{0} an", "other": "+{0} ani"},
+			Past:     map[string]string{"one": "-{0} an", "other": "-{0} ani"},
+			Relative: map[string]string{"-1": "anul trecut", "0": "anul acesta", "1": "anul viitor"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} trimestru", "few": "peste {0} trimestre", "other": "peste {0} de trimestre"},
+			Past:     map[string]string{"one": "acum {0} trimestru", "few": "acum {0} trimestre", "other": "acum {0} de trimestre"},
+			Relative: map[string]string{"-1": "trimestrul trecut", "0": "trimestrul acesta", "1": "trimestrul viitor"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "peste {0} trim."},
+			Past:     map[string]string{"other": "acum {0} trim."},
+			Relative: map[string]string{"-1": "trim. trecut", "0": "trim. acesta", "1": "trim. viitor"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} trim."},
+			Past:     map[string]string{"other": "-{0} trim."},
+			Relative: map[string]string{"-1": "trim. trecut", "0": "trim. acesta", "1": "trim. viitor"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} lună", "few": "peste {0} luni", "other": "peste {0} de luni"},
+			Past:     map[string]string{"one": "acum {0} lună", "few": "acum {0} luni", "other": "acum {0} de luni"},
+			Relative: map[string]string{"-1": "luna trecută", "0": "luna aceasta", "1": "luna viitoare"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} lună", "other": "peste {0} luni"},
+			Past:     map[string]string{"one": "acum {0} lună", "other": "acum {0} luni"},
+			Relative: map[string]string{"-1": "luna trecută", "0": "luna aceasta", "1": "luna viitoare"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "+{0} lună", "other": "+{0} luni"},
+			Past:     map[string]string{"one": "-{0} lună", "other": "-{0} luni"},
+			Relative: map[string]string{"-1": "luna trecută", "0": "luna aceasta", "1": "luna viitoare"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} săptămână", "few": "peste {0} săptămâni", "other": "peste {0} de săptămâni"},
+			Past:     map[string]string{"one": "acum {0} săptămână", "few": "acum {0} săptămâni", "other": "acum {0} de săptămâni"},
+			Relative: map[string]string{"-1": "săptămâna trecută", "0": "săptămâna aceasta", "1": "săptămâna viitoare"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "peste {0} săpt."},
+			Past:     map[string]string{"other": "acum {0} săpt."},
+			Relative: map[string]string{"-1": "săpt. trecută", "0": "săpt. aceasta", "1": "săpt. viitoare"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} săpt."},
+			Past:     map[string]string{"other": "-{0} săpt."},
+			Relative: map[string]string{"-1": "săpt. trecută", "0": "săpt. aceasta", "1": "săpt. viitoare"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} zi", "few": "peste {0} zile", "other": "peste {0} de zile"},
+			Past:     map[string]string{"one": "acum {0} zi", "few": "acum {0} zile", "other": "acum {0} de zile"},
+			Relative: map[string]string{"-2": "alaltăieri", "-1": "ieri", "0": "azi", "1": "mâine", "2": "poimâine"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} zi", "few": "peste {0} zile", "other": "peste {0} de zile"},
+			Past:     map[string]string{"one": "acum {0} zi", "few": "acum {0} zile", "other": "acum {0} de zile"},
+			Relative: map[string]string{"-2": "alaltăieri", "-1": "ieri", "0": "azi", "1": "mâine", "2": "poimâine"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "+{0} zi", "other": "+{0} zile"},
+			Past:     map[string]string{"one": "-{0} zi", "other": "-{0} zile"},
+			Relative: map[string]string{"-2": "alaltăieri", "-1": "ieri", "0": "azi", "1": "mâine", "2": "poimâine"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} oră", "few": "peste {0} ore", "other": "peste {0} de ore"},
+			Past:     map[string]string{"one": "acum {0} oră", "few": "acum {0} ore", "other": "acum {0} de ore"},
+			Relative: map[string]string{"0": "ora aceasta"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "peste {0} h"},
+			Past:     map[string]string{"other": "acum {0} h"},
+			Relative: map[string]string{"0": "ora aceasta"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} h"},
+			Past:     map[string]string{"other": "-{0} h"},
+			Relative: map[string]string{"0": "ora aceasta"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} minut", "few": "peste {0} minute", "other": "peste {0} de minute"},
+			Past:     map[string]string{"one": "acum {0} minut", "few": "acum {0} minute", "other": "acum {0} de minute"},
+			Relative: map[string]string{"0": "minutul acesta"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "peste {0} min."},
+			Past:     map[string]string{"other": "acum {0} min."},
+			Relative: map[string]string{"0": "minutul acesta"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} m"},
+			Past:     map[string]string{"other": "-{0} m"},
+			Relative: map[string]string{"0": "minutul acesta"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "peste {0} secundă", "few": "peste {0} secunde", "other": "peste {0} de secunde"},
+			Past:     map[string]string{"one": "acum {0} secundă", "few": "acum {0} secunde", "other": "acum {0} de secunde"},
+			Relative: map[string]string{"0": "acum"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "peste {0} sec."},
+			Past:     map[string]string{"other": "acum {0} sec."},
+			Relative: map[string]string{"0": "acum"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} s"},
+			Past:     map[string]string{"other": "-{0} s"},
+			Relative: map[string]string{"0": "acum"},
+		},
+	},
+}
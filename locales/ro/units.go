@@ -0,0 +1,321 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} acru", "few": "{0} acri", "other": "{0} de acri", "per": "{0} pe acru"},
+		Short:  map[string]string{"other": "{0} ac.", "per": "{0}/ac."},
+		Narrow: map[string]string{"other": "{0} ac.", "per": "{0}/ac."},
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} bit", "few": "{0} biți", "other": "{0} de biți", "per": "{0} pe bit"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} byte", "few": "{0} byți", "other": "{0} de byți", "per": "{0} pe byte"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} grad Celsius", "few": "{0} grade Celsius", "other": "{0} de grade Celsius", "per": "{0} pe grad Celsius"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Gender: "neuter",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} centimetru", "few": "{0} centimetri", "other": "{0} de centimetri", "per": "{0} pe centimetru"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} zi", "few": "{0} zile", "other": "{0} de zile", "per": "{0} pe zi"},
+		Short:  map[string]string{"one": "{0} zi", "other": "{0} zile", "per": "{0}/zi"},
+		Narrow: map[string]string{"other": "{0} z", "per": "{0}/zi"},
+		Gender: "feminine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} grad", "few": "{0} grade", "other": "{0} de grade", "per": "{0} pe grad"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "neuter",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} grad Fahrenheit", "few": "{0} grade Fahrenheit", "other": "{0} de grade Fahrenheit", "per": "{0} pe grad Fahrenheit"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} uncie lichidă", "few": "{0} uncii lichide", "other": "{0} de uncii lichide", "per": "{0} pe uncie lichidă"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} picior", "few": "{0} picioare", "other": "{0} de picioare", "per": "{0} pe picior"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} galon", "few": "{0} galoane", "other": "{0} de galoane", "per": "{0} per galon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} gigabit", "few": "{0} gigabiți", "other": "{0} de gigabiți", "per": "{0} pe gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} gigabyte", "few": "{0} gigabyți", "other": "{0} de gigabyți", "per": "{0} pe gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} gram", "few": "{0} grame", "other": "{0} de grame", "per": "{0} per gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Gender: "neuter",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} hectar", "few": "{0} hectare", "other": "{0} de hectare", "per": "{0} pe hectar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Gender: "neuter",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} oră", "few": "{0} ore", "other": "{0} de ore", "per": "{0} pe oră"},
+		Short:  map[string]string{"one": "{0} oră", "other": "{0} ore", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0} h", "per": "{0}/h"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} inch", "few": "{0} inchi", "other": "{0} de inchi", "per": "{0} pe inch"},
+		Short:  map[string]string{"other": "{0} in", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0} in", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} kilobit", "few": "{0} kilobiți", "other": "{0} de kilobiți", "per": "{0} pe kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} kilobyte", "few": "{0} kilobyți", "other": "{0} de kilobyți", "per": "{0} pe kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} kilogram", "few": "{0} kilograme", "other": "{0} de kilograme", "per": "{0} per kilogram"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Gender: "neuter",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} kilometru", "few": "{0} kilometri", "other": "{0} de kilometri", "per": "{0} pe kilometru"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} kilometru pe oră", "few": "{0} kilometri pe oră", "other": "{0} de kilometri pe oră"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0} km/h"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} litru", "few": "{0} litri", "other": "{0} de litri", "per": "{0} pe litru"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} litru pe kilometru", "few": "{0} litri pe kilometru", "other": "{0} de litri pe kilometru"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} megabit", "few": "{0} megabiți", "other": "{0} de megabiți", "per": "{0} pe megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} megabyte", "few": "{0} megabyți", "other": "{0} de megabyți", "per": "{0} pe megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} metru", "few": "{0} metri", "other": "{0} de metri", "per": "{0} pe metru"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} metru pe secundă", "few": "{0} metri pe secundă", "other": "{0} de metri pe secundă"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} microsecundă", "few": "{0} microsecunde", "other": "{0} de microsecunde", "per": "{0} pe microsecundă"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} milă", "few": "{0} mile", "other": "{0} de mile", "per": "{0} pe milă"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} milă pe galon", "few": "{0} mile pe galon", "other": "{0} de mile pe galon"},
+		Short:  map[string]string{"one": "{0} milă/gal.", "other": "{0} mile/gal."},
+		Narrow: map[string]string{"other": "{0} mi/gal"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} milă pe oră", "few": "{0} mile pe oră", "other": "{0} de mile pe oră"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0} mi/h"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} milă scandinavă", "few": "{0} mile scandinave", "other": "{0} de mile scandinave", "per": "{0} pe milă scandinavă"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} mililitru", "few": "{0} mililitri", "other": "{0} de mililitri", "per": "{0} pe mililitru"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} milimetru", "few": "{0} milimetri", "other": "{0} de milimetri", "per": "{0} pe milimetru"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} milisecundă", "few": "{0} milisecunde", "other": "{0} de milisecunde", "per": "{0} pe milisecundă"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minut", "few": "{0} minute", "other": "{0} de minute", "per": "{0} pe minut"},
+		Short:  map[string]string{"other": "{0} min.", "per": "{0}/min."},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/min."},
+		Gender: "neuter",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} lună", "few": "{0} luni", "other": "{0} de luni", "per": "{0} pe lună"},
+		Short:  map[string]string{"one": "{0} lună", "other": "{0} luni", "per": "{0}/lună"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/lună"},
+		Gender: "feminine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosecundă", "few": "{0} nanosecunde", "other": "{0} de nanosecunde", "per": "{0} pe nanosecundă"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} uncie", "few": "{0} uncii", "other": "{0} de uncii", "per": "{0} per uncie"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} pe {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} petabyte", "few": "{0} petabyți", "other": "{0} de petabyți", "per": "{0} pe petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} livră", "few": "{0} livre", "other": "{0} de livre", "per": "{0} per livră"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} secundă", "few": "{0} secunde", "other": "{0} de secunde", "per": "{0} pe secundă"},
+		Short:  map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} centimetru pătrat", "few": "{0} centimetri pătrați", "other": "{0} de centimetri pătrați", "per": "{0} pe centimetru pătrat"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0} pe cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} picior pătrat", "few": "{0} picioare pătrate", "other": "{0} de picioare pătrate"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} kilometru pătrat", "few": "{0} kilometri pătrați", "other": "{0} de kilometri pătrați", "per": "{0} pe kilometru pătrat"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} metru pătrat", "few": "{0} metri pătrați", "other": "{0} de metri pătrați", "per": "{0} pe metru pătrat"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0} pe m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} milă pătrată", "few": "{0} mile pătrate", "other": "{0} de mile pătrate", "per": "{0} pe milă pătrată"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} stone", "few": "{0} stone", "other": "{0} de stone", "per": "{0} pe stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} terabit", "few": "{0} terabiți", "other": "{0} de terabiți", "per": "{0} pe terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} terabyte", "few": "{0} terabyți", "other": "{0} de terabyți", "per": "{0} pe terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} săptămână", "few": "{0} săptămâni", "other": "{0} de săptămâni", "per": "{0} pe săptămână"},
+		Short:  map[string]string{"other": "{0} săpt.", "per": "{0}/săpt."},
+		Narrow: map[string]string{"other": "{0} săpt.", "per": "{0}/săpt."},
+		Gender: "feminine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} iard", "few": "{0} iarzi", "other": "{0} de iarzi", "per": "{0} pe iard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} an", "few": "{0} ani", "other": "{0} de ani", "per": "{0} pe an"},
+		Short:  map[string]string{"one": "{0} an", "other": "{0} ani", "per": "{0}/an"},
+		Narrow: map[string]string{"other": "{0} a", "per": "{0}/an"},
+		Gender: "masculine",
+	},
+}
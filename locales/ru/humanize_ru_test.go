@@ -2,11 +2,13 @@ package locale_test
 
 import (
 	"testing"
+	"time"
 
 	"golang.org/x/text/language"
 
 	hc "github.com/dejurin/humanizecompact"
 	locale "github.com/dejurin/humanizecompact/locales/ru"
+	"github.com/govalues/decimal"
 )
 
 func fallback(number string) string {
@@ -210,3 +212,412 @@ func TestHumanizeRuOptionShort(t *testing.T) {
 		}
 	}
 }
+
+func TestHumanizeRuCurrency(t *testing.T) {
+	tests := []struct {
+		number   string
+		currency string
+		expected string
+	}{
+		{"1200000", "RUB", "1,2\u00A0млн\u00A0₽"},
+		{"5000", "RUB", "5\u00A0тыс.\u00A0₽"},
+		{"1200000", "USD", "1,2\u00A0млн\u00A0$"},
+		{"1234567", "RUB", "1,2\u00A0млн\u00A0₽"},
+		{"1234.5", "RUB", "1,2\u00A0тыс.\u00A0₽"},
+		{"999.25", "RUB", "999,25\u00A0₽"},
+		{"999.25", "CHF", "999,25\u00A0CHF"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatCurrency(tt.number, tt.currency, language.Russian, hc.Options{})
+		if err != nil {
+			t.Errorf("[CURRENCY] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[CURRENCY] number %q %s => got %q, want %q", tt.number, tt.currency, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuUnit(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		expected string
+	}{
+		{hc.Long, "1", "1 километр"},
+		{hc.Long, "21", "21 километр"},
+		{hc.Long, "5", "5 километров"},
+		{hc.Long, "2.5", "2,5 километра"},
+		{hc.Long, "3400000", "3,4 миллиона километров"},
+		{hc.Short, "3400000", "3,4\u00A0млн км"},
+		{hc.Short, "1000", "1\u00A0тыс. км"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatUnit(tt.number, "kilometer", language.Russian, hc.Options{Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[UNIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[UNIT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuCompoundUnit(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		expected string
+	}{
+		{hc.Long, "5", "kilometer-per-hour", "5 километров в час"},
+		{hc.Long, "1200", "per-second", "1,2 тысячи в секунду"},
+		{hc.Short, "1200", "per-second", "1,2\u00A0тыс./с"},
+		{hc.Short, "1200", "megabyte-per-second", "1,2\u00A0тыс. МБ/с"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatUnit(tt.number, tt.unit, language.Russian, hc.Options{Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[UNIT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[UNIT] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuMeasure(t *testing.T) {
+	tests := []struct {
+		number   string
+		unit     string
+		locale   string
+		expected string
+	}{
+		{"68", "fahrenheit", "ru", "20 °C"},
+		{"60", "mile-per-hour", "ru-RU", "96,6 км/ч"},
+		{"1200", "kilometer", "ru-u-ms-ussystem", "745,6 ми"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatMeasure(tt.number, tt.unit, "", language.MustParse(tt.locale), hc.Options{})
+		if err != nil {
+			t.Errorf("[MEASURE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[MEASURE] number %q %s in %s => got %q, want %q", tt.number, tt.unit, tt.locale, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuDuration(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		duration time.Duration
+		maxUnits int
+		expected string
+	}{
+		{hc.Short, 72 * time.Hour, 0, "3 дн."},
+		{hc.Short, 90 * time.Minute, 0, "1,5 ч"},
+		{hc.Short, 90 * time.Minute, 2, "1 ч 30 мин"},
+		{hc.Long, 90 * time.Minute, 2, "1 час 30 минут"},
+		{hc.Long, 22 * 24 * time.Hour, 0, "22 дня"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatDuration(tt.duration, language.Russian, hc.Options{MaxUnits: tt.maxUnits})
+		if err != nil {
+			t.Errorf("[DURATION] duration %v => unexpected error: %v", tt.duration, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[DURATION] duration %v => got %q, want %q", tt.duration, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuRelative(t *testing.T) {
+	tests := []struct {
+		opt      hc.Option
+		number   string
+		unit     string
+		numeric  hc.NumericDisplay
+		expected string
+	}{
+		{hc.Long, "-5", "minute", hc.NumericAlways, "5 минут назад"},
+		{hc.Long, "-21", "day", hc.NumericAlways, "21 день назад"},
+		{hc.Long, "2", "week", hc.NumericAlways, "через 2 недели"},
+		{hc.Long, "1234", "year", hc.NumericAlways, "через 1,2 тысячи лет"},
+		{hc.Short, "-2", "day", hc.NumericAuto, "позавчера"},
+		{hc.Narrow, "-5", "minute", hc.NumericAlways, "-5 мин"},
+	}
+
+	for _, tt := range tests {
+		h := hc.New(locales, tt.opt, fallback)
+		res, err := h.FormatRelative(tt.number, tt.unit, language.Russian, hc.Options{Numeric: tt.numeric, Precision: hc.FractionDigits(0, 1)})
+		if err != nil {
+			t.Errorf("[RELATIVE] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[RELATIVE] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuOrdinal(t *testing.T) {
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "1-й"},
+		{"22", "22-й"},
+		{"1000000", "1\u00A0000\u00A0000-й"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatOrdinal(tt.number, language.Russian, hc.Options{})
+		if err != nil {
+			t.Errorf("[ORDINAL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[ORDINAL] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuSpellOut(t *testing.T) {
+	tests := []struct {
+		number   string
+		ruleSet  string
+		expected string
+	}{
+		{"1", "", "один"},
+		{"21", "", "двадцать один"},
+		{"1200", "", "одна тысяча двести"},
+		{"5000", "", "пять тысяч"},
+		{"1000000", "", "один миллион"},
+		{"0.5", "", "пять десятых"},
+		{"2.25", "", "две целых двадцать пять сотых"},
+		{"1", "spellout-cardinal-feminine", "одна"},
+		{"2", "%spellout-cardinal-feminine", "две"},
+		{"21", "spellout-cardinal-feminine", "двадцать одна"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+
+	for _, tt := range tests {
+		res, err := h.SpellOut(tt.number, language.Russian, tt.ruleSet)
+		if err != nil {
+			t.Errorf("[SPELLOUT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELLOUT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	if _, err := h.SpellOut("5", language.Russian, "spellout-ordinal-x"); err == nil {
+		t.Errorf("[SPELLOUT] rule set %q => expected error", "spellout-ordinal-x")
+	} else if _, ok := err.(hc.UnknownRuleSetError); !ok {
+		t.Errorf("[SPELLOUT] rule set %q => got %T, want hc.UnknownRuleSetError", "spellout-ordinal-x", err)
+	}
+}
+
+func TestHumanizeRuCount(t *testing.T) {
+	views := hc.Noun{"one": "просмотр", "few": "просмотра", "many": "просмотров", "other": "просмотра"}
+
+	tests := []struct {
+		number   string
+		expected string
+	}{
+		{"1", "1 просмотр"},
+		{"2", "2 просмотра"},
+		{"5", "5 просмотров"},
+		{"21", "21 просмотр"},
+		{"1000", "1\u00a0тыс. просмотров"},
+		{"1200", "1,2\u00a0тыс. просмотров"},
+		{"2000", "2\u00a0тыс. просмотров"},
+		{"21000", "21\u00a0тыс. просмотров"},
+		{"1500000", "1,5\u00a0млн просмотров"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1)}
+
+	for _, tt := range tests {
+		res, err := h.FormatCount(tt.number, views, language.Russian, opts)
+		if err != nil {
+			t.Errorf("[COUNT] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[COUNT] number %q => got %q, want %q", tt.number, res, tt.expected)
+		}
+	}
+
+	requests := hc.Noun{"one": "запрос", "few": "запроса", "many": "запросов", "other": "запроса"}
+	long := hc.New(locales, hc.Long, fallback)
+	want := "3 миллиона запросов в секунду"
+	if res, _ := long.FormatCountPer("3000000", requests, "second", language.Russian, opts); res != want {
+		t.Errorf("[COUNT] per %q => got %q, want %q", "second", res, want)
+	}
+}
+
+func TestHumanizeRuMessage(t *testing.T) {
+	m, err := hc.ParseMessage("{count, plural, one {# подписчик} few {# подписчика} many {# подписчиков} other {# подписчика}}")
+	if err != nil {
+		t.Fatalf("[MESSAGE] unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		count    any
+		expected string
+	}{
+		{1, "1 подписчик"},
+		{3, "3 подписчика"},
+		{11, "11 подписчиков"},
+		{21, "21 подписчик"},
+		{"1.5", "1,5 подписчика"},
+		{1200, "1,2\u00a0тыс. подписчиков"},
+		{3000000, "3\u00a0млн подписчиков"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatMessage(m, map[string]any{"count": tt.count}, language.Russian, hc.Options{})
+		if err != nil {
+			t.Errorf("[MESSAGE] count %v => unexpected error: %v", tt.count, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[MESSAGE] count %v => got %q, want %q", tt.count, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeRuRange(t *testing.T) {
+	tests := []struct {
+		lo, hi   string
+		expected string
+	}{
+		{"1000", "5000", "1–5\u00a0тыс."},
+		{"1000", "2000000", "1\u00a0тыс. – 2\u00a0млн"},
+		{"1200", "1240", "≈1,2\u00a0тыс."},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, err := h.FormatRange(tt.lo, tt.hi, language.Russian, hc.Options{})
+		if err != nil {
+			t.Errorf("[RANGE] range %q-%q => unexpected error: %v", tt.lo, tt.hi, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[RANGE] range %q-%q => got %q, want %q", tt.lo, tt.hi, res, tt.expected)
+		}
+	}
+
+	long := hc.New(locales, hc.Long, fallback)
+	if res, _ := long.FormatRange("1000", "5000", language.Russian, hc.Options{}); res != "1 тысяча – 5 тысяч" {
+		t.Errorf("[RANGE] long => got %q, want %q", res, "1 тысяча – 5 тысяч")
+	}
+
+	views := hc.Noun{"one": "просмотр", "few": "просмотра", "many": "просмотров", "other": "просмотра"}
+	if res, _ := h.FormatCountRange("1", "3", views, language.Russian, hc.Options{}); res != "1–3 просмотра" {
+		t.Errorf("[RANGE] count => got %q, want %q", res, "1–3 просмотра")
+	}
+}
+
+func TestHumanizeRuFixedScale(t *testing.T) {
+	values := []string{"950000", "1200000", "30000000"}
+	expected := []string{"0,95\u00A0млн", "1,2\u00A0млн", "30\u00A0млн"}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	scale, name, err := h.CommonScaleName(values, language.Russian)
+	if err != nil {
+		t.Fatalf("[SCALE] unexpected error: %v", err)
+	}
+	if scale != 6 || name != "млн" {
+		t.Errorf("[SCALE] common scale => got %d %q, want %d %q", scale, name, 6, "млн")
+	}
+
+	opts := hc.Options{Precision: hc.FractionDigits(0, 2), FixedScale: scale}
+	for i, value := range values {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(value), language.Russian, opts)
+		if err != nil {
+			t.Errorf("[SCALE] number %q => unexpected error: %v", value, err)
+			continue
+		}
+		if res != expected[i] {
+			t.Errorf("[SCALE] number %q => got %q, want %q", value, res, expected[i])
+		}
+	}
+
+	long := hc.New(locales, hc.Long, fallback)
+	if _, name, _ := long.CommonScaleName(values, language.Russian); name != "миллион" {
+		t.Errorf("[SCALE] long name => got %q, want %q", name, "миллион")
+	}
+}
+
+func TestHumanizeRuSpellSmallNumbers(t *testing.T) {
+	tests := []struct {
+		number   string
+		unit     string
+		expected string
+	}{
+		{"1", "day", "один день"},
+		{"2", "hour", "два часа"},
+		{"1", "minute", "одна минута"},
+		{"1", "second", "одна секунда"},
+		{"2", "week", "две недели"},
+		{"3", "kilometer-per-hour", "три километра в час"},
+	}
+
+	h := hc.New(locales, hc.Long, fallback)
+	opts := hc.Options{SpellSmallNumbers: 10}
+
+	for _, tt := range tests {
+		res, err := h.FormatUnit(tt.number, tt.unit, language.Russian, opts)
+		if err != nil {
+			t.Errorf("[SPELL SMALL] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SPELL SMALL] number %q %s => got %q, want %q", tt.number, tt.unit, res, tt.expected)
+		}
+	}
+
+	// The gender of a noun is unknown, so it is counted in digits unless
+	// the rule set is given.
+	weeks := hc.Noun{"one": "неделя", "few": "недели", "many": "недель", "other": "недели"}
+	if res, _ := h.FormatCount("1", weeks, language.Russian, opts); res != "1 неделя" {
+		t.Errorf("[SPELL SMALL] count => got %q, want %q", res, "1 неделя")
+	}
+	if res, _ := h.FormatRelative("-1", "minute", language.Russian, opts); res != "1 минуту назад" {
+		t.Errorf("[SPELL SMALL] relative => got %q, want %q", res, "1 минуту назад")
+	}
+	opts.SpellOutRuleSet = "spellout-cardinal-feminine"
+	if res, _ := h.FormatCount("1", weeks, language.Russian, opts); res != "одна неделя" {
+		t.Errorf("[SPELL SMALL] count => got %q, want %q", res, "одна неделя")
+	}
+}
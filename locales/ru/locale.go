@@ -51,6 +51,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Russian,
 	data: hc.CldrData{
@@ -135,5 +140,89 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 трлн",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 тыс. ¤",
+				"1000-count-other":            "0 тыс. ¤",
+				"10000-count-one":             "00 тыс. ¤",
+				"10000-count-other":           "00 тыс. ¤",
+				"100000-count-one":            "000 тыс. ¤",
+				"100000-count-other":          "000 тыс. ¤",
+				"1000000-count-one":           "0 млн ¤",
+				"1000000-count-other":         "0 млн ¤",
+				"10000000-count-one":          "00 млн ¤",
+				"10000000-count-other":        "00 млн ¤",
+				"100000000-count-one":         "000 млн ¤",
+				"100000000-count-other":       "000 млн ¤",
+				"1000000000-count-one":        "0 млрд ¤",
+				"1000000000-count-other":      "0 млрд ¤",
+				"10000000000-count-one":       "00 млрд ¤",
+				"10000000000-count-other":     "00 млрд ¤",
+				"100000000000-count-one":      "000 млрд ¤",
+				"100000000000-count-other":    "000 млрд ¤",
+				"1000000000000-count-one":     "0 трлн ¤",
+				"1000000000000-count-other":   "0 трлн ¤",
+				"10000000000000-count-one":    "00 трлн ¤",
+				"10000000000000-count-other":  "00 трлн ¤",
+				"100000000000000-count-one":   "000 трлн ¤",
+				"100000000000000-count-other": "000 трлн ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "A$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"RUB": "₽",
+				"THB": "฿",
+				"UAH": "₴",
+				"USD": "$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}-й"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "≈{0}",
+			AtLeast:       "≥{0}",
+		},
 	},
 }
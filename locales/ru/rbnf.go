@@ -0,0 +1,206 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: ноль;
+1: один;
+2: два;
+3: три;
+4: четыре;
+5: пять;
+6: шесть;
+7: семь;
+8: восемь;
+9: девять;
+10: десять;
+11: одиннадцать;
+12: двенадцать;
+13: тринадцать;
+14: четырнадцать;
+15: пятнадцать;
+16: шестнадцать;
+17: семнадцать;
+18: восемнадцать;
+19: девятнадцать;
+20: двадцать;
+21: двадцать >%spellout-cardinal-masculine>;
+30: тридцать;
+31: тридцать >%spellout-cardinal-masculine>;
+40: сорок;
+41: сорок >%spellout-cardinal-masculine>;
+50: пятьдесят;
+51: пятьдесят >%spellout-cardinal-masculine>;
+60: шестьдесят;
+61: шестьдесят >%spellout-cardinal-masculine>;
+70: семьдесят;
+71: семьдесят >%spellout-cardinal-masculine>;
+80: восемьдесят;
+81: восемьдесят >%spellout-cardinal-masculine>;
+90: девяносто;
+91: девяносто >%spellout-cardinal-masculine>;
+100: сто;
+101: сто >%spellout-cardinal-masculine>;
+200: <%spellout-cardinal-feminine<сти;
+201: <%spellout-cardinal-feminine<сти >%spellout-cardinal-masculine>;
+300: <%spellout-cardinal-feminine<ста;
+301: <%spellout-cardinal-feminine<ста >%spellout-cardinal-masculine>;
+500: <%spellout-cardinal-feminine<сот;
+501: <%spellout-cardinal-feminine<сот >%spellout-cardinal-masculine>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$ >%spellout-cardinal-masculine>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$ >%spellout-cardinal-masculine>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$ >%spellout-cardinal-masculine>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$ >%spellout-cardinal-masculine>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$ >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-feminine< $(cardinal,one{целый}other{целых})$ >%%fractions-feminine>;
+0.x: >%%fractions-feminine>;
+%spellout-cardinal-neuter:
+0: ноль;
+1: одно;
+2: =%spellout-cardinal-masculine=;
+20: двадцать;
+21: двадцать >%spellout-cardinal-neuter>;
+30: тридцать;
+31: тридцать >%spellout-cardinal-neuter>;
+40: сорок;
+41: сорок >%spellout-cardinal-neuter>;
+50: пятьдесят;
+51: пятьдесят >%spellout-cardinal-neuter>;
+60: шестьдесят;
+61: шестьдесят >%spellout-cardinal-neuter>;
+70: семьдесят;
+71: семьдесят >%spellout-cardinal-neuter>;
+80: восемьдесят;
+81: восемьдесят >%spellout-cardinal-neuter>;
+90: девяносто;
+91: девяносто >%spellout-cardinal-neuter>;
+100: сто;
+101: сто >%spellout-cardinal-neuter>;
+200: <%spellout-cardinal-feminine<сти;
+201: <%spellout-cardinal-feminine<сти >%spellout-cardinal-neuter>;
+300: <%spellout-cardinal-feminine<ста;
+301: <%spellout-cardinal-feminine<ста >%spellout-cardinal-neuter>;
+500: <%spellout-cardinal-feminine<сот;
+501: <%spellout-cardinal-feminine<сот >%spellout-cardinal-neuter>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$ >%spellout-cardinal-neuter>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$ >%spellout-cardinal-neuter>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$ >%spellout-cardinal-neuter>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$ >%spellout-cardinal-neuter>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$ >%spellout-cardinal-neuter>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-feminine< $(cardinal,one{целая}other{целых})$ >%%fractions-feminine>;
+0.x: >%%fractions-feminine>;
+%spellout-cardinal-feminine:
+0: ноль;
+1: одна;
+2: две;
+3: =%spellout-cardinal-masculine=;
+20: двадцать;
+21: двадцать >%spellout-cardinal-feminine>;
+30: тридцать;
+31: тридцать >%spellout-cardinal-feminine>;
+40: сорок;
+41: сорок >%spellout-cardinal-feminine>;
+50: пятьдесят;
+51: пятьдесят >%spellout-cardinal-feminine>;
+60: шестьдесят;
+61: шестьдесят >%spellout-cardinal-feminine>;
+70: семьдесят;
+71: семьдесят >%spellout-cardinal-feminine>;
+80: восемьдесят;
+81: восемьдесят >%spellout-cardinal-feminine>;
+90: девяносто;
+91: девяносто >%spellout-cardinal-feminine>;
+100: сто;
+101: сто >%spellout-cardinal-feminine>;
+200: <%spellout-cardinal-feminine<сти;
+201: <%spellout-cardinal-feminine<сти >%spellout-cardinal-feminine>;
+300: <%spellout-cardinal-feminine<ста;
+301: <%spellout-cardinal-feminine<ста >%spellout-cardinal-feminine>;
+500: <%spellout-cardinal-feminine<сот;
+501: <%spellout-cardinal-feminine<сот >%spellout-cardinal-feminine>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$ >%spellout-cardinal-feminine>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$ >%spellout-cardinal-feminine>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$ >%spellout-cardinal-feminine>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$ >%spellout-cardinal-feminine>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$ >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< $(cardinal,one{целая}other{целых})$ >%%fractions-feminine>;
+0.x: >%%fractions-feminine>;
+%spellout-cardinal-plural:
+0: ноль;
+1: одни;
+2: две;
+3: =%spellout-cardinal-masculine=;
+20: двадцать;
+21: двадцать >%spellout-cardinal-plural>;
+30: тридцать;
+31: тридцать >%spellout-cardinal-plural>;
+40: сорок;
+41: сорок >%spellout-cardinal-plural>;
+50: пятьдесят;
+51: пятьдесят >%spellout-cardinal-plural>;
+60: шестьдесят;
+61: шестьдесят >%spellout-cardinal-plural>;
+70: семьдесят;
+71: семьдесят >%spellout-cardinal-plural>;
+80: восемьдесят;
+81: восемьдесят >%spellout-cardinal-plural>;
+90: девяносто;
+91: девяносто >%spellout-cardinal-plural>;
+100: сто;
+101: сто >%spellout-cardinal-plural>;
+200: <%spellout-cardinal-feminine<сти;
+201: <%spellout-cardinal-feminine<сти >%spellout-cardinal-plural>;
+300: <%spellout-cardinal-feminine<ста;
+301: <%spellout-cardinal-feminine<ста >%spellout-cardinal-plural>;
+500: <%spellout-cardinal-feminine<сот;
+501: <%spellout-cardinal-feminine<сот >%spellout-cardinal-plural>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тысяча}few{тысячи}other{тысяч})$ >%spellout-cardinal-plural>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{миллион}few{миллиона}other{миллионов})$ >%spellout-cardinal-plural>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{миллиард}few{миллиарда}other{миллиардов})$ >%spellout-cardinal-plural>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{триллион}few{триллиона}other{триллионов})$ >%spellout-cardinal-plural>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{квадриллион}few{квадриллиона}other{квадриллионов})$ >%spellout-cardinal-plural>;
+1000000000000000000: =#,##0=;
+-x: минус >%spellout-cardinal-plural>;
+x.x: <%spellout-cardinal-plural< запятая >%spellout-cardinal-plural>;
+%%fractions-feminine:
+10: <%spellout-cardinal-feminine< $(cardinal,one{десятая}other{десятых})$;
+100: <%spellout-cardinal-feminine< $(cardinal,one{сотая}other{сотых})$;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тысячная}other{тысячных})$;
+10000: <%spellout-cardinal-feminine< $(cardinal,one{десятитысячная}other{десятитысячных})$;
+100000: <%spellout-cardinal-feminine< $(cardinal,one{стотысячная}other{стотысячных})$;
+1000000: <%spellout-cardinal-feminine< $(cardinal,one{миллионная}other{миллионных})$;
+10000000: <%spellout-cardinal-feminine< $(cardinal,one{десятимиллионная}other{десятимиллионных})$;
+100000000: <%spellout-cardinal-feminine< $(cardinal,one{стомиллионная}other{стомиллионных})$;
+1000000000: <%spellout-cardinal-feminine< $(cardinal,one{миллиардная}other{миллиардных})$;
+10000000000: <%spellout-cardinal-feminine< $(cardinal,one{десятимиллиардная}other{десятимиллиардных})$;
+100000000000: <%spellout-cardinal-feminine< $(cardinal,one{стомиллиардная}other{стомиллиардных})$;
+1000000000000: <0<;
+`
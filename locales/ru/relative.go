@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} год", "many": "через {0} лет", "other": "через {0} года"},
+			Past:     map[string]string{"one": "{0} год назад", "many": "{0} лет назад", "other": "{0} года назад"},
+			Relative: map[string]string{"-1": "в прошлом году", "0": "в этом году", "1": "в следующем году"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"many": "через {0} л.", "other": "через {0} г."},
+			Past:     map[string]string{"many": "{0} л. назад", "other": "{0} г. назад"},
+			Relative: map[string]string{"-1": "в прошлом г.", "0": "в этом г.", "1": "в след. г."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"many": "+{0} л.", "other": "+{0} г."},
+			Past:     map[string]string{"many": "-{0} л.", "other": "-{0} г."},
+			Relative: map[string]string{"-1": "в пр. г.", "0": "в эт. г.", "1": "в сл. г."},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} квартал", "many": "через {0} кварталов", "other": "через {0} квартала"},
+			Past:     map[string]string{"one": "{0} квартал назад", "many": "{0} кварталов назад", "other": "{0} квартала назад"},
+			Relative: map[string]string{"-1": "в прошлом квартале", "0": "в текущем квартале", "1": "в следующем квартале"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} кв."},
+			Past:     map[string]string{"other": "{0} кв. назад"},
+			Relative: map[string]string{"-1": "последний кв.", "0": "текущий кв.", "1": "следующий кв."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} кв."},
+			Past:     map[string]string{"other": "-{0} кв."},
+			Relative: map[string]string{"-1": "посл. кв.", "0": "тек. кв.", "1": "след. кв."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} месяц", "many": "через {0} месяцев", "other": "через {0} месяца"},
+			Past:     map[string]string{"one": "{0} месяц назад", "many": "{0} месяцев назад", "other": "{0} месяца назад"},
+			Relative: map[string]string{"-1": "в прошлом месяце", "0": "в этом месяце", "1": "в следующем месяце"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} мес."},
+			Past:     map[string]string{"other": "{0} мес. назад"},
+			Relative: map[string]string{"-1": "в прошлом мес.", "0": "в этом мес.", "1": "в следующем мес."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} мес."},
+			Past:     map[string]string{"other": "-{0} мес."},
+			Relative: map[string]string{"-1": "в пр. мес.", "0": "в эт. мес.", "1": "в след. мес."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} неделю", "many": "через {0} недель", "other": "через {0} недели"},
+			Past:     map[string]string{"one": "{0} неделю назад", "many": "{0} недель назад", "other": "{0} недели назад"},
+			Relative: map[string]string{"-1": "на прошлой неделе", "0": "на этой неделе", "1": "на следующей неделе"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} нед."},
+			Past:     map[string]string{"other": "{0} нед. назад"},
+			Relative: map[string]string{"-1": "на прошлой нед.", "0": "на этой нед.", "1": "на следующей нед."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} нед."},
+			Past:     map[string]string{"other": "-{0} нед."},
+			Relative: map[string]string{"-1": "на пр. нед.", "0": "на эт. нед.", "1": "на след. нед."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} день", "many": "через {0} дней", "other": "через {0} дня"},
+			Past:     map[string]string{"one": "{0} день назад", "many": "{0} дней назад", "other": "{0} дня назад"},
+			Relative: map[string]string{"-2": "позавчера", "-1": "вчера", "0": "сегодня", "1": "завтра", "2": "послезавтра"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} дн."},
+			Past:     map[string]string{"other": "{0} дн. назад"},
+			Relative: map[string]string{"-2": "позавчера", "-1": "вчера", "0": "сегодня", "1": "завтра", "2": "послезавтра"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} дн."},
+			Past:     map[string]string{"other": "-{0} дн."},
+			Relative: map[string]string{"-2": "позавчера", "-1": "вчера", "0": "сегодня", "1": "завтра", "2": "послезавтра"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} час", "many": "через {0} часов", "other": "через {0} часа"},
+			Past:     map[string]string{"one": "{0} час назад", "many": "{0} часов назад", "other": "{0} часа назад"},
+			Relative: map[string]string{"0": "в этот час"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} ч"},
+			Past:     map[string]string{"other": "{0} ч назад"},
+			Relative: map[string]string{"0": "в этот час"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} ч"},
+			Past:     map[string]string{"other": "-{0} ч"},
+			Relative: map[string]string{"0": "в этот час"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} минуту", "many": "через {0} минут", "other": "через {0} минуты"},
+			Past:     map[string]string{"one": "{0} минуту назад", "many": "{0} минут назад", "other": "{0} минуты назад"},
+			Relative: map[string]string{"0": "в эту минуту"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} мин."},
+			Past:     map[string]string{"other": "{0} мин. назад"},
+			Relative: map[string]string{"0": "в эту минуту"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} мин"},
+			Past:     map[string]string{"other": "-{0} мин"},
+			Relative: map[string]string{"0": "в эту минуту"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} секунду", "many": "через {0} секунд", "other": "через {0} секунды"},
+			Past:     map[string]string{"one": "{0} секунду назад", "many": "{0} секунд назад", "other": "{0} секунды назад"},
+			Relative: map[string]string{"0": "сейчас"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} сек."},
+			Past:     map[string]string{"other": "{0} сек. назад"},
+			Relative: map[string]string{"0": "сейчас"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} с"},
+			Past:     map[string]string{"other": "-{0} с"},
+			Relative: map[string]string{"0": "сейчас"},
+		},
+	},
+}
@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} акр", "many": "{0} акров", "other": "{0} акра", "per": "{0}/акр"},
+		Short:  map[string]string{"one": "{0} акр", "other": "{0} акр.", "per": "{0}/акр"},
+		Narrow: map[string]string{"one": "{0} акр", "other": "{0} акр.", "per": "{0}/акр"},
+		Gender: "masculine",
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} бит", "many": "{0} бит", "other": "{0} бита", "per": "{0}/бит"},
+		Short:  map[string]string{"one": "{0} бит", "many": "{0} бит", "other": "{0} бита", "per": "{0}/бит"},
+		Narrow: map[string]string{"one": "{0} бит", "many": "{0} бит", "other": "{0} бита", "per": "{0}/бит"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} байт", "many": "{0} байт", "other": "{0} байта", "per": "{0}/байт"},
+		Short:  map[string]string{"other": "{0} Б", "per": "{0}/Б"},
+		Narrow: map[string]string{"other": "{0} Б", "per": "{0}/Б"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} градус Цельсия", "many": "{0} градусов Цельсия", "other": "{0} градуса Цельсия", "per": "{0}/градус Цельсия"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Gender: "masculine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} сантиметр", "many": "{0} сантиметров", "other": "{0} сантиметра", "per": "{0} на сантиметр"},
+		Short:  map[string]string{"other": "{0} см", "per": "{0}/см"},
+		Narrow: map[string]string{"other": "{0} см", "per": "{0}/см"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} день", "many": "{0} дней", "other": "{0} дня", "per": "{0} в день"},
+		Short:  map[string]string{"other": "{0} дн.", "per": "{0}/д"},
+		Narrow: map[string]string{"other": "{0} д.", "per": "{0}/д."},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} градус", "many": "{0} градусов", "other": "{0} градуса", "per": "{0}/градус"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "masculine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} градус Фаренгейта", "many": "{0} градусов Фаренгейта", "other": "{0} градуса Фаренгейта", "per": "{0}/градус Фаренгейта"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"few": "{0} °F", "other": "{0}°F", "per": "{0}/°F"},
+		Gender: "masculine",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} амер. жидкая унция", "few": "{0} амер. жидкие унции", "many": "{0} амер. жидких унций", "other": "{0} амер. жидкой унции", "per": "{0}/амер. жидкая унция"},
+		Short:  map[string]string{"other": "{0} ам. жидк. унц.", "per": "{0}/ам. жидк. унц."},
+		Narrow: map[string]string{"other": "{0} ам. жидк. унц.", "per": "{0}/ам. жидк. унц."},
+		Gender: "feminine",
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} фут", "many": "{0} футов", "other": "{0} фута", "per": "{0} на фут"},
+		Short:  map[string]string{"other": "{0} фт", "per": "{0}/фт"},
+		Narrow: map[string]string{"other": "{0} фт", "per": "{0}/фт"},
+		Gender: "masculine",
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} амер. галлон", "many": "{0} амер. галлонов", "other": "{0} амер. галлона", "per": "{0} на амер. галлон"},
+		Short:  map[string]string{"other": "{0} ам. гал.", "per": "{0}/ам. гал"},
+		Narrow: map[string]string{"other": "{0} ам. гал.", "per": "{0}/ам. гал"},
+		Gender: "masculine",
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} гигабит", "many": "{0} гигабит", "other": "{0} гигабита", "per": "{0}/гигабит"},
+		Short:  map[string]string{"other": "{0} Гбит", "per": "{0}/Гбит"},
+		Narrow: map[string]string{"other": "{0} Гбит", "per": "{0}/Гбит"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} гигабайт", "many": "{0} гигабайт", "other": "{0} гигабайта", "per": "{0}/гигабайт"},
+		Short:  map[string]string{"other": "{0} ГБ", "per": "{0}/ГБ"},
+		Narrow: map[string]string{"other": "{0} ГБ", "per": "{0}/ГБ"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} грамм", "many": "{0} грамм", "other": "{0} грамма", "per": "{0} на грамм"},
+		Short:  map[string]string{"other": "{0} г", "per": "{0}/г"},
+		Narrow: map[string]string{"other": "{0} г", "per": "{0}/г"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} гектар", "many": "{0} гектаров", "other": "{0} гектара", "per": "{0}/гектар"},
+		Short:  map[string]string{"other": "{0} га", "per": "{0}/га"},
+		Narrow: map[string]string{"other": "{0} га", "per": "{0}/га"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} час", "many": "{0} часов", "other": "{0} часа", "per": "{0} в час"},
+		Short:  map[string]string{"other": "{0} ч", "per": "{0}/ч"},
+		Narrow: map[string]string{"other": "{0} ч", "per": "{0}/ч"},
+		Gender: "masculine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} дюйм", "many": "{0} дюймов", "other": "{0} дюйма", "per": "{0} на дюйм"},
+		Short:  map[string]string{"one": "{0} дюйм", "other": "{0} дюйм.", "per": "{0}/дюйм"},
+		Narrow: map[string]string{"other": "{0} дюйм.", "per": "{0}/дюйм"},
+		Gender: "masculine",
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} килобит", "many": "{0} килобит", "other": "{0} килобита", "per": "{0}/килобит"},
+		Short:  map[string]string{"other": "{0} кбит", "per": "{0}/кбит"},
+		Narrow: map[string]string{"other": "{0} кбит", "per": "{0}/кбит"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} килобайт", "many": "{0} килобайт", "other": "{0} килобайта", "per": "{0}/килобайт"},
+		Short:  map[string]string{"other": "{0} кБ", "per": "{0}/кБ"},
+		Narrow: map[string]string{"other": "{0} кБ", "per": "{0}/кБ"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} килограмм", "many": "{0} килограмм", "other": "{0} килограмма", "per": "{0} на килограмм"},
+		Short:  map[string]string{"other": "{0} кг", "per": "{0}/кг"},
+		Narrow: map[string]string{"other": "{0} кг", "per": "{0}/кг"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} километр", "many": "{0} километров", "other": "{0} километра", "per": "{0} на километр"},
+		Short:  map[string]string{"other": "{0} км", "per": "{0}/км"},
+		Narrow: map[string]string{"other": "{0} км", "per": "{0}/км"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} километр в час", "many": "{0} километров в час", "other": "{0} километра в час"},
+		Short:  map[string]string{"other": "{0} км/ч"},
+		Narrow: map[string]string{"other": "{0} км/ч"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} литр", "many": "{0} литров", "other": "{0} литра", "per": "{0} на литр"},
+		Short:  map[string]string{"other": "{0} л", "per": "{0}/л"},
+		Narrow: map[string]string{"other": "{0} л", "per": "{0}/л"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} литр на километр", "many": "{0} литров на километр", "other": "{0} литра на километр"},
+		Short:  map[string]string{"other": "{0} л/км"},
+		Narrow: map[string]string{"other": "{0} л/км"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} мегабит", "many": "{0} мегабит", "other": "{0} мегабита", "per": "{0}/мегабит"},
+		Short:  map[string]string{"other": "{0} Мбит", "per": "{0}/Мбит"},
+		Narrow: map[string]string{"other": "{0} Мбит", "per": "{0}/Мбит"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} мегабайт", "many": "{0} мегабайт", "other": "{0} мегабайта", "per": "{0}/мегабайт"},
+		Short:  map[string]string{"other": "{0} МБ", "per": "{0}/МБ"},
+		Narrow: map[string]string{"other": "{0} МБ", "per": "{0}/МБ"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} метр", "many": "{0} метров", "other": "{0} метра", "per": "{0} на метр"},
+		Short:  map[string]string{"other": "{0} м", "per": "{0}/м"},
+		Narrow: map[string]string{"other": "{0} м", "per": "{0}/м"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} метр в секунду", "many": "{0} метров в секунду", "other": "{0} метра в секунду"},
+		Short:  map[string]string{"other": "{0} м/с"},
+		Narrow: map[string]string{"other": "{0} м/с"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} микросекунда", "many": "{0} микросекунд", "other": "{0} микросекунды", "per": "{0}/микросекунда"},
+		Short:  map[string]string{"other": "{0} мкс", "per": "{0}/мкс"},
+		Narrow: map[string]string{"other": "{0} мкс", "per": "{0}/мкс"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} миля", "many": "{0} миль", "other": "{0} мили", "per": "{0}/миля"},
+		Short:  map[string]string{"other": "{0} ми", "per": "{0}/ми"},
+		Narrow: map[string]string{"other": "{0} ми", "per": "{0}/ми"},
+		Gender: "feminine",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} миля на амер. галлон", "many": "{0} миль на амер. галлон", "other": "{0} мили на амер. галлон"},
+		Short:  map[string]string{"other": "{0} ми/ам. гал"},
+		Narrow: map[string]string{"other": "{0} ми/ам. гал"},
+		Gender: "feminine",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} миля в час", "many": "{0} миль в час", "other": "{0} мили в час"},
+		Short:  map[string]string{"other": "{0} ми/ч"},
+		Narrow: map[string]string{"other": "{0} ми/ч"},
+		Gender: "feminine",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} скандинавская миля", "few": "{0} скандинавские мили", "many": "{0} скандинавских миль", "other": "{0} скандинавской мили", "per": "{0}/скандинавская миля"},
+		Short:  map[string]string{"other": "{0} ск. ми", "per": "{0}/ск. ми"},
+		Narrow: map[string]string{"other": "{0} ск. ми", "per": "{0}/ск. ми"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} миллилитр", "many": "{0} миллилитров", "other": "{0} миллилитра", "per": "{0}/миллилитр"},
+		Short:  map[string]string{"other": "{0} мл", "per": "{0}/мл"},
+		Narrow: map[string]string{"other": "{0} мл", "per": "{0}/мл"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} миллиметр", "many": "{0} миллиметров", "other": "{0} миллиметра", "per": "{0}/миллиметр"},
+		Short:  map[string]string{"other": "{0} мм", "per": "{0}/мм"},
+		Narrow: map[string]string{"other": "{0} мм", "per": "{0}/мм"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} миллисекунда", "many": "{0} миллисекунд", "other": "{0} миллисекунды", "per": "{0}/миллисекунда"},
+		Short:  map[string]string{"other": "{0} мс", "per": "{0}/мс"},
+		Narrow: map[string]string{"other": "{0} мс", "per": "{0}/мс"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} минута", "many": "{0} минут", "other": "{0} минуты", "per": "{0} в минуту"},
+		Short:  map[string]string{"other": "{0} мин", "per": "{0}/мин"},
+		Narrow: map[string]string{"other": "{0} мин", "per": "{0}/мин"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} месяц", "many": "{0} месяцев", "other": "{0} месяца", "per": "{0} в месяц"},
+		Short:  map[string]string{"other": "{0} мес.", "per": "{0}/мес"},
+		Narrow: map[string]string{"other": "{0} м.", "per": "{0}/м."},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} наносекунда", "many": "{0} наносекунд", "other": "{0} наносекунды", "per": "{0}/наносекунда"},
+		Short:  map[string]string{"other": "{0} нс", "per": "{0}/нс"},
+		Narrow: map[string]string{"other": "{0} нс", "per": "{0}/нс"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} унция", "many": "{0} унций", "other": "{0} унции", "per": "{0} на унцию"},
+		Short:  map[string]string{"other": "{0} унц.", "per": "{0}/унц"},
+		Narrow: map[string]string{"other": "{0} унц.", "per": "{0}/унц"},
+		Gender: "feminine",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} на {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} петабайт", "many": "{0} петабайт", "other": "{0} петабайта", "per": "{0}/петабайт"},
+		Short:  map[string]string{"other": "{0} ПБ", "per": "{0}/ПБ"},
+		Narrow: map[string]string{"other": "{0} ПБ", "per": "{0}/ПБ"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} фунт", "many": "{0} фунтов", "other": "{0} фунта", "per": "{0} на фунт"},
+		Short:  map[string]string{"other": "{0} фнт", "per": "{0}/фнт"},
+		Narrow: map[string]string{"other": "{0} фнт", "per": "{0}/фнт"},
+		Gender: "masculine",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} секунда", "many": "{0} секунд", "other": "{0} секунды", "per": "{0} в секунду"},
+		Short:  map[string]string{"other": "{0} с", "per": "{0}/с"},
+		Narrow: map[string]string{"other": "{0} с", "per": "{0}/с"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} квадратный сантиметр", "few": "{0} квадратных сантиметра", "many": "{0} квадратных сантиметров", "other": "{0} квадратного сантиметра", "per": "{0} на квадратный сантиметр"},
+		Short:  map[string]string{"other": "{0} см²", "per": "{0}/см²"},
+		Narrow: map[string]string{"other": "{0} см²", "per": "{0}/см²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} квадратный фут", "few": "{0} квадратных фута", "many": "{0} квадратных футов", "other": "{0} квадратного фута"},
+		Short:  map[string]string{"other": "{0} фт²"},
+		Narrow: map[string]string{"other": "{0} фт²"},
+		Gender: "masculine",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} квадратный километр", "few": "{0} квадратных километра", "many": "{0} квадратных километров", "other": "{0} квадратного километра", "per": "{0} на квадратный километр"},
+		Short:  map[string]string{"other": "{0} км²", "per": "{0}/км²"},
+		Narrow: map[string]string{"other": "{0} км²", "per": "{0}/км²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} квадратный метр", "few": "{0} квадратных метра", "many": "{0} квадратных метров", "other": "{0} квадратного метра", "per": "{0} на квадратный метр"},
+		Short:  map[string]string{"other": "{0} м²", "per": "{0}/м²"},
+		Narrow: map[string]string{"other": "{0} м²", "per": "{0}/м²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} квадратная миля", "few": "{0} квадратные мили", "many": "{0} квадратных миль", "other": "{0} квадратной мили", "per": "{0} на квадратную милю"},
+		Short:  map[string]string{"other": "{0} ми²", "per": "{0}/ми²"},
+		Narrow: map[string]string{"other": "{0} ми²", "per": "{0}/ми²"},
+		Gender: "feminine",
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} стоун", "many": "{0} стоунов", "other": "{0} стоуна", "per": "{0}/стоун"},
+		Short:  map[string]string{"one": "{0} стоун", "many": "{0} стоунов", "other": "{0} стоуна", "per": "{0}/стоун"},
+		Narrow: map[string]string{"other": "{0} стн", "per": "{0}/стн"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} терабит", "many": "{0} терабит", "other": "{0} терабита", "per": "{0}/терабит"},
+		Short:  map[string]string{"other": "{0} Тбит", "per": "{0}/Тбит"},
+		Narrow: map[string]string{"other": "{0} Тбит", "per": "{0}/Тбит"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} терабайт", "many": "{0} терабайт", "other": "{0} терабайта", "per": "{0}/терабайт"},
+		Short:  map[string]string{"other": "{0} ТБ", "per": "{0}/ТБ"},
+		Narrow: map[string]string{"other": "{0} ТБ", "per": "{0}/ТБ"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} неделя", "many": "{0} недель", "other": "{0} недели", "per": "{0} в неделю"},
+		Short:  map[string]string{"other": "{0} нед.", "per": "{0}/нед"},
+		Narrow: map[string]string{"other": "{0} н.", "per": "{0}/н."},
+		Gender: "feminine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} ярд", "many": "{0} ярдов", "other": "{0} ярда", "per": "{0}/ярд"},
+		Short:  map[string]string{"one": "{0} ярд", "other": "{0} ярд.", "per": "{0}/ярд"},
+		Narrow: map[string]string{"one": "{0} ярд", "other": "{0} ярд.", "per": "{0}/ярд"},
+		Gender: "masculine",
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} год", "many": "{0} лет", "other": "{0} года", "per": "{0} в год"},
+		Short:  map[string]string{"many": "{0} л.", "other": "{0} г.", "per": "{0}/г"},
+		Narrow: map[string]string{"many": "{0} л.", "other": "{0} г.", "per": "{0}/г."},
+		Gender: "masculine",
+	},
+}
@@ -38,6 +38,23 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-one": "n % 10 = 1,2 and n % 100 != 11,12"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	i10 := i64 % 10
+	i100 := i64 % 100
+
+	if (i10 == 1 || i10 == 2) && i100 != 11 && i100 != 12 {
+		return "one"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Swedish,
 	data: hc.CldrData{
@@ -97,5 +114,85 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 bn",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 tn ¤",
+				"1000-count-other":            "0 tn ¤",
+				"10000-count-one":             "00 tn ¤",
+				"10000-count-other":           "00 tn ¤",
+				"100000-count-one":            "000 tn ¤",
+				"100000-count-other":          "000 tn ¤",
+				"1000000-count-one":           "0 mn ¤",
+				"1000000-count-other":         "0 mn ¤",
+				"10000000-count-one":          "00 mn ¤",
+				"10000000-count-other":        "00 mn ¤",
+				"100000000-count-one":         "000 mn ¤",
+				"100000000-count-other":       "000 mn ¤",
+				"1000000000-count-one":        "0 md ¤",
+				"1000000000-count-other":      "0 md ¤",
+				"10000000000-count-one":       "00 md ¤",
+				"10000000000-count-other":     "00 md ¤",
+				"100000000000-count-one":      "000 md ¤",
+				"100000000000-count-other":    "000 md ¤",
+				"1000000000000-count-one":     "0 bn ¤",
+				"1000000000000-count-other":   "0 bn ¤",
+				"10000000000000-count-one":    "00 bn ¤",
+				"10000000000000-count-other":  "00 bn ¤",
+				"100000000000000-count-one":   "000 bn ¤",
+				"100000000000000-count-other": "000 bn ¤",
+			},
+			Symbols: map[string]string{
+				"BRL": "BR$",
+				"CAD": "CA$",
+				"DKK": "Dkr",
+				"EGP": "EG£",
+				"EUR": "€",
+				"ILS": "₪",
+				"MXN": "MX$",
+				"SEK": "kr",
+				"USD": "US$",
+			},
+		},
+		PercentFormat: "#,##0 %",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "−",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"one": "{0}:a", "other": "{0}:e"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}‒{1}",
+			Approximately: "~{0}",
+			AtLeast:       "⩾{0}",
+		},
+		PluralRanges: map[string]string{
+			"other-one": "other",
+		},
 	},
 }
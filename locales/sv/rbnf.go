@@ -0,0 +1,155 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: noll;
+1: ett;
+2: två;
+3: tre;
+4: fyra;
+5: fem;
+6: sex;
+7: sju;
+8: åtta;
+9: nio;
+10: tio;
+11: elva;
+12: tolv;
+13: tretton;
+14: fjorton;
+15: femton;
+16: sexton;
+17: sjutton;
+18: arton;
+19: nitton;
+20: tjugo;
+21: tjugo­>%spellout-numbering>;
+30: trettio;
+31: trettio­>%spellout-numbering>;
+40: fyrtio;
+41: fyrtio­>%spellout-numbering>;
+50: femtio;
+51: femtio­>%spellout-numbering>;
+60: sextio;
+61: sextio­>%spellout-numbering>;
+70: sjuttio;
+71: sjuttio­>%spellout-numbering>;
+80: åttio;
+81: åttio­>%spellout-numbering>;
+90: nittio;
+91: nittio­>%spellout-numbering>;
+100: <%spellout-numbering<­hundra;
+101: <%spellout-numbering<­hundra­>%spellout-numbering>;
+1000: <%%spellout-numbering-t<­tusen;
+1001: <%%spellout-numbering-t<­tusen >%spellout-numbering>;
+1000000: en miljon;
+1000001: en miljon >%spellout-numbering>;
+2000000: <%spellout-cardinal-reale< miljoner;
+2000001: <%spellout-cardinal-reale< miljoner >%spellout-numbering>;
+1000000000: en miljard;
+1000000001: en miljard >%spellout-numbering>;
+2000000000: <%spellout-cardinal-reale< miljarder;
+2000000001: <%spellout-cardinal-reale< miljarder >%spellout-numbering>;
+1000000000000: en biljon;
+1000000000001: en biljon >%spellout-numbering>;
+2000000000000: <%spellout-cardinal-reale< biljoner;
+2000000000001: <%spellout-cardinal-reale< biljoner >%spellout-numbering>;
+1000000000000000: en biljard;
+1000000000000001: en biljard >%spellout-numbering>;
+2000000000000000: <%spellout-cardinal-reale< biljarder;
+2000000000000001: <%spellout-cardinal-reale< biljarder >%spellout-numbering>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-numbering>;
+x.x: <%spellout-numbering< komma >%spellout-numbering>;
+%%spellout-numbering-t:
+1: et;
+2: två;
+3: tre;
+4: fyra;
+5: fem;
+6: sex;
+7: sju;
+8: åtta;
+9: nio;
+10: tio;
+11: elva;
+12: tolv;
+13: tretton;
+14: fjorton;
+15: femton;
+16: sexton;
+17: sjutton;
+18: arton;
+19: nitton;
+20: tjugo;
+21: tjugo­>%%spellout-numbering-t>;
+30: trettio;
+31: trettio­>%%spellout-numbering-t>;
+40: fyrtio;
+41: fyrtio­>%%spellout-numbering-t>;
+50: femtio;
+51: femtio­>%%spellout-numbering-t>;
+60: sextio;
+61: sextio­>%%spellout-numbering-t>;
+70: sjuttio;
+71: sjuttio­>%%spellout-numbering-t>;
+80: åttio;
+81: åttio­>%%spellout-numbering-t>;
+90: nittio;
+91: nittio­>%%spellout-numbering-t>;
+100: <%spellout-numbering<­hundra;
+101: <%spellout-numbering<­hundra­>%%spellout-numbering-t>;
+1000: ERROR;
+%spellout-cardinal-neuter:
+0: =%spellout-numbering=;
+%spellout-cardinal-masculine:
+0: =%spellout-cardinal-reale=;
+%spellout-cardinal-feminine:
+0: =%spellout-cardinal-reale=;
+%spellout-cardinal-reale:
+0: noll;
+1: en;
+2: =%spellout-numbering=;
+20: tjugo;
+21: tjugo­>%spellout-cardinal-reale>;
+30: trettio;
+31: trettio­>%spellout-cardinal-reale>;
+40: fyrtio;
+41: fyrtio­>%spellout-cardinal-reale>;
+50: femtio;
+51: femtio­>%spellout-cardinal-reale>;
+60: sextio;
+61: sextio­>%spellout-cardinal-reale>;
+70: sjuttio;
+71: sjuttio­>%spellout-cardinal-reale>;
+80: åttio;
+81: åttio­>%spellout-cardinal-reale>;
+90: nittio;
+91: nittio­>%spellout-cardinal-reale>;
+100: <%spellout-cardinal-neuter<­hundra;
+101: <%spellout-cardinal-neuter<­hundra­>%spellout-cardinal-reale>;
+1000: ettusen;
+1001: ettusen >%spellout-cardinal-reale>;
+2000: <%spellout-cardinal-reale<­tusen;
+2001: <%spellout-cardinal-reale<­tusen >%spellout-cardinal-reale>;
+1000000: en miljon;
+1000001: en miljon >%spellout-cardinal-reale>;
+2000000: <%spellout-cardinal-reale< miljoner;
+2000001: <%spellout-cardinal-reale< miljoner >%spellout-cardinal-reale>;
+1000000000: en miljard;
+1000000001: en miljard >%spellout-cardinal-reale>;
+2000000000: <%spellout-cardinal-reale< miljarder;
+2000000001: <%spellout-cardinal-reale< miljarder >%spellout-cardinal-reale>;
+1000000000000: en biljon;
+1000000000001: en biljon >%spellout-cardinal-reale>;
+2000000000000: <%spellout-cardinal-reale< biljoner;
+2000000000001: <%spellout-cardinal-reale< biljoner >%spellout-cardinal-reale>;
+1000000000000000: en biljard;
+1000000000000001: en biljard >%spellout-cardinal-reale>;
+2000000000000000: <%spellout-cardinal-reale< biljarder;
+2000000000000001: <%spellout-cardinal-reale< biljarder >%spellout-cardinal-reale>;
+1000000000000000000: =#,##0=;
+-x: minus >%spellout-cardinal-reale>;
+x.x: <%spellout-cardinal-reale< komma >%spellout-cardinal-reale>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} år"},
+			Past:     map[string]string{"other": "för {0} år sedan"},
+			Relative: map[string]string{"-1": "i fjol", "0": "i år", "1": "nästa år"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} år"},
+			Past:     map[string]string{"other": "för {0} år sen"},
+			Relative: map[string]string{"-1": "i fjol", "0": "i år", "1": "nästa år"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} år"},
+			Past:     map[string]string{"other": "−{0} år"},
+			Relative: map[string]string{"-1": "i fjol", "0": "i år", "1": "nästa år"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} kvartal"},
+			Past:     map[string]string{"other": "för {0} kvartal sedan"},
+			Relative: map[string]string{"-1": "förra kvartalet", "0": "detta kvartal", "1": "nästa kvartal"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} kv."},
+			Past:     map[string]string{"other": "för {0} kv. sen"},
+			Relative: map[string]string{"-1": "förra kv.", "0": "detta kv.", "1": "nästa kv."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} kv."},
+			Past:     map[string]string{"other": "−{0} kv"},
+			Relative: map[string]string{"-1": "förra kv.", "0": "detta kv.", "1": "nästa kv."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} månad", "other": "om {0} månader"},
+			Past:     map[string]string{"one": "för {0} månad sedan", "other": "för {0} månader sedan"},
+			Relative: map[string]string{"-1": "förra månaden", "0": "den här månaden", "1": "nästa månad"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} mån."},
+			Past:     map[string]string{"other": "för {0} mån. sen"},
+			Relative: map[string]string{"-1": "förra mån.", "0": "denna mån.", "1": "nästa mån."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} mån."},
+			Past:     map[string]string{"other": "−{0} mån"},
+			Relative: map[string]string{"-1": "förra mån.", "0": "denna mån.", "1": "nästa mån."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} vecka", "other": "om {0} veckor"},
+			Past:     map[string]string{"one": "för {0} vecka sedan", "other": "för {0} veckor sedan"},
+			Relative: map[string]string{"-1": "förra veckan", "0": "denna vecka", "1": "nästa vecka"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} v."},
+			Past:     map[string]string{"other": "för {0} v. sedan"},
+			Relative: map[string]string{"-1": "förra v.", "0": "denna v.", "1": "nästa v."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} v."},
+			Past:     map[string]string{"other": "−{0} v"},
+			Relative: map[string]string{"-1": "förra v.", "0": "denna v.", "1": "nästa v."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} dag", "other": "om {0} dagar"},
+			Past:     map[string]string{"one": "för {0} dag sedan", "other": "för {0} dagar sedan"},
+			Relative: map[string]string{"-2": "i förrgår", "-1": "i går", "0": "i dag", "1": "i morgon", "2": "i övermorgon"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} d"},
+			Past:     map[string]string{"one": "för {0} d sedan", "other": "för {0} d sedan"},
+			Relative: map[string]string{"-2": "i förrgår", "-1": "i går", "0": "i dag", "1": "i morgon", "2": "i övermorgon"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} d"},
+			Past:     map[string]string{"other": "−{0} d"},
+			Relative: map[string]string{"-2": "i förrgår", "-1": "igår", "0": "idag", "1": "imorgon", "2": "i övermorgon"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} timme", "other": "om {0} timmar"},
+			Past:     map[string]string{"one": "för {0} timme sedan", "other": "för {0} timmar sedan"},
+			Relative: map[string]string{"0": "denna timme"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} tim"},
+			Past:     map[string]string{"other": "för {0} tim sedan"},
+			Relative: map[string]string{"0": "denna timme"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} h"},
+			Past:     map[string]string{"other": "−{0} h"},
+			Relative: map[string]string{"0": "denna timme"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} minut", "other": "om {0} minuter"},
+			Past:     map[string]string{"one": "för {0} minut sedan", "other": "för {0} minuter sedan"},
+			Relative: map[string]string{"0": "denna minut"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "om {0} min"},
+			Past:     map[string]string{"other": "för {0} min sen"},
+			Relative: map[string]string{"0": "denna minut"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} min"},
+			Past:     map[string]string{"other": "−{0} min"},
+			Relative: map[string]string{"0": "denna minut"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} sekund", "other": "om {0} sekunder"},
+			Past:     map[string]string{"one": "för {0} sekund sedan", "other": "för {0} sekunder sedan"},
+			Relative: map[string]string{"0": "nu"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "om {0} sek", "other": "om {0} sek"},
+			Past:     map[string]string{"other": "för {0} s sen"},
+			Relative: map[string]string{"0": "nu"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "+{0} s"},
+			Past:     map[string]string{"other": "−{0} s"},
+			Relative: map[string]string{"0": "nu"},
+		},
+	},
+}
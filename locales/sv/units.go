@@ -0,0 +1,335 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} engelskt tunnland", "other": "{0} engelska tunnland", "per": "{0} per engelskt tunnland"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+		Gender: "neuter",
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0} per bit"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0}b", "per": "{0}/b"},
+		Gender: "common",
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} byte", "per": "{0} per byte"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0}B", "per": "{0}/B"},
+		Gender: "common",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} grad Celsius", "other": "{0} grader Celsius", "per": "{0} per grad Celsius"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "common",
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} centimeter", "per": "{0} per centimeter"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+		Gender: "common",
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} dygn", "per": "{0} per dygn"},
+		Short:  map[string]string{"other": "{0} d", "per": "{0}/d"},
+		Narrow: map[string]string{"other": "{0}d", "per": "{0}/d"},
+		Gender: "neuter",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} grad", "other": "{0} grader", "per": "{0} per grad"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "common",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} grad Fahrenheit", "other": "{0} grader Fahrenheit", "per": "{0} per grad Fahrenheit"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Gender: "common",
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} flytande uns", "other": "{0} fluid ounces", "per": "{0} per flytande uns"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+		Gender: "neuter",
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} fot", "per": "{0} per fot"},
+		Short:  map[string]string{"other": "{0} fot", "per": "{0}/fot"},
+		Narrow: map[string]string{"other": "{0}fot", "per": "{0}/fot"},
+		Gender: "common",
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} gallon", "per": "{0} per gallon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal US"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal US"},
+		Gender: "common",
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0} per gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+		Gender: "common",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} gigabyte", "per": "{0} per gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+		Gender: "common",
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} gram", "per": "{0} per gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+		Gender: "neuter",
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} hektar", "per": "{0} per hektar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+		Gender: "common",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} timme", "other": "{0} timmar", "per": "{0} per timme"},
+		Short:  map[string]string{"other": "{0} tim", "per": "{0}/h"},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/h"},
+		Gender: "common",
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} tum", "per": "{0} per tum"},
+		Short:  map[string]string{"other": "{0} tum", "per": "{0}/tum"},
+		Narrow: map[string]string{"one": "{0} tum", "other": "{0}\"", "per": "{0}/tum"},
+		Gender: "common",
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0} per kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+		Gender: "common",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} kilobyte", "per": "{0} per kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+		Gender: "common",
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} kilogram", "per": "{0} per kilogram"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+		Gender: "neuter",
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} kilometer", "per": "{0} per kilometer"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+		Gender: "common",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} kilometer per timme"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+		Gender: "common",
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} liter", "per": "{0} per liter"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0}l", "per": "{0}/l"},
+		Gender: "common",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} liter per kilometer"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0}l/km"},
+		Gender: "common",
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0} per megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+		Gender: "common",
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} megabyte", "per": "{0} per megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+		Gender: "common",
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} meter", "per": "{0} per meter"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Gender: "common",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} meter per sekund"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+		Gender: "common",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} mikrosekund", "other": "{0} mikrosekunder", "per": "{0} per mikrosekund"},
+		Short:  map[string]string{"other": "{0} μs", "per": "{0}/μs"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+		Gender: "common",
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} mile", "per": "{0} per mile"},
+		Short:  map[string]string{"other": "{0} mi", "per": "{0}/mi"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+		Gender: "common",
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} mile per gallon", "other": "{0} miles per gallon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+		Gender: "common",
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} mile per timme"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0}mi/h"},
+		Gender: "common",
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} mil", "per": "{0} per mil"},
+		Short:  map[string]string{"other": "{0} mil", "per": "{0}/mil"},
+		Narrow: map[string]string{"other": "{0}mil", "per": "{0}/mil"},
+		Gender: "common",
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} milliliter", "per": "{0} per milliliter"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0}ml", "per": "{0}/ml"},
+		Gender: "common",
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} millimeter", "per": "{0} per millimeter"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+		Gender: "common",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} millisekund", "other": "{0} millisekunder", "per": "{0} per millisekund"},
+		Short:  map[string]string{"other": "{0} ms", "per": "{0}/ms"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+		Gender: "common",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} minut", "other": "{0} minuter", "per": "{0} per minut"},
+		Short:  map[string]string{"other": "{0} min", "per": "{0}/min"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+		Gender: "common",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} månad", "other": "{0} månader", "per": "{0} per månad"},
+		Short:  map[string]string{"other": "{0} mån", "per": "{0}/mån"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/mån"},
+		Gender: "common",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} nanosekund", "other": "{0} nanosekunder", "per": "{0} per nanosekund"},
+		Short:  map[string]string{"other": "{0} ns", "per": "{0}/ns"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+		Gender: "common",
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} uns", "per": "{0} per uns"},
+		Short:  map[string]string{"other": "{0} uns", "per": "{0}/uns"},
+		Narrow: map[string]string{"other": "{0}uns", "per": "{0}/uns"},
+		Gender: "neuter",
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} per {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} petabyte", "per": "{0} per petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+		Gender: "common",
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} pund", "per": "{0} per pund"},
+		Short:  map[string]string{"other": "{0} pund", "per": "{0}/pund"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/pund"},
+		Gender: "neuter",
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} sekund", "other": "{0} sekunder", "per": "{0} per sekund"},
+		Short:  map[string]string{"other": "{0} s", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0}s", "per": "{0}/s"},
+		Gender: "common",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} kvadratcentimeter", "per": "{0} per kvadratcentimeter"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+		Gender: "common",
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} kvadratfot"},
+		Short:  map[string]string{"other": "{0} kv.fot"},
+		Narrow: map[string]string{"other": "{0}fot²"},
+		Gender: "common",
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} kvadratkilometer", "per": "{0} per kvadratkilometer"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+		Gender: "common",
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} kvadratmeter", "per": "{0} per kvadratmeter"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+		Gender: "common",
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} kvadratmile", "per": "{0} per kvadratmile"},
+		Short:  map[string]string{"other": "{0} kv.mile", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+		Gender: "common",
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} engelsk sten", "other": "{0} engelska stenar", "per": "{0} per engelsk sten"},
+		Short:  map[string]string{"other": "{0} eng. sten", "per": "{0}/eng. sten"},
+		Narrow: map[string]string{"other": "{0} eng. s:n", "per": "{0}/eng. s:n"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0} per terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+		Gender: "common",
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} terabyte", "per": "{0} per terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+		Gender: "common",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} vecka", "other": "{0} veckor", "per": "{0} per vecka"},
+		Short:  map[string]string{"other": "{0} v", "per": "{0}/v"},
+		Narrow: map[string]string{"other": "{0}v", "per": "{0}/v"},
+		Gender: "common",
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} yard", "per": "{0} per yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"one": "{0} yd", "other": "{0}yd", "per": "{0}/yd"},
+		Gender: "common",
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} år", "per": "{0} per år"},
+		Short:  map[string]string{"other": "{0} år", "per": "{0}/år"},
+		Narrow: map[string]string{"other": "{0}å", "per": "{0}/år"},
+		Gender: "neuter",
+	},
+}
@@ -0,0 +1,40 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%spellout-cardinal:
+0: ศูนย์;
+1: หนึ่ง;
+2: สอง;
+3: สาม;
+4: สี่;
+5: ห้า;
+6: หก;
+7: เจ็ด;
+8: แปด;
+9: เก้า;
+10: สิบ;
+11: สิบ​>%%alt-ones>;
+20: ยี่​สิบ;
+21: ยี่​สิบ​>%%alt-ones>;
+30: <%spellout-cardinal<​สิบ;
+31: <%spellout-cardinal<​สิบ​>%%alt-ones>;
+100: <%spellout-cardinal<​ร้อย;
+101: <%spellout-cardinal<​ร้อย​>%spellout-cardinal>;
+1000: <%spellout-cardinal<​พัน;
+1001: <%spellout-cardinal<​พัน​>%spellout-cardinal>;
+10000: <%spellout-cardinal<​หมื่น;
+10001: <%spellout-cardinal<​หมื่น​>%spellout-cardinal>;
+100000: <%spellout-cardinal<​แสน;
+100001: <%spellout-cardinal<​แสน​>%spellout-cardinal>;
+1000000: <%spellout-cardinal<​ล้าน;
+1000001: <%spellout-cardinal<​ล้าน​>%spellout-cardinal>;
+1000000000000000000: =#,##0=;
+-x: ลบ​>%spellout-cardinal>;
+x.x: <%spellout-cardinal<​จุด​>>>;
+%%alt-ones:
+1: เอ็ด;
+2: =%spellout-cardinal=;
+`
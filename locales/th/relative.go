@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} ปี"},
+			Past:     map[string]string{"other": "{0} ปีที่แล้ว"},
+			Relative: map[string]string{"-1": "ปีที่แล้ว", "0": "ปีนี้", "1": "ปีหน้า"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} ปี"},
+			Past:     map[string]string{"other": "{0} ปีที่แล้ว"},
+			Relative: map[string]string{"-1": "ปีที่แล้ว", "0": "ปีนี้", "1": "ปีหน้า"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} ปี"},
+			Past:     map[string]string{"other": "{0} ปีที่แล้ว"},
+			Relative: map[string]string{"-1": "ปีที่แล้ว", "0": "ปีนี้", "1": "ปีหน้า"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} ไตรมาส"},
+			Past:     map[string]string{"other": "{0} ไตรมาสที่แล้ว"},
+			Relative: map[string]string{"-1": "ไตรมาสที่แล้ว", "0": "ไตรมาสนี้", "1": "ไตรมาสหน้า"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} ไตรมาส"},
+			Past:     map[string]string{"other": "{0} ไตรมาสที่แล้ว"},
+			Relative: map[string]string{"-1": "ไตรมาสที่แล้ว", "0": "ไตรมาสนี้", "1": "ไตรมาสหน้า"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} ไตรมาส"},
+			Past:     map[string]string{"other": "{0} ไตรมาสที่แล้ว"},
+			Relative: map[string]string{"-1": "ไตรมาสที่แล้ว", "0": "ไตรมาสนี้", "1": "ไตรมาสหน้า"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} เดือน"},
+			Past:     map[string]string{"other": "{0} เดือนที่ผ่านมา"},
+			Relative: map[string]string{"-1": "เดือนที่แล้ว", "0": "เดือนนี้", "1": "เดือนหน้า"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} เดือน"},
+			Past:     map[string]string{"other": "{0} เดือนที่แล้ว"},
+			Relative: map[string]string{"-1": "เดือนที่แล้ว", "0": "เดือนนี้", "1": "เดือนหน้า"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} เดือน"},
+			Past:     map[string]string{"other": "{0} เดือนที่แล้ว"},
+			Relative: map[string]string{"-1": "เดือนที่แล้ว", "0": "เดือนนี้", "1": "เดือนหน้า"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} สัปดาห์"},
+			Past:     map[string]string{"other": "{0} สัปดาห์ที่ผ่านมา"},
+			Relative: map[string]string{"-1": "สัปดาห์ที่แล้ว", "0": "สัปดาห์นี้", "1": "สัปดาห์หน้า"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} สัปดาห์"},
+			Past:     map[string]string{"other": "{0} สัปดาห์ที่แล้ว"},
+			Relative: map[string]string{"-1": "สัปดาห์ที่แล้ว", "0": "สัปดาห์นี้", "1": "สัปดาห์หน้า"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} สัปดาห์"},
+			Past:     map[string]string{"other": "{0} สัปดาห์ที่แล้ว"},
+			Relative: map[string]string{"-1": "สัปดาห์ที่แล้ว", "0": "สัปดาห์นี้", "1": "สัปดาห์หน้า"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} วัน"},
+			Past:     map[string]string{"other": "{0} วันที่ผ่านมา"},
+			Relative: map[string]string{"-2": "เมื่อวานซืน", "-1": "เมื่อวาน", "0": "วันนี้", "1": "พรุ่งนี้", "2": "มะรืนนี้"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} วัน"},
+			Past:     map[string]string{"other": "{0} วันที่แล้ว"},
+			Relative: map[string]string{"-2": "เมื่อวานซืน", "-1": "เมื่อวาน", "0": "วันนี้", "1": "พรุ่งนี้", "2": "มะรืนนี้"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} วัน"},
+			Past:     map[string]string{"other": "{0} วันที่แล้ว"},
+			Relative: map[string]string{"-2": "เมื่อวานซืน", "-1": "เมื่อวาน", "0": "วันนี้", "1": "พรุ่งนี้", "2": "มะรืนนี้"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} ชั่วโมง"},
+			Past:     map[string]string{"other": "{0} ชั่วโมงที่ผ่านมา"},
+			Relative: map[string]string{"0": "ชั่วโมงนี้"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} ชม."},
+			Past:     map[string]string{"other": "{0} ชม. ที่แล้ว"},
+			Relative: map[string]string{"0": "ชั่วโมงนี้"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} ชม."},
+			Past:     map[string]string{"other": "{0} ชม. ที่แล้ว"},
+			Relative: map[string]string{"0": "ชั่วโมงนี้"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} นาที"},
+			Past:     map[string]string{"other": "{0} นาทีที่ผ่านมา"},
+			Relative: map[string]string{"0": "นาทีนี้"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} นาที"},
+			Past:     map[string]string{"other": "{0} นาทีที่แล้ว"},
+			Relative: map[string]string{"0": "นาทีนี้"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} นาที"},
+			Past:     map[string]string{"other": "{0} นาทีที่แล้ว"},
+			Relative: map[string]string{"0": "นาทีนี้"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ในอีก {0} วินาที"},
+			Past:     map[string]string{"other": "{0} วินาทีที่ผ่านมา"},
+			Relative: map[string]string{"0": "ขณะนี้"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} วินาที"},
+			Past:     map[string]string{"other": "{0} วินาทีที่แล้ว"},
+			Relative: map[string]string{"0": "ขณะนี้"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "ใน {0} วินาที"},
+			Past:     map[string]string{"other": "{0} วินาทีที่แล้ว"},
+			Relative: map[string]string{"0": "ขณะนี้"},
+		},
+	},
+}
@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} เอเคอร์", "per": "{0}ต่อเอเคอร์"},
+		Short:  map[string]string{"other": "{0} เอเคอร์", "per": "{0}/เอเคอร์"},
+		Narrow: map[string]string{"other": "{0}เอเคอร์", "per": "{0}/เอเคอร์"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} บิต", "per": "{0}ต่อบิต"},
+		Short:  map[string]string{"other": "{0} บิต", "per": "{0}/บิต"},
+		Narrow: map[string]string{"other": "{0}บิต", "per": "{0}/บิต"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} ไบต์", "per": "{0}ต่อไบต์"},
+		Short:  map[string]string{"other": "{0} ไบต์", "per": "{0}/ไบต์"},
+		Narrow: map[string]string{"other": "{0}ไบต์", "per": "{0}/ไบต์"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} องศาเซลเซียส", "per": "{0}ต่อองศาเซลเซียส"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} เซนติเมตร", "per": "{0} ต่อเซนติเมตร"},
+		Short:  map[string]string{"other": "{0} ซม.", "per": "{0}/ซม."},
+		Narrow: map[string]string{"other": "{0}ซม.", "per": "{0}/ซม."},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} วัน", "per": "{0} ต่อวัน"},
+		Short:  map[string]string{"other": "{0} วัน", "per": "{0}/วัน"},
+		Narrow: map[string]string{"other": "{0}วัน", "per": "{0}/วัน"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} องศา", "per": "{0}ต่อองศา"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} องศาฟาเรนไฮต์", "per": "{0}ต่อองศาฟาเรนไฮต์"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} ฟลูอิดออนซ์", "per": "{0}ต่อฟลูอิดออนซ์"},
+		Short:  map[string]string{"other": "{0} ฟลูอิดออนซ์", "per": "{0}/ฟลูอิดออนซ์"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} ฟุต", "per": "{0} ต่อฟุต"},
+		Short:  map[string]string{"other": "{0} ฟุต", "per": "{0}/ฟุต"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ฟุต"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} แกลลอน", "per": "{0} ต่อแกลลอน"},
+		Short:  map[string]string{"other": "{0} แกลลอน", "per": "{0}/แกลลอน"},
+		Narrow: map[string]string{"other": "{0}แกลลอน", "per": "{0}/แกลลอน"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} กิกะบิต", "per": "{0}ต่อกิกะบิต"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0}Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} กิกะไบต์", "per": "{0}ต่อกิกะไบต์"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0}GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} กรัม", "per": "{0} ต่อกรัม"},
+		Short:  map[string]string{"other": "{0} ก.", "per": "{0}/ก."},
+		Narrow: map[string]string{"other": "{0}ก.", "per": "{0}/ก."},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} เฮกตาร์", "per": "{0}ต่อเฮกตาร์"},
+		Short:  map[string]string{"other": "{0} เฮกตาร์", "per": "{0}/เฮกตาร์"},
+		Narrow: map[string]string{"other": "{0}เฮกตาร์", "per": "{0}/เฮกตาร์"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} ชั่วโมง", "per": "{0} ต่อชั่วโมง"},
+		Short:  map[string]string{"other": "{0} ชม.", "per": "{0}/ชม."},
+		Narrow: map[string]string{"other": "{0}ชม.", "per": "{0}/ชม."},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} นิ้ว", "per": "{0} ต่อนิ้ว"},
+		Short:  map[string]string{"other": "{0} นิ้ว", "per": "{0}/นิ้ว"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/นิ้ว"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} กิโลบิต", "per": "{0}ต่อกิโลบิต"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0}kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} กิโลไบต์", "per": "{0}ต่อกิโลไบต์"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0}kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} กิโลกรัม", "per": "{0} ต่อกิโลกรัม"},
+		Short:  map[string]string{"other": "{0} กก.", "per": "{0}/กก."},
+		Narrow: map[string]string{"other": "{0}กก.", "per": "{0}/กก."},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} กิโลเมตร", "per": "{0} ต่อกิโลเมตร"},
+		Short:  map[string]string{"other": "{0} กม.", "per": "{0}/กม."},
+		Narrow: map[string]string{"other": "{0}กม.", "per": "{0}/กม."},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} กิโลเมตรต่อชั่วโมง"},
+		Short:  map[string]string{"other": "{0} กม./ชม."},
+		Narrow: map[string]string{"other": "{0}กม./ชม."},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} ลิตร", "per": "{0} ต่อลิตร"},
+		Short:  map[string]string{"other": "{0} ล.", "per": "{0}/ล."},
+		Narrow: map[string]string{"other": "{0}ล.", "per": "{0}/ล."},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} ลิตรต่อกิโลเมตร"},
+		Short:  map[string]string{"other": "{0} ล./กม."},
+		Narrow: map[string]string{"other": "{0}ล./กม."},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} เมกะบิต", "per": "{0}ต่อเมกะบิต"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0}Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} เมกะไบต์", "per": "{0}ต่อเมกะไบต์"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0}MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} เมตร", "per": "{0} ต่อเมตร"},
+		Short:  map[string]string{"other": "{0} ม.", "per": "{0}/ม."},
+		Narrow: map[string]string{"other": "{0}ม.", "per": "{0}/ม."},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} เมตรต่อวินาที"},
+		Short:  map[string]string{"other": "{0} ม./วิ"},
+		Narrow: map[string]string{"other": "{0}ม./วิ"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} ไมโครวินาที", "per": "{0}ต่อไมโครวินาที"},
+		Short:  map[string]string{"other": "{0} ไมโครวิ", "per": "{0}/ไมโครวิ"},
+		Narrow: map[string]string{"other": "{0}ไมโครวิ", "per": "{0}/ไมโครวิ"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} ไมล์", "per": "{0}ต่อไมล์"},
+		Short:  map[string]string{"other": "{0} ไมล์", "per": "{0}/ไมล์"},
+		Narrow: map[string]string{"other": "{0}ไมล์", "per": "{0}/ไมล์"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} ไมล์ต่อแกลลอน"},
+		Short:  map[string]string{"other": "{0} ไมล์/แกลลอน"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} ไมล์ต่อชั่วโมง"},
+		Short:  map[string]string{"other": "{0} ไมล์/ชม."},
+		Narrow: map[string]string{"other": "{0}ไมล์/ชม."},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} ไมล์สแกนดิเนเวีย", "per": "{0}ต่อไมล์สแกนดิเนเวีย"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} มิลลิลิตร", "per": "{0}ต่อมิลลิลิตร"},
+		Short:  map[string]string{"other": "{0} มล.", "per": "{0}/มล."},
+		Narrow: map[string]string{"other": "{0}มล.", "per": "{0}/มล."},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} มิลลิเมตร", "per": "{0}ต่อมิลลิเมตร"},
+		Short:  map[string]string{"other": "{0} มม.", "per": "{0}/มม."},
+		Narrow: map[string]string{"other": "{0}มม.", "per": "{0}/มม."},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} มิลลิวินาที", "per": "{0}ต่อมิลลิวินาที"},
+		Short:  map[string]string{"other": "{0} มิลลิวินาที", "per": "{0}/มิลลิวินาที"},
+		Narrow: map[string]string{"other": "{0} มิลลิวิ", "per": "{0}/มิลลิวิ"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} นาที", "per": "{0} ต่อนาที"},
+		Short:  map[string]string{"other": "{0} นาที", "per": "{0}/นาที"},
+		Narrow: map[string]string{"other": "{0}นาที", "per": "{0}/นาที"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} เดือน", "per": "{0} ต่อเดือน"},
+		Short:  map[string]string{"other": "{0} เดือน", "per": "{0}/เดือน"},
+		Narrow: map[string]string{"other": "{0}เดือน", "per": "{0}/เดือน"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} นาโนวินาที", "per": "{0}ต่อนาโนวินาที"},
+		Short:  map[string]string{"other": "{0} นาโนวิ", "per": "{0}/นาโนวิ"},
+		Narrow: map[string]string{"other": "{0}นาโนวิ", "per": "{0}/นาโนวิ"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} ออนซ์", "per": "{0} ต่อออนซ์"},
+		Short:  map[string]string{"other": "{0} ออนซ์", "per": "{0}/ออนซ์"},
+		Narrow: map[string]string{"other": "{0}ออนซ์", "per": "{0}/ออนซ์"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0}ต่อ{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} เพตะไบต์", "per": "{0}ต่อเพตะไบต์"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0}PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} ปอนด์", "per": "{0} ต่อปอนด์"},
+		Short:  map[string]string{"other": "{0} ปอนด์", "per": "{0}/ปอนด์"},
+		Narrow: map[string]string{"other": "{0}#", "per": "{0}/ปอนด์"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} วินาที", "per": "{0}ต่อวินาที"},
+		Short:  map[string]string{"other": "{0} วิ", "per": "{0}/วิ"},
+		Narrow: map[string]string{"other": "{0}วิ", "per": "{0}/วิ"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} ตารางเซนติเมตร", "per": "{0} ต่อตารางเซนติเมตร"},
+		Short:  map[string]string{"other": "{0} ตร.ซม.", "per": "{0}/ตร.ซม."},
+		Narrow: map[string]string{"other": "{0}ตร.ซม.", "per": "{0}/ตร.ซม."},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} ตารางฟุต"},
+		Short:  map[string]string{"other": "{0} ตร.ฟุต"},
+		Narrow: map[string]string{"other": "{0}ตร.ฟุต"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} ตารางกิโลเมตร", "per": "{0} ต่อตารางกิโลเมตร"},
+		Short:  map[string]string{"other": "{0} ตร.กม.", "per": "{0}/ตร.กม."},
+		Narrow: map[string]string{"other": "{0}ตร.กม.", "per": "{0}/ตร.กม."},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} ตารางเมตร", "per": "{0} ต่อตารางเมตร"},
+		Short:  map[string]string{"other": "{0} ตร.ม.", "per": "{0}/ตร.ม."},
+		Narrow: map[string]string{"other": "{0}ตร.ม.", "per": "{0}/ตร.ม."},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} ตารางไมล์", "per": "{0} ต่อตารางไมล์"},
+		Short:  map[string]string{"other": "{0} ตร.ไมล์", "per": "{0}/ตร.ไมล์"},
+		Narrow: map[string]string{"other": "{0}ตร.ไมล์", "per": "{0}/ตร.ไมล์"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} สโตน", "per": "{0}ต่อสโตน"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} เทราบิต", "per": "{0}ต่อเทราบิต"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0}Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} เทราไบต์", "per": "{0}ต่อเทราไบต์"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0}TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} สัปดาห์", "per": "{0} ต่อสัปดาห์"},
+		Short:  map[string]string{"other": "{0} สัปดาห์", "per": "{0}/สัปดาห์"},
+		Narrow: map[string]string{"other": "{0}สัปดาห์", "per": "{0}/สัปดาห์"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} หลา", "per": "{0}ต่อหลา"},
+		Short:  map[string]string{"other": "{0} หลา", "per": "{0}/หลา"},
+		Narrow: map[string]string{"other": "{0}หลา", "per": "{0}/หลา"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} ปี", "per": "{0} ต่อปี"},
+		Short:  map[string]string{"other": "{0} ปี", "per": "{0}/ปี"},
+		Narrow: map[string]string{"other": "{0}ปี", "per": "{0}/ปี"},
+	},
+}
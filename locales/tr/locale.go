@@ -33,6 +33,11 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Turkish,
 	data: hc.CldrData{
@@ -92,5 +97,88 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 Tn",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "¤#,##0.00",
+			Accounting: "¤#,##0.00;(¤#,##0.00)",
+			Short: map[string]string{
+				"1000-count-one":              "0 B ¤",
+				"1000-count-other":            "0 B ¤",
+				"10000-count-one":             "00 B ¤",
+				"10000-count-other":           "00 B ¤",
+				"100000-count-one":            "000 B ¤",
+				"100000-count-other":          "000 B ¤",
+				"1000000-count-one":           "0 Mn ¤",
+				"1000000-count-other":         "0 Mn ¤",
+				"10000000-count-one":          "00 Mn ¤",
+				"10000000-count-other":        "00 Mn ¤",
+				"100000000-count-one":         "000 Mn ¤",
+				"100000000-count-other":       "000 Mn ¤",
+				"1000000000-count-one":        "0 Mr ¤",
+				"1000000000-count-other":      "0 Mr ¤",
+				"10000000000-count-one":       "00 Mr ¤",
+				"10000000000-count-other":     "00 Mr ¤",
+				"100000000000-count-one":      "000 Mr ¤",
+				"100000000000-count-other":    "000 Mr ¤",
+				"1000000000000-count-one":     "0 Tn ¤",
+				"1000000000000-count-other":   "0 Tn ¤",
+				"10000000000000-count-one":    "00 Tn ¤",
+				"10000000000000-count-other":  "00 Tn ¤",
+				"100000000000000-count-one":   "000 Tn ¤",
+				"100000000000000-count-other": "000 Tn ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"TRY": "₺",
+				"USD": "$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "%#,##0",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
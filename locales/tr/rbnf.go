@@ -0,0 +1,55 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%spellout-cardinal:
+0: sıfır;
+1: bir;
+2: iki;
+3: üç;
+4: dört;
+5: beş;
+6: altı;
+7: yedi;
+8: sekiz;
+9: dokuz;
+10: on;
+11: on >%spellout-cardinal>;
+20: yirmi;
+21: yirmi >%spellout-cardinal>;
+30: otuz;
+31: otuz >%spellout-cardinal>;
+40: kırk;
+41: kırk >%spellout-cardinal>;
+50: elli;
+51: elli >%spellout-cardinal>;
+60: altmış;
+61: altmış >%spellout-cardinal>;
+70: yetmiş;
+71: yetmiş >%spellout-cardinal>;
+80: seksen;
+81: seksen >%spellout-cardinal>;
+90: doksan;
+91: doksan >%spellout-cardinal>;
+100: yüz;
+101: yüz >%spellout-cardinal>;
+200: <%spellout-cardinal< yüz;
+201: <%spellout-cardinal< yüz >%spellout-cardinal>;
+1000: bin;
+1001: bin >%spellout-cardinal>;
+2000: <%spellout-cardinal< bin;
+2001: <%spellout-cardinal< bin >%spellout-cardinal>;
+1000000: <%spellout-cardinal< milyon;
+1000001: <%spellout-cardinal< milyon >%spellout-cardinal>;
+1000000000: <%spellout-cardinal< milyar;
+1000000001: <%spellout-cardinal< milyar >%spellout-cardinal>;
+1000000000000: <%spellout-cardinal< trilyon;
+1000000000001: <%spellout-cardinal< trilyon >%spellout-cardinal>;
+1000000000000000: <%spellout-cardinal< katrilyon;
+1000000000000001: <%spellout-cardinal< katrilyon >%spellout-cardinal>;
+1000000000000000000: =#,##0=;
+-x: eksi >%spellout-cardinal>;
+x.x: <%spellout-cardinal< virgül >%spellout-cardinal>;
+`
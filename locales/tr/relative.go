@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} yıl sonra"},
+			Past:     map[string]string{"other": "{0} yıl önce"},
+			Relative: map[string]string{"-1": "geçen yıl", "0": "bu yıl", "1": "gelecek yıl"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} yıl sonra"},
+			Past:     map[string]string{"other": "{0} yıl önce"},
+			Relative: map[string]string{"-1": "geçen yıl", "0": "bu yıl", "1": "gelecek yıl"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} yıl sonra"},
+			Past:     map[string]string{"other": "{0} yıl önce"},
+			Relative: map[string]string{"-1": "geçen yıl", "0": "bu yıl", "1": "gelecek yıl"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} çeyrek sonra"},
+			Past:     map[string]string{"other": "{0} çeyrek önce"},
+			Relative: map[string]string{"-1": "geçen çeyrek", "0": "bu çeyrek", "1": "gelecek çeyrek"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} çyr. sonra"},
+			Past:     map[string]string{"other": "{0} çyr. önce"},
+			Relative: map[string]string{"-1": "geçen çyr.", "0": "bu çyr.", "1": "gelecek çyr."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} çyr. sonra"},
+			Past:     map[string]string{"other": "{0} çyr. önce"},
+			Relative: map[string]string{"-1": "geçen çyr.", "0": "bu çyr.", "1": "gelecek çyr."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ay sonra"},
+			Past:     map[string]string{"other": "{0} ay önce"},
+			Relative: map[string]string{"-1": "geçen ay", "0": "bu ay", "1": "gelecek ay"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ay sonra"},
+			Past:     map[string]string{"other": "{0} ay önce"},
+			Relative: map[string]string{"-1": "geçen ay", "0": "bu ay", "1": "gelecek ay"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} ay sonra"},
+			Past:     map[string]string{"other": "{0} ay önce"},
+			Relative: map[string]string{"-1": "geçen ay", "0": "bu ay", "1": "gelecek ay"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hafta sonra"},
+			Past:     map[string]string{"other": "{0} hafta önce"},
+			Relative: map[string]string{"-1": "geçen hafta", "0": "bu hafta", "1": "gelecek hafta"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hf. sonra"},
+			Past:     map[string]string{"other": "{0} hf. önce"},
+			Relative: map[string]string{"-1": "geçen hf.", "0": "bu hf.", "1": "gelecek hf."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} hf. sonra"},
+			Past:     map[string]string{"other": "{0} hf. önce"},
+			Relative: map[string]string{"-1": "geçen hf.", "0": "bu hf.", "1": "gelecek hf."},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} gün sonra"},
+			Past:     map[string]string{"other": "{0} gün önce"},
+			Relative: map[string]string{"-2": "evvelsi gün", "-1": "dün", "0": "bugün", "1": "yarın", "2": "öbür gün"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} gün sonra"},
+			Past:     map[string]string{"other": "{0} gün önce"},
+			Relative: map[string]string{"-2": "evvelsi gün", "-1": "dün", "0": "bugün", "1": "yarın", "2": "öbür gün"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} gün sonra"},
+			Past:     map[string]string{"other": "{0} gün önce"},
+			Relative: map[string]string{"-2": "evvelsi gün", "-1": "dün", "0": "bugün", "1": "yarın", "2": "öbür gün"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} saat sonra"},
+			Past:     map[string]string{"other": "{0} saat önce"},
+			Relative: map[string]string{"0": "bu saat"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} sa. sonra"},
+			Past:     map[string]string{"other": "{0} sa. önce"},
+			Relative: map[string]string{"0": "bu saat"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} sa. sonra"},
+			Past:     map[string]string{"other": "{0} sa. önce"},
+			Relative: map[string]string{"0": "bu saat"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} dakika sonra"},
+			Past:     map[string]string{"other": "{0} dakika önce"},
+			Relative: map[string]string{"0": "bu dakika"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} dk. sonra"},
+			Past:     map[string]string{"other": "{0} dk. önce"},
+			Relative: map[string]string{"0": "bu dakika"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} dk. sonra"},
+			Past:     map[string]string{"other": "{0} dk. önce"},
+			Relative: map[string]string{"0": "bu dakika"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} saniye sonra"},
+			Past:     map[string]string{"other": "{0} saniye önce"},
+			Relative: map[string]string{"0": "şimdi"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} sn. sonra"},
+			Past:     map[string]string{"other": "{0} sn. önce"},
+			Relative: map[string]string{"0": "şimdi"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0} sn. sonra"},
+			Past:     map[string]string{"other": "{0} sn. önce"},
+			Relative: map[string]string{"0": "şimdi"},
+		},
+	},
+}
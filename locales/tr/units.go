@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} akre", "per": "{0}/akre"},
+		Short:  map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+		Narrow: map[string]string{"other": "{0} ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} bayt", "per": "{0}/bayt"},
+		Short:  map[string]string{"other": "{0} bayt", "per": "{0}/bayt"},
+		Narrow: map[string]string{"other": "{0} bayt", "per": "{0}/bayt"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} santigrat derece", "per": "{0}/santigrat derece"},
+		Short:  map[string]string{"one": "{0} °C", "other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"one": "{0}°C", "other": "{0} °C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} santimetre", "per": "{0}/santimetre"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} gün", "per": "{0}/gün"},
+		Short:  map[string]string{"other": "{0} gün", "per": "{0}/gün"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} derece", "per": "{0}/derece"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} fahrenhayt derece", "per": "{0}/fahrenhayt derece"},
+		Short:  map[string]string{"one": "{0} °F", "other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} sıvı ons", "per": "{0}/sıvı ons"},
+		Short:  map[string]string{"other": "{0} sıvı ons", "per": "{0}/sıvı ons"},
+		Narrow: map[string]string{"other": "{0} sıvı ons", "per": "{0}/sıvı ons"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} fit", "per": "{0}/fit"},
+		Short:  map[string]string{"other": "{0} fit", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} galon", "per": "{0}/galon"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+		Narrow: map[string]string{"other": "{0} galon", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0}/gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} gigabayt", "per": "{0}/gigabayt"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} gram", "per": "{0}/gram"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} hektar", "per": "{0}/hektar"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} saat", "per": "{0}/saat"},
+		Short:  map[string]string{"other": "{0} sa.", "per": "{0}/sa"},
+		Narrow: map[string]string{"one": "{0} sa", "other": "{0}s", "per": "{0}/sa"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} inç", "per": "{0}/inç"},
+		Short:  map[string]string{"other": "{0} inç", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0}/kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} kilobayt", "per": "{0}/kilobayt"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} kilogram", "per": "{0}/kg"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} kilometre", "per": "{0}/kilometre"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0} km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} kilometre/saat"},
+		Short:  map[string]string{"other": "{0} km/sa"},
+		Narrow: map[string]string{"other": "{0} km/sa"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} litre", "per": "{0}/litre"},
+		Short:  map[string]string{"other": "{0} l", "per": "{0}/l"},
+		Narrow: map[string]string{"other": "{0} l", "per": "{0}/l"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} litre/kilometre"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0}/megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} megabayt", "per": "{0}/megabayt"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} metre", "per": "{0}/metre"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0} m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} metre/saniye"},
+		Short:  map[string]string{"other": "{0} m/sn"},
+		Narrow: map[string]string{"other": "{0} m/sn"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} mikrosaniye", "per": "{0}/mikrosaniye"},
+		Short:  map[string]string{"other": "{0} μsn", "per": "{0}/μsn"},
+		Narrow: map[string]string{"other": "{0} μsn", "per": "{0}/μsn"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} mil", "per": "{0}/mil"},
+		Short:  map[string]string{"other": "{0} mil", "per": "{0}/mil"},
+		Narrow: map[string]string{"other": "{0} mil", "per": "{0}/mil"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} mil/galon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} mil/saat"},
+		Short:  map[string]string{"other": "{0} mil/sa"},
+		Narrow: map[string]string{"other": "{0} mil/sa"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} mililitre", "per": "{0}/mililitre"},
+		Short:  map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+		Narrow: map[string]string{"other": "{0} ml", "per": "{0}/ml"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} milimetre", "per": "{0}/milimetre"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} milisaniye", "per": "{0}/milisaniye"},
+		Short:  map[string]string{"other": "{0} msn", "per": "{0}/msn"},
+		Narrow: map[string]string{"other": "{0}msn", "per": "{0}/msn"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} dakika", "per": "{0}/dakika"},
+		Short:  map[string]string{"other": "{0} dk.", "per": "{0}/dk."},
+		Narrow: map[string]string{"other": "{0}d", "per": "{0}/dk."},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} ay", "per": "{0}/ay"},
+		Short:  map[string]string{"other": "{0} ay", "per": "{0}/ay"},
+		Narrow: map[string]string{"other": "{0}a", "per": "{0}/ay"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} nanosaniye", "per": "{0}/nanosaniye"},
+		Short:  map[string]string{"other": "{0} nsn", "per": "{0}/nsn"},
+		Narrow: map[string]string{"other": "{0} nsn", "per": "{0}/nsn"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} ons", "per": "{0}/oz"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0}/{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} petabayt", "per": "{0}/petabayt"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} libre", "per": "{0}/libre"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} saniye", "per": "{0}/saniye"},
+		Short:  map[string]string{"other": "{0} sn.", "per": "{0}/sn"},
+		Narrow: map[string]string{"other": "{0}sn", "per": "{0}/sn"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} santimetrekare", "per": "{0}/santimetrekare"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} fit kare"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} kilometrekare", "per": "{0}/kilometrekare"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} metrekare", "per": "{0}/metrekare"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} mil kare", "per": "{0}/mil kare"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} stone", "per": "{0}/stone"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0}/terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} terabayt", "per": "{0}/terabayt"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} hafta", "per": "{0}/hafta"},
+		Short:  map[string]string{"other": "{0} hf.", "per": "{0}/hf."},
+		Narrow: map[string]string{"other": "{0}h", "per": "{0}/hf."},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} yarda", "per": "{0}/yarda"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} yıl", "per": "{0}/yıl"},
+		Short:  map[string]string{"other": "{0} yıl", "per": "{0}/y"},
+		Narrow: map[string]string{"other": "{0}y", "per": "{0}/y"},
+	},
+}
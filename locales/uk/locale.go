@@ -51,6 +51,23 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	}
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-few": "n % 10 = 3 and n % 100 != 13"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	i10 := i64 % 10
+	i100 := i64 % 100
+
+	if i10 == 3 && i100 != 13 {
+		return "few"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Ukrainian,
 	data: hc.CldrData{
@@ -135,5 +152,75 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 трлн",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-one":              "0 тис. ¤",
+				"1000-count-other":            "0 тис. ¤",
+				"10000-count-one":             "00 тис. ¤",
+				"10000-count-other":           "00 тис. ¤",
+				"100000-count-one":            "000 тис. ¤",
+				"100000-count-other":          "000 тис. ¤",
+				"1000000-count-one":           "0 млн ¤",
+				"1000000-count-other":         "0 млн ¤",
+				"10000000-count-one":          "00 млн ¤",
+				"10000000-count-other":        "00 млн ¤",
+				"100000000-count-one":         "000 млн ¤",
+				"100000000-count-other":       "000 млн ¤",
+				"1000000000-count-one":        "0 млрд ¤",
+				"1000000000-count-other":      "0 млрд ¤",
+				"10000000000-count-one":       "00 млрд ¤",
+				"10000000000-count-other":     "00 млрд ¤",
+				"100000000000-count-one":      "000 млрд ¤",
+				"100000000000-count-other":    "000 млрд ¤",
+				"1000000000000-count-one":     "0 трлн ¤",
+				"1000000000000-count-other":   "0 трлн ¤",
+				"10000000000000-count-one":    "00 трлн ¤",
+				"10000000000000-count-other":  "00 трлн ¤",
+				"100000000000000-count-one":   "000 трлн ¤",
+				"100000000000000-count-other": "000 трлн ¤",
+			},
+			Symbols: map[string]string{
+				"JPY": "¥",
+				"UAH": "₴",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0} і {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} і {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0} і {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} і {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} і {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0} і {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "{0}."},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}–{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
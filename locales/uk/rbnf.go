@@ -0,0 +1,179 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal-masculine=;
+%spellout-cardinal-masculine:
+0: нуль;
+1: один;
+2: два;
+3: три;
+4: чотири;
+5: пʼять;
+6: шість;
+7: сім;
+8: вісім;
+9: девʼять;
+10: десять;
+11: одинадцять;
+12: дванадцять;
+13: тринадцять;
+14: чотирнадцять;
+15: пʼятнадцять;
+16: шістнадцять;
+17: сімнадцять;
+18: вісімнадцять;
+19: девʼятнадцять;
+20: двадцять;
+21: двадцять >%spellout-cardinal-masculine>;
+30: тридцять;
+31: тридцять >%spellout-cardinal-masculine>;
+40: сорок;
+41: сорок >%spellout-cardinal-masculine>;
+50: пʼятдесят;
+51: пʼятдесят >%spellout-cardinal-masculine>;
+60: шістдесят;
+61: шістдесят >%spellout-cardinal-masculine>;
+70: сімдесят;
+71: сімдесят >%spellout-cardinal-masculine>;
+80: вісімдесят;
+81: вісімдесят >%spellout-cardinal-masculine>;
+90: девʼяносто;
+91: девʼяносто >%spellout-cardinal-masculine>;
+100: сто;
+101: сто >%spellout-cardinal-masculine>;
+200: двісті;
+201: двісті >%spellout-cardinal-masculine>;
+300: триста;
+301: триста >%spellout-cardinal-masculine>;
+400: чотириста;
+401: чотириста >%spellout-cardinal-masculine>;
+500: пʼятсот;
+501: пʼятсот >%spellout-cardinal-masculine>;
+600: шістсот;
+601: шістсот >%spellout-cardinal-masculine>;
+700: сімсот;
+701: сімсот >%spellout-cardinal-masculine>;
+800: вісімсот;
+801: вісімсот >%spellout-cardinal-masculine>;
+900: девʼятсот;
+901: девʼятсот >%spellout-cardinal-masculine>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тисяча}few{тисячі}other{тисяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тисяча}few{тисячі}other{тисяч})$ >%spellout-cardinal-masculine>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{мільйон}few{мільйони}other{мільйонів})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{мільйон}few{мільйони}other{мільйонів})$ >%spellout-cardinal-masculine>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{мільярд}few{мільярди}other{мільярдів})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{мільярд}few{мільярди}other{мільярдів})$ >%spellout-cardinal-masculine>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{більйон}few{більйони}other{більйонів})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{більйон}few{більйони}other{більйонів})$ >%spellout-cardinal-masculine>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{більярд}few{більярди}other{більярдів})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{більярд}few{більярди}other{більярдів})$ >%spellout-cardinal-masculine>;
+1000000000000000000: =#,##0=;
+-x: мінус >%spellout-cardinal-masculine>;
+x.x: <%spellout-cardinal-masculine< кома >%spellout-cardinal-masculine>;
+%spellout-cardinal-neuter:
+0: нуль;
+1: одне;
+2: два;
+3: =%spellout-cardinal-masculine=;
+20: двадцять;
+21: двадцять >%spellout-cardinal-neuter>;
+30: тридцять;
+31: тридцять >%spellout-cardinal-neuter>;
+40: сорок;
+41: сорок >%spellout-cardinal-neuter>;
+50: пʼятдесят;
+51: пʼятдесят >%spellout-cardinal-neuter>;
+60: шістдесят;
+61: шістдесят >%spellout-cardinal-neuter>;
+70: сімдесят;
+71: сімдесят >%spellout-cardinal-neuter>;
+80: вісімдесят;
+81: вісімдесят >%spellout-cardinal-neuter>;
+90: девʼяносто;
+91: девʼяносто >%spellout-cardinal-neuter>;
+100: сто;
+101: сто >%spellout-cardinal-neuter>;
+200: двісті;
+201: двісті >%spellout-cardinal-neuter>;
+300: триста;
+301: триста >%spellout-cardinal-neuter>;
+400: чотириста;
+401: чотириста >%spellout-cardinal-neuter>;
+500: пʼятсот;
+501: пʼятсот >%spellout-cardinal-neuter>;
+600: шістсот;
+601: шістсот >%spellout-cardinal-neuter>;
+700: сімсот;
+701: сімсот >%spellout-cardinal-neuter>;
+800: вісімсот;
+801: вісімсот >%spellout-cardinal-neuter>;
+900: девʼятсот;
+901: девʼятсот >%spellout-cardinal-neuter>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тисяча}few{тисячі}other{тисяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тисяча}few{тисячі}other{тисяч})$ >%spellout-cardinal-neuter>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{мільйон}few{мільйони}other{мільйонів})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{мільйон}few{мільйони}other{мільйонів})$ >%spellout-cardinal-neuter>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{мільярд}few{мільярди}other{мільярдів})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{мільярд}few{мільярди}other{мільярдів})$ >%spellout-cardinal-neuter>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{більйон}few{більйони}other{більйонів})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{більйон}few{більйони}other{більйонів})$ >%spellout-cardinal-neuter>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{більярд}few{більярди}other{більярдів})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{більярд}few{більярди}other{більярдів})$ >%spellout-cardinal-neuter>;
+1000000000000000000: =#,##0=;
+-x: мінус >%spellout-cardinal-neuter>;
+x.x: <%spellout-cardinal-neuter< кома >%spellout-cardinal-neuter>;
+%spellout-cardinal-feminine:
+0: нуль;
+1: одна;
+2: дві;
+3: =%spellout-cardinal-masculine=;
+20: двадцять;
+21: двадцять >%spellout-cardinal-feminine>;
+30: тридцять;
+31: тридцять >%spellout-cardinal-feminine>;
+40: сорок;
+41: сорок >%spellout-cardinal-feminine>;
+50: пʼятдесят;
+51: пʼятдесят >%spellout-cardinal-feminine>;
+60: шістдесят;
+61: шістдесят >%spellout-cardinal-feminine>;
+70: сімдесят;
+71: сімдесят >%spellout-cardinal-feminine>;
+80: вісімдесят;
+81: вісімдесят >%spellout-cardinal-feminine>;
+90: девʼяносто;
+91: девʼяносто >%spellout-cardinal-feminine>;
+100: сто;
+101: сто >%spellout-cardinal-feminine>;
+200: двісті;
+201: двісті >%spellout-cardinal-feminine>;
+300: триста;
+301: триста >%spellout-cardinal-feminine>;
+400: чотириста;
+401: чотириста >%spellout-cardinal-feminine>;
+500: пʼятсот;
+501: пʼятсот >%spellout-cardinal-feminine>;
+600: шістсот;
+601: шістсот >%spellout-cardinal-feminine>;
+700: сімсот;
+701: сімсот >%spellout-cardinal-feminine>;
+800: вісімсот;
+801: вісімсот >%spellout-cardinal-feminine>;
+900: девʼятсот;
+901: девʼятсот >%spellout-cardinal-feminine>;
+1000: <%spellout-cardinal-feminine< $(cardinal,one{тисяча}few{тисячі}other{тисяч})$;
+1001: <%spellout-cardinal-feminine< $(cardinal,one{тисяча}few{тисячі}other{тисяч})$ >%spellout-cardinal-feminine>;
+1000000: <%spellout-cardinal-masculine< $(cardinal,one{мільйон}few{мільйони}other{мільйонів})$;
+1000001: <%spellout-cardinal-masculine< $(cardinal,one{мільйон}few{мільйони}other{мільйонів})$ >%spellout-cardinal-feminine>;
+1000000000: <%spellout-cardinal-masculine< $(cardinal,one{мільярд}few{мільярди}other{мільярдів})$;
+1000000001: <%spellout-cardinal-masculine< $(cardinal,one{мільярд}few{мільярди}other{мільярдів})$ >%spellout-cardinal-feminine>;
+1000000000000: <%spellout-cardinal-masculine< $(cardinal,one{більйон}few{більйони}other{більйонів})$;
+1000000000001: <%spellout-cardinal-masculine< $(cardinal,one{більйон}few{більйони}other{більйонів})$ >%spellout-cardinal-feminine>;
+1000000000000000: <%spellout-cardinal-masculine< $(cardinal,one{більярд}few{більярди}other{більярдів})$;
+1000000000000001: <%spellout-cardinal-masculine< $(cardinal,one{більярд}few{більярди}other{більярдів})$ >%spellout-cardinal-feminine>;
+1000000000000000000: =#,##0=;
+-x: мінус >%spellout-cardinal-feminine>;
+x.x: <%spellout-cardinal-feminine< кома >%spellout-cardinal-feminine>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} рік", "few": "через {0} роки", "many": "через {0} років", "other": "через {0} року"},
+			Past:     map[string]string{"one": "{0} рік тому", "few": "{0} роки тому", "many": "{0} років тому", "other": "{0} року тому"},
+			Relative: map[string]string{"-1": "минулого року", "0": "цього року", "1": "наступного року"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} р."},
+			Past:     map[string]string{"other": "{0} р. тому"},
+			Relative: map[string]string{"-1": "торік", "0": "цьогоріч", "1": "наст. року"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} р."},
+			Past:     map[string]string{"other": "{0} р. тому"},
+			Relative: map[string]string{"-1": "торік", "0": "цього року", "1": "наст. р."},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} квартал", "few": "через {0} квартали", "many": "через {0} кварталів", "other": "через {0} кварталу"},
+			Past:     map[string]string{"one": "{0} квартал тому", "few": "{0} квартали тому", "many": "{0} кварталів тому", "other": "{0} кварталу тому"},
+			Relative: map[string]string{"-1": "минулого кварталу", "0": "цього кварталу", "1": "наступного кварталу"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} кв."},
+			Past:     map[string]string{"other": "{0} кв. тому"},
+			Relative: map[string]string{"-1": "минулого кв.", "0": "цього кв.", "1": "наступного кв."},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} кв."},
+			Past:     map[string]string{"other": "{0} кв. тому"},
+			Relative: map[string]string{"-1": "минулого кв.", "0": "цього кв.", "1": "наступного кв."},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} місяць", "few": "через {0} місяці", "many": "через {0} місяців", "other": "через {0} місяця"},
+			Past:     map[string]string{"one": "{0} місяць тому", "few": "{0} місяці тому", "many": "{0} місяців тому", "other": "{0} місяця тому"},
+			Relative: map[string]string{"-1": "минулого місяця", "0": "цього місяця", "1": "наступного місяця"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} міс."},
+			Past:     map[string]string{"other": "{0} міс. тому"},
+			Relative: map[string]string{"-1": "минулого місяця", "0": "цього місяця", "1": "наступного місяця"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} міс."},
+			Past:     map[string]string{"other": "{0} міс. тому"},
+			Relative: map[string]string{"-1": "мин. міс.", "0": "цього міс.", "1": "наст. міс."},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} тиждень", "few": "через {0} тижні", "many": "через {0} тижнів", "other": "через {0} тижня"},
+			Past:     map[string]string{"one": "{0} тиждень тому", "few": "{0} тижні тому", "many": "{0} тижнів тому", "other": "{0} тижня тому"},
+			Relative: map[string]string{"-1": "минулого тижня", "0": "цього тижня", "1": "наступного тижня"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} тиж."},
+			Past:     map[string]string{"other": "{0} тиж. тому"},
+			Relative: map[string]string{"-1": "мин. тижня", "0": "цього тижня", "1": "наст. тижня"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} тиж."},
+			Past:     map[string]string{"other": "{0} тиж. тому"},
+			Relative: map[string]string{"-1": "минулого тижня", "0": "цього тижня", "1": "наступного тижня"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} день", "few": "через {0} дні", "many": "через {0} днів", "other": "через {0} дня"},
+			Past:     map[string]string{"one": "{0} день тому", "few": "{0} дні тому", "many": "{0} днів тому", "other": "{0} дня тому"},
+			Relative: map[string]string{"-2": "позавчора", "-1": "учора", "0": "сьогодні", "1": "завтра", "2": "післязавтра"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} дн."},
+			Past:     map[string]string{"other": "{0} дн. тому"},
+			Relative: map[string]string{"-2": "позавчора", "-1": "учора", "0": "сьогодні", "1": "завтра", "2": "післязавтра"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} дн."},
+			Past:     map[string]string{"other": "{0} дн. тому"},
+			Relative: map[string]string{"-2": "позавчора", "-1": "учора", "0": "сьогодні", "1": "завтра", "2": "післязавтра"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} годину", "many": "через {0} годин", "other": "через {0} години"},
+			Past:     map[string]string{"one": "{0} годину тому", "many": "{0} годин тому", "other": "{0} години тому"},
+			Relative: map[string]string{"0": "цієї години"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} год"},
+			Past:     map[string]string{"other": "{0} год тому"},
+			Relative: map[string]string{"0": "цієї години"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} год"},
+			Past:     map[string]string{"other": "{0} год тому"},
+			Relative: map[string]string{"0": "цієї години"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} хвилину", "many": "через {0} хвилин", "other": "через {0} хвилини"},
+			Past:     map[string]string{"one": "{0} хвилину тому", "many": "{0} хвилин тому", "other": "{0} хвилини тому"},
+			Relative: map[string]string{"0": "цієї хвилини"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} хв"},
+			Past:     map[string]string{"other": "{0} хв тому"},
+			Relative: map[string]string{"0": "цієї хвилини"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} хв"},
+			Past:     map[string]string{"other": "{0} хв тому"},
+			Relative: map[string]string{"0": "цієї хвилини"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"one": "через {0} секунду", "many": "через {0} секунд", "other": "через {0} секунди"},
+			Past:     map[string]string{"one": "{0} секунду тому", "many": "{0} секунд тому", "other": "{0} секунди тому"},
+			Relative: map[string]string{"0": "зараз"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "через {0} с"},
+			Past:     map[string]string{"other": "{0} с тому"},
+			Relative: map[string]string{"0": "зараз"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "за {0} с"},
+			Past:     map[string]string{"other": "{0} с тому"},
+			Relative: map[string]string{"0": "зараз"},
+		},
+	},
+}
@@ -0,0 +1,321 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"one": "{0} акр", "few": "{0} акри", "many": "{0} акрів", "other": "{0} акра", "per": "{0} на акр"},
+		Short:  map[string]string{"one": "{0} акр", "few": "{0} акри", "many": "{0} акрів", "other": "{0} акра", "per": "{0}/акр"},
+		Narrow: map[string]string{"other": "{0}акр", "per": "{0}/акр"},
+	},
+	"bit": {
+		Long:   map[string]string{"one": "{0} біт", "few": "{0} біти", "many": "{0} бітів", "other": "{0} біта", "per": "{0} на біт"},
+		Short:  map[string]string{"other": "{0} б", "per": "{0}/б"},
+		Narrow: map[string]string{"other": "{0}б", "per": "{0}/б"},
+		Gender: "masculine",
+	},
+	"byte": {
+		Long:   map[string]string{"one": "{0} байт", "few": "{0} байти", "many": "{0} байтів", "other": "{0} байта", "per": "{0} на байт"},
+		Short:  map[string]string{"other": "{0} Б", "per": "{0}/Б"},
+		Narrow: map[string]string{"other": "{0}Б", "per": "{0}/Б"},
+		Gender: "masculine",
+	},
+	"celsius": {
+		Long:   map[string]string{"one": "{0} градус Цельсія", "few": "{0} градуси Цельсія", "many": "{0} градусів Цельсія", "other": "{0} градуса Цельсія", "per": "{0} на градус Цельсія"},
+		Short:  map[string]string{"other": "{0} °C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Gender: "masculine",
+	},
+	"centimeter": {
+		Long:   map[string]string{"one": "{0} сантиметр", "few": "{0} сантиметри", "many": "{0} сантиметрів", "other": "{0} сантиметра", "per": "{0} на сантиметр"},
+		Short:  map[string]string{"other": "{0} см", "per": "{0}/см"},
+		Narrow: map[string]string{"other": "{0}см", "per": "{0}/см"},
+		Gender: "masculine",
+	},
+	"day": {
+		Long:   map[string]string{"one": "{0} день", "few": "{0} дні", "many": "{0} днів", "other": "{0} дня", "per": "{0} на день"},
+		Short:  map[string]string{"other": "{0} дн.", "per": "{0}/дн"},
+		Narrow: map[string]string{"other": "{0}д", "per": "{0}/д"},
+		Gender: "masculine",
+	},
+	"degree": {
+		Long:   map[string]string{"one": "{0} градус", "few": "{0} градуси", "many": "{0} градусів", "other": "{0} градуса", "per": "{0} на градус"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Gender: "masculine",
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"one": "{0} градус Фаренгейта", "few": "{0} градуси Фаренгейта", "many": "{0} градусів Фаренгейта", "other": "{0} градуса Фаренгейта", "per": "{0} на градус Фаренгейта"},
+		Short:  map[string]string{"other": "{0} °F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"one": "{0} рідинна унція", "few": "{0} рідинні унції", "many": "{0} рідинних унцій", "other": "{0} рідинної унції", "per": "{0} на рідинна унція"},
+		Short:  map[string]string{"one": "{0} рід. унція", "many": "{0} рід. унцій", "other": "{0} рід. унції", "per": "{0}/рід. унція"},
+		Narrow: map[string]string{"other": "{0}рід.ун.", "per": "{0}/рід.ун."},
+	},
+	"foot": {
+		Long:   map[string]string{"one": "{0} фут", "few": "{0} фути", "many": "{0} футів", "other": "{0} фута", "per": "{0} на фут"},
+		Short:  map[string]string{"other": "{0} фт", "per": "{0}/фт"},
+		Narrow: map[string]string{"other": "{0}фт", "per": "{0}/фт"},
+	},
+	"gallon": {
+		Long:   map[string]string{"one": "{0} галон", "few": "{0} галони", "many": "{0} галонів", "other": "{0} галона", "per": "{0} на галон"},
+		Short:  map[string]string{"other": "{0} гал.", "per": "{0}/гал"},
+		Narrow: map[string]string{"other": "{0}гал.", "per": "{0}/гал"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"one": "{0} гігабіт", "few": "{0} гігабіти", "many": "{0} гігабітів", "other": "{0} гігабіта", "per": "{0} на гігабіт"},
+		Short:  map[string]string{"other": "{0} Гб", "per": "{0}/Гб"},
+		Narrow: map[string]string{"other": "{0}Гб", "per": "{0}/Гб"},
+		Gender: "masculine",
+	},
+	"gigabyte": {
+		Long:   map[string]string{"one": "{0} гігабайт", "few": "{0} гігабайти", "many": "{0} гігабайтів", "other": "{0} гігабайта", "per": "{0} на гігабайт"},
+		Short:  map[string]string{"other": "{0} ГБ", "per": "{0}/ГБ"},
+		Narrow: map[string]string{"other": "{0}ГБ", "per": "{0}/ГБ"},
+		Gender: "masculine",
+	},
+	"gram": {
+		Long:   map[string]string{"one": "{0} грам", "few": "{0} грами", "many": "{0} грамів", "other": "{0} грама", "per": "{0} на грам"},
+		Short:  map[string]string{"other": "{0} г", "per": "{0}/г"},
+		Narrow: map[string]string{"other": "{0}г", "per": "{0}/г"},
+		Gender: "masculine",
+	},
+	"hectare": {
+		Long:   map[string]string{"one": "{0} гектар", "few": "{0} гектари", "many": "{0} гектарів", "other": "{0} гектара", "per": "{0} на гектар"},
+		Short:  map[string]string{"other": "{0} га", "per": "{0}/га"},
+		Narrow: map[string]string{"other": "{0}га", "per": "{0}/га"},
+		Gender: "masculine",
+	},
+	"hour": {
+		Long:   map[string]string{"one": "{0} година", "many": "{0} годин", "other": "{0} години", "per": "{0} на годину"},
+		Short:  map[string]string{"other": "{0} год", "per": "{0}/год"},
+		Narrow: map[string]string{"other": "{0}г", "per": "{0}/г"},
+		Gender: "feminine",
+	},
+	"inch": {
+		Long:   map[string]string{"one": "{0} дюйм", "few": "{0} дюйми", "many": "{0} дюймів", "other": "{0} дюйма", "per": "{0} на дюйм"},
+		Short:  map[string]string{"one": "{0} дюйм", "few": "{0} дюйми", "many": "{0} дюймів", "other": "{0} дюйма", "per": "{0}/дюйм"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/″"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"one": "{0} кілобіт", "few": "{0} кілобіти", "many": "{0} кілобітів", "other": "{0} кілобіта", "per": "{0} на кілобіт"},
+		Short:  map[string]string{"other": "{0} кб", "per": "{0}/кб"},
+		Narrow: map[string]string{"other": "{0}кб", "per": "{0}/кб"},
+		Gender: "masculine",
+	},
+	"kilobyte": {
+		Long:   map[string]string{"one": "{0} кілобайт", "few": "{0} кілобайти", "many": "{0} кілобайтів", "other": "{0} кілобайта", "per": "{0} на кілобайт"},
+		Short:  map[string]string{"other": "{0} кБ", "per": "{0}/кБ"},
+		Narrow: map[string]string{"other": "{0}кБ", "per": "{0}/кБ"},
+		Gender: "masculine",
+	},
+	"kilogram": {
+		Long:   map[string]string{"one": "{0} кілограм", "few": "{0} кілограми", "many": "{0} кілограмів", "other": "{0} кілограма", "per": "{0} на кілограм"},
+		Short:  map[string]string{"other": "{0} кг", "per": "{0}/кг"},
+		Narrow: map[string]string{"other": "{0}кг", "per": "{0}/кг"},
+		Gender: "masculine",
+	},
+	"kilometer": {
+		Long:   map[string]string{"one": "{0} кілометр", "few": "{0} кілометри", "many": "{0} кілометрів", "other": "{0} кілометра", "per": "{0} на кілометр"},
+		Short:  map[string]string{"other": "{0} км", "per": "{0}/км"},
+		Narrow: map[string]string{"other": "{0}км", "per": "{0}/км"},
+		Gender: "masculine",
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"one": "{0} кілометр за годину", "few": "{0} кілометри за годину", "many": "{0} кілометрів за годину", "other": "{0} кілометра за годину"},
+		Short:  map[string]string{"other": "{0} км/год"},
+		Narrow: map[string]string{"other": "{0}км/год"},
+		Gender: "masculine",
+	},
+	"liter": {
+		Long:   map[string]string{"one": "{0} літр", "few": "{0} літри", "many": "{0} літрів", "other": "{0} літра", "per": "{0} на літр"},
+		Short:  map[string]string{"other": "{0} л", "per": "{0}/л"},
+		Narrow: map[string]string{"other": "{0}л", "per": "{0}/л"},
+		Gender: "masculine",
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"one": "{0} літр на кілометр", "few": "{0} літри на кілометр", "many": "{0} літрів на кілометр", "other": "{0} літра на кілометр"},
+		Short:  map[string]string{"other": "{0} л/км"},
+		Narrow: map[string]string{"other": "{0}л/км"},
+		Gender: "masculine",
+	},
+	"megabit": {
+		Long:   map[string]string{"one": "{0} мегабіт", "few": "{0} мегабіти", "many": "{0} мегабітів", "other": "{0} мегабіта", "per": "{0} на мегабіт"},
+		Short:  map[string]string{"other": "{0} Мб", "per": "{0}/Мб"},
+		Narrow: map[string]string{"other": "{0}Мб", "per": "{0}/Мб"},
+		Gender: "masculine",
+	},
+	"megabyte": {
+		Long:   map[string]string{"one": "{0} мегабайт", "few": "{0} мегабайти", "many": "{0} мегабайтів", "other": "{0} мегабайта", "per": "{0} на мегабайт"},
+		Short:  map[string]string{"other": "{0} МБ", "per": "{0}/МБ"},
+		Narrow: map[string]string{"other": "{0}МБ", "per": "{0}/МБ"},
+		Gender: "masculine",
+	},
+	"meter": {
+		Long:   map[string]string{"one": "{0} метр", "few": "{0} метри", "many": "{0} метрів", "other": "{0} метра", "per": "{0} на метр"},
+		Short:  map[string]string{"other": "{0} м", "per": "{0}/м"},
+		Narrow: map[string]string{"other": "{0}м", "per": "{0}/м"},
+		Gender: "masculine",
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"one": "{0} метр за секунду", "few": "{0} метри за секунду", "many": "{0} метрів за секунду", "other": "{0} метра за секунду"},
+		Short:  map[string]string{"other": "{0} м/с"},
+		Narrow: map[string]string{"other": "{0}м/с"},
+		Gender: "masculine",
+	},
+	"microsecond": {
+		Long:   map[string]string{"one": "{0} мікросекунда", "many": "{0} мікросекунд", "other": "{0} мікросекунди", "per": "{0} на мікросекунда"},
+		Short:  map[string]string{"other": "{0} мкс", "per": "{0}/мкс"},
+		Narrow: map[string]string{"other": "{0}мкс", "per": "{0}/мкс"},
+		Gender: "feminine",
+	},
+	"mile": {
+		Long:   map[string]string{"one": "{0} миля", "many": "{0} миль", "other": "{0} милі", "per": "{0} на миля"},
+		Short:  map[string]string{"one": "{0} миля", "many": "{0} миль", "other": "{0} милі", "per": "{0}/миля"},
+		Narrow: map[string]string{"other": "{0}мл", "per": "{0}/мл"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"one": "{0} миля на галон", "many": "{0} миль на галон", "other": "{0} милі на галон"},
+		Short:  map[string]string{"one": "{0} миля/гал", "many": "{0} миль/гал", "other": "{0} милі/гал"},
+		Narrow: map[string]string{"other": "{0}мл/гал"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"one": "{0} миля за годину", "many": "{0} миль за годину", "other": "{0} милі за годину"},
+		Short:  map[string]string{"one": "{0} миля/год", "many": "{0} миль/год", "other": "{0} милі/год"},
+		Narrow: map[string]string{"other": "{0}мл/год"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"one": "{0} скандинавська миля", "few": "{0} скандинавські милі", "many": "{0} скандинавських миль", "other": "{0} скандинавської милі", "per": "{0} на скандинавська миля"},
+		Short:  map[string]string{"one": "{0} сканд. миля", "many": "{0} сканд. миль", "other": "{0} сканд. милі", "per": "{0}/сканд. миля"},
+		Narrow: map[string]string{"other": "{0}ск.мл", "per": "{0}/ск.мл"},
+		Gender: "feminine",
+	},
+	"milliliter": {
+		Long:   map[string]string{"one": "{0} мілілітр", "few": "{0} мілілітри", "many": "{0} мілілітрів", "other": "{0} мілілітра", "per": "{0} на мілілітр"},
+		Short:  map[string]string{"other": "{0} мл", "per": "{0}/мл"},
+		Narrow: map[string]string{"other": "{0}мл", "per": "{0}/мл"},
+		Gender: "masculine",
+	},
+	"millimeter": {
+		Long:   map[string]string{"one": "{0} міліметр", "few": "{0} міліметри", "many": "{0} міліметрів", "other": "{0} міліметра", "per": "{0} на міліметр"},
+		Short:  map[string]string{"other": "{0} мм", "per": "{0}/мм"},
+		Narrow: map[string]string{"other": "{0}мм", "per": "{0}/мм"},
+		Gender: "masculine",
+	},
+	"millisecond": {
+		Long:   map[string]string{"one": "{0} мілісекунда", "many": "{0} мілісекунд", "other": "{0} мілісекунди", "per": "{0} на мілісекунда"},
+		Short:  map[string]string{"other": "{0} мс", "per": "{0}/мс"},
+		Narrow: map[string]string{"other": "{0}мс", "per": "{0}/мс"},
+		Gender: "feminine",
+	},
+	"minute": {
+		Long:   map[string]string{"one": "{0} хвилина", "many": "{0} хвилин", "other": "{0} хвилини", "per": "{0} на хвилину"},
+		Short:  map[string]string{"other": "{0} хв", "per": "{0}/хв"},
+		Narrow: map[string]string{"other": "{0}х", "per": "{0}/х"},
+		Gender: "feminine",
+	},
+	"month": {
+		Long:   map[string]string{"one": "{0} місяць", "few": "{0} місяці", "many": "{0} місяців", "other": "{0} місяця", "per": "{0} на місяць"},
+		Short:  map[string]string{"other": "{0} міс.", "per": "{0}/міс"},
+		Narrow: map[string]string{"other": "{0}м", "per": "{0}/м"},
+		Gender: "masculine",
+	},
+	"nanosecond": {
+		Long:   map[string]string{"one": "{0} наносекунда", "many": "{0} наносекунд", "other": "{0} наносекунди", "per": "{0} на наносекунда"},
+		Short:  map[string]string{"other": "{0} нс", "per": "{0}/нс"},
+		Narrow: map[string]string{"other": "{0}нс", "per": "{0}/нс"},
+		Gender: "feminine",
+	},
+	"ounce": {
+		Long:   map[string]string{"one": "{0} унція", "many": "{0} унцій", "other": "{0} унції", "per": "{0} на унцію"},
+		Short:  map[string]string{"other": "{0} унц.", "per": "{0}/унц"},
+		Narrow: map[string]string{"other": "{0}ун.", "per": "{0}/ун"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0} на {1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"one": "{0} петабайт", "few": "{0} петабайти", "many": "{0} петабайтів", "other": "{0} петабайта", "per": "{0} на петабайт"},
+		Short:  map[string]string{"other": "{0} ПБ", "per": "{0}/ПБ"},
+		Narrow: map[string]string{"other": "{0}ПБ", "per": "{0}/ПБ"},
+		Gender: "masculine",
+	},
+	"pound": {
+		Long:   map[string]string{"one": "{0} фунт", "few": "{0} фунти", "many": "{0} фунтів", "other": "{0} фунта", "per": "{0} на фунт"},
+		Short:  map[string]string{"other": "{0} фнт", "per": "{0}/фунт"},
+		Narrow: map[string]string{"other": "{0}фнт", "per": "{0}/фнт"},
+	},
+	"second": {
+		Long:   map[string]string{"one": "{0} секунда", "many": "{0} секунд", "other": "{0} секунди", "per": "{0} за секунду"},
+		Short:  map[string]string{"other": "{0} с", "per": "{0}/с"},
+		Narrow: map[string]string{"other": "{0}с", "per": "{0}/с"},
+		Gender: "feminine",
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"one": "{0} квадратний сантиметр", "few": "{0} квадратні сантиметри", "many": "{0} квадратних сантиметрів", "other": "{0} квадратного сантиметра", "per": "{0} на квадратний сантиметр"},
+		Short:  map[string]string{"other": "{0} см²", "per": "{0}/см²"},
+		Narrow: map[string]string{"other": "{0} см²", "per": "{0}/см²"},
+		Gender: "masculine",
+	},
+	"square-foot": {
+		Long:   map[string]string{"one": "{0} квадратний фут", "few": "{0} квадратні фути", "many": "{0} квадратних футів", "other": "{0} квадратного фута"},
+		Short:  map[string]string{"one": "{0} фут²", "few": "{0} фути²", "many": "{0} футів²", "other": "{0} фута²"},
+		Narrow: map[string]string{"other": "{0} фт²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"one": "{0} квадратний кілометр", "few": "{0} квадратні кілометри", "many": "{0} квадратних кілометрів", "other": "{0} квадратного кілометра", "per": "{0} на квадратний кілометр"},
+		Short:  map[string]string{"other": "{0} км²", "per": "{0}/км²"},
+		Narrow: map[string]string{"other": "{0} км²", "per": "{0}/км²"},
+		Gender: "masculine",
+	},
+	"square-meter": {
+		Long:   map[string]string{"one": "{0} квадратний метр", "few": "{0} квадратні метри", "many": "{0} квадратних метрів", "other": "{0} квадратного метра", "per": "{0} на квадратний метр"},
+		Short:  map[string]string{"other": "{0} м²", "per": "{0}/м²"},
+		Narrow: map[string]string{"other": "{0} м²", "per": "{0}/м²"},
+		Gender: "masculine",
+	},
+	"square-mile": {
+		Long:   map[string]string{"one": "{0} квадратна миля", "few": "{0} квадратні милі", "many": "{0} квадратних миль", "other": "{0} квадратної милі", "per": "{0} на квадратну милю"},
+		Short:  map[string]string{"one": "{0} миля²", "many": "{0} миль²", "other": "{0} милі²", "per": "{0}/милю²"},
+		Narrow: map[string]string{"other": "{0} мл²", "per": "{0}/мл²"},
+	},
+	"stone": {
+		Long:   map[string]string{"one": "{0} стоун", "few": "{0} стоуни", "many": "{0} стоунів", "other": "{0} стоуна", "per": "{0} на стоун"},
+		Short:  map[string]string{"other": "{0} стн", "per": "{0}/стн"},
+		Narrow: map[string]string{"other": "{0}стн", "per": "{0}/стн"},
+	},
+	"terabit": {
+		Long:   map[string]string{"one": "{0} терабіт", "few": "{0} терабіти", "many": "{0} терабітів", "other": "{0} терабіта", "per": "{0} на терабіт"},
+		Short:  map[string]string{"other": "{0} Тб", "per": "{0}/Тб"},
+		Narrow: map[string]string{"other": "{0}Тб", "per": "{0}/Тб"},
+		Gender: "masculine",
+	},
+	"terabyte": {
+		Long:   map[string]string{"one": "{0} терабайт", "few": "{0} терабайти", "many": "{0} терабайтів", "other": "{0} терабайта", "per": "{0} на терабайт"},
+		Short:  map[string]string{"other": "{0} ТБ", "per": "{0}/ТБ"},
+		Narrow: map[string]string{"other": "{0}ТБ", "per": "{0}/ТБ"},
+		Gender: "masculine",
+	},
+	"week": {
+		Long:   map[string]string{"one": "{0} тиждень", "few": "{0} тижні", "many": "{0} тижнів", "other": "{0} тижня", "per": "{0} на тиждень"},
+		Short:  map[string]string{"other": "{0} тиж.", "per": "{0}/тиж"},
+		Narrow: map[string]string{"other": "{0}т", "per": "{0}/т"},
+		Gender: "masculine",
+	},
+	"yard": {
+		Long:   map[string]string{"one": "{0} ярд", "few": "{0} ярди", "many": "{0} ярдів", "other": "{0} ярда", "per": "{0} на ярд"},
+		Short:  map[string]string{"one": "{0} ярд", "few": "{0} ярди", "many": "{0} ярдів", "other": "{0} ярда", "per": "{0}/ярд"},
+		Narrow: map[string]string{"other": "{0}ярд", "per": "{0}/ярд"},
+	},
+	"year": {
+		Long:   map[string]string{"one": "{0} рік", "few": "{0} роки", "many": "{0} років", "other": "{0} року", "per": "{0} на рік"},
+		Short:  map[string]string{"other": "{0} р.", "per": "{0}/р."},
+		Narrow: map[string]string{"other": "{0}р", "per": "{0}/р"},
+		Gender: "masculine",
+	},
+}
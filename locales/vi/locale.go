@@ -24,6 +24,20 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules:
+// "pluralRule-count-one": "n = 1"
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	i64, _, ok := n.Int64(0)
+	if !ok {
+		return "other"
+	}
+
+	if i64 == 1 {
+		return "one"
+	}
+	return "other"
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Vietnamese,
 	data: hc.CldrData{
@@ -59,5 +73,75 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000 NT",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "#,##0.00 ¤",
+			Accounting: "#,##0.00 ¤",
+			Short: map[string]string{
+				"1000-count-other":            "0 N ¤",
+				"10000-count-other":           "00 N ¤",
+				"100000-count-other":          "000 N ¤",
+				"1000000-count-other":         "0 Tr ¤",
+				"10000000-count-other":        "00 Tr ¤",
+				"100000000-count-other":       "000 Tr ¤",
+				"1000000000-count-other":      "0 T ¤",
+				"10000000000-count-other":     "00 T ¤",
+				"100000000000-count-other":    "000 T ¤",
+				"1000000000000-count-other":   "0 NT ¤",
+				"10000000000000-count-other":  "00 NT ¤",
+				"100000000000000-count-other": "000 NT ¤",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "CN¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"THB": "฿",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}, {1}",
+				Start:  "{0}, {1}",
+				Middle: "{0}, {1}",
+				End:    "{0}, {1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0} {1}",
+				Start:  "{0} {1}",
+				Middle: "{0} {1}",
+				End:    "{0} {1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "thứ {0}"},
+		RBNF:         rbnf,
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}-{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
@@ -0,0 +1,49 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets.
+var rbnf = `%spellout-numbering:
+0: =%spellout-cardinal=;
+%%teen:
+0: =%spellout-cardinal=;
+5: lăm;
+6: =%spellout-cardinal=;
+%%x-ty:
+0: =%spellout-cardinal=;
+1: mốt;
+2: =%%teen=;
+4: tư;
+5: =%%teen=;
+%%after-hundred:
+0: lẻ =%spellout-cardinal=;
+10: =%spellout-cardinal=;
+%%after-thousand-or-more:
+0: không trăm =%%after-hundred=;
+100: =%spellout-cardinal=;
+%spellout-cardinal:
+0: không;
+1: một;
+2: hai;
+3: ba;
+4: bốn;
+5: năm;
+6: sáu;
+7: bảy;
+8: tám;
+9: chín;
+10: mười;
+11: mười >%%teen>;
+20: <%spellout-cardinal< mươi;
+21: <%spellout-cardinal< mươi >%%x-ty>;
+100: <%spellout-cardinal< trăm;
+101: <%spellout-cardinal< trăm >%%after-hundred>;
+1000: <%spellout-cardinal< nghìn;
+1001: <%spellout-cardinal< nghìn >%%after-thousand-or-more>;
+1000000: <%spellout-cardinal< triệu;
+1000001: <%spellout-cardinal< triệu >%%after-hundred>;
+1000000000: <%spellout-cardinal< tỷ;
+1000000001: <%spellout-cardinal< tỷ >%%after-hundred>;
+1000000000000000000: =#,##0=;
+-x: âm >%spellout-cardinal>;
+x.x: <%spellout-cardinal< phẩy >%spellout-cardinal>;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} năm nữa"},
+			Past:     map[string]string{"other": "{0} năm trước"},
+			Relative: map[string]string{"-1": "năm ngoái", "0": "năm nay", "1": "năm sau"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} năm nữa"},
+			Past:     map[string]string{"other": "{0} năm trước"},
+			Relative: map[string]string{"-1": "năm ngoái", "0": "năm nay", "1": "năm sau"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} năm nữa"},
+			Past:     map[string]string{"other": "{0} năm trước"},
+			Relative: map[string]string{"-1": "năm ngoái", "0": "năm nay", "1": "năm sau"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} quý nữa"},
+			Past:     map[string]string{"other": "{0} quý trước"},
+			Relative: map[string]string{"-1": "quý trước", "0": "quý này", "1": "quý sau"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} quý nữa"},
+			Past:     map[string]string{"other": "{0} quý trước"},
+			Relative: map[string]string{"-1": "quý trước", "0": "quý này", "1": "quý sau"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} quý nữa"},
+			Past:     map[string]string{"other": "{0} quý trước"},
+			Relative: map[string]string{"-1": "quý trước", "0": "quý này", "1": "quý sau"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} tháng nữa"},
+			Past:     map[string]string{"other": "{0} tháng trước"},
+			Relative: map[string]string{"-1": "tháng trước", "0": "tháng này", "1": "tháng sau"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} tháng nữa"},
+			Past:     map[string]string{"other": "{0} tháng trước"},
+			Relative: map[string]string{"-1": "tháng trước", "0": "tháng này", "1": "tháng sau"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} tháng nữa"},
+			Past:     map[string]string{"other": "{0} tháng trước"},
+			Relative: map[string]string{"-1": "tháng trước", "0": "tháng này", "1": "tháng sau"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} tuần nữa"},
+			Past:     map[string]string{"other": "{0} tuần trước"},
+			Relative: map[string]string{"-1": "tuần trước", "0": "tuần này", "1": "tuần sau"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} tuần nữa"},
+			Past:     map[string]string{"other": "{0} tuần trước"},
+			Relative: map[string]string{"-1": "tuần trước", "0": "tuần này", "1": "tuần sau"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} tuần nữa"},
+			Past:     map[string]string{"other": "{0} tuần trước"},
+			Relative: map[string]string{"-1": "tuần trước", "0": "tuần này", "1": "tuần sau"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} ngày nữa"},
+			Past:     map[string]string{"other": "{0} ngày trước"},
+			Relative: map[string]string{"-2": "Hôm kia", "-1": "Hôm qua", "0": "Hôm nay", "1": "Ngày mai", "2": "Ngày kia"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} ngày nữa"},
+			Past:     map[string]string{"other": "{0} ngày trước"},
+			Relative: map[string]string{"-2": "Hôm kia", "-1": "hôm qua", "0": "hôm nay", "1": "ngày mai", "2": "Ngày kia"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} ngày nữa"},
+			Past:     map[string]string{"other": "{0} ngày trước"},
+			Relative: map[string]string{"-2": "Hôm kia", "-1": "hôm qua", "0": "hôm nay", "1": "ngày mai", "2": "Ngày kia"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} giờ nữa"},
+			Past:     map[string]string{"other": "{0} giờ trước"},
+			Relative: map[string]string{"0": "giờ này"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} giờ nữa"},
+			Past:     map[string]string{"other": "{0} giờ trước"},
+			Relative: map[string]string{"0": "giờ này"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} giờ nữa"},
+			Past:     map[string]string{"other": "{0} giờ trước"},
+			Relative: map[string]string{"0": "giờ này"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} phút nữa"},
+			Past:     map[string]string{"other": "{0} phút trước"},
+			Relative: map[string]string{"0": "phút này"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} phút nữa"},
+			Past:     map[string]string{"other": "{0} phút trước"},
+			Relative: map[string]string{"0": "phút này"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} phút nữa"},
+			Past:     map[string]string{"other": "{0} phút trước"},
+			Relative: map[string]string{"0": "phút này"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} giây nữa"},
+			Past:     map[string]string{"other": "{0} giây trước"},
+			Relative: map[string]string{"0": "bây giờ"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} giây nữa"},
+			Past:     map[string]string{"other": "{0} giây trước"},
+			Relative: map[string]string{"0": "bây giờ"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "sau {0} giây nữa"},
+			Past:     map[string]string{"other": "{0} giây trước"},
+			Relative: map[string]string{"0": "bây giờ"},
+		},
+	},
+}
@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0} mẫu", "per": "{0}/mẫu"},
+		Short:  map[string]string{"other": "{0} mẫu", "per": "{0}/mẫu"},
+		Narrow: map[string]string{"other": "{0} mẫu", "per": "{0}/mẫu"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Short:  map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+		Narrow: map[string]string{"other": "{0} bit", "per": "{0}/bit"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0} byte", "per": "{0}/byte"},
+		Short:  map[string]string{"other": "{0} byte", "per": "{0}/byte"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0} độ C", "per": "{0}/độ C"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0} xentimét", "per": "{0}/cm"},
+		Short:  map[string]string{"other": "{0} cm", "per": "{0}/cm"},
+		Narrow: map[string]string{"other": "{0}cm", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0} ngày", "per": "{0}/ngày"},
+		Short:  map[string]string{"other": "{0} ngày", "per": "{0}/ngày"},
+		Narrow: map[string]string{"other": "{0} ngày", "per": "{0}/ngày"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0} độ", "per": "{0}/độ"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0} độ F", "per": "{0}/độ F"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0} aoxơ chất lỏng", "per": "{0}/aoxơ chất lỏng"},
+		Short:  map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+		Narrow: map[string]string{"other": "{0} fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0} feet", "per": "{0}/ft"},
+		Short:  map[string]string{"other": "{0} ft", "per": "{0}/ft"},
+		Narrow: map[string]string{"other": "{0}'", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0} gallon", "per": "{0}/gal"},
+		Short:  map[string]string{"other": "{0} gal", "per": "{0}/gal Mỹ"},
+		Narrow: map[string]string{"other": "{0} gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0} gigabit", "per": "{0}/gigabit"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0} gigabyte", "per": "{0}/gigabyte"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0} gam", "per": "{0}/gam"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0} g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0} héc-ta", "per": "{0}/héc-ta"},
+		Short:  map[string]string{"other": "{0} ha", "per": "{0}/ha"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0} giờ", "per": "{0}/giờ"},
+		Short:  map[string]string{"other": "{0} giờ", "per": "{0}/giờ"},
+		Narrow: map[string]string{"other": "{0} giờ", "per": "{0}/giờ"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0} inch", "per": "{0}/inch"},
+		Short:  map[string]string{"other": "{0} inch", "per": "{0}/in"},
+		Narrow: map[string]string{"other": "{0}\"", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0} kilobit", "per": "{0}/kilobit"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0} kilobyte", "per": "{0}/kilobyte"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0} kilôgam", "per": "{0}/kg"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0} kilômét", "per": "{0}/km"},
+		Short:  map[string]string{"other": "{0} km", "per": "{0}/km"},
+		Narrow: map[string]string{"other": "{0}km", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "{0} kilômét/giờ"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0} km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0} lít", "per": "{0}/l"},
+		Short:  map[string]string{"other": "{0} L", "per": "{0}/L"},
+		Narrow: map[string]string{"other": "{0}L", "per": "{0}/l"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "{0} lít/km"},
+		Short:  map[string]string{"other": "{0} l/km"},
+		Narrow: map[string]string{"other": "{0} l/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0} megabit", "per": "{0}/megabit"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0} megabyte", "per": "{0}/megabyte"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0} mét", "per": "{0}/m"},
+		Short:  map[string]string{"other": "{0} m", "per": "{0}/m"},
+		Narrow: map[string]string{"other": "{0}m", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "{0} mét/giây"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0} m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0} micrô giây", "per": "{0}/micrô giây"},
+		Short:  map[string]string{"other": "{0} micrô giây", "per": "{0}/micrô giây"},
+		Narrow: map[string]string{"other": "{0} micrô giây", "per": "{0}/micrô giây"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0} dặm", "per": "{0}/dặm"},
+		Short:  map[string]string{"other": "{0} dặm", "per": "{0}/dặm"},
+		Narrow: map[string]string{"other": "{0} dặm", "per": "{0}/dặm"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "{0} dặm/gallon"},
+		Short:  map[string]string{"other": "{0} mpg"},
+		Narrow: map[string]string{"other": "{0} mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "{0} dặm/giờ"},
+		Short:  map[string]string{"other": "{0} mph"},
+		Narrow: map[string]string{"other": "{0} mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0} dặm scandinavia", "per": "{0}/dặm scandinavia"},
+		Short:  map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+		Narrow: map[string]string{"other": "{0} smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0} mililít", "per": "{0}/mililít"},
+		Short:  map[string]string{"other": "{0} mL", "per": "{0}/mL"},
+		Narrow: map[string]string{"other": "{0} mL", "per": "{0}/mL"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0} milimét", "per": "{0}/milimét"},
+		Short:  map[string]string{"other": "{0} mm", "per": "{0}/mm"},
+		Narrow: map[string]string{"other": "{0}mm", "per": "{0}/mm"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0} mili giây", "per": "{0}/mili giây"},
+		Short:  map[string]string{"other": "{0} mili giây", "per": "{0}/mili giây"},
+		Narrow: map[string]string{"other": "{0}miligiây", "per": "{0}/miligiây"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0} phút", "per": "{0}/phút"},
+		Short:  map[string]string{"other": "{0} phút", "per": "{0}/phút"},
+		Narrow: map[string]string{"other": "{0} phút", "per": "{0}/phút"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0} tháng", "per": "{0}/tháng"},
+		Short:  map[string]string{"other": "{0} tháng", "per": "{0}/tháng"},
+		Narrow: map[string]string{"other": "{0} tháng", "per": "{0}/tháng"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0} nano giây", "per": "{0}/nano giây"},
+		Short:  map[string]string{"other": "{0} nano giây", "per": "{0}/nano giây"},
+		Narrow: map[string]string{"other": "{0} nano giây", "per": "{0}/nano giây"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0} aoxơ", "per": "{0}/aoxơ"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0}/{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0} petabyte", "per": "{0}/petabyte"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0} pao", "per": "{0}/lb"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}lb", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0} giây", "per": "{0}/giây"},
+		Short:  map[string]string{"other": "{0} giây", "per": "{0}/s"},
+		Narrow: map[string]string{"other": "{0} giây"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0} xentimét vuông"},
+		Short:  map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+		Narrow: map[string]string{"other": "{0} cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0} feet vuông"},
+		Short:  map[string]string{"other": "{0} ft²"},
+		Narrow: map[string]string{"other": "{0} ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0} kilômét vuông"},
+		Short:  map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+		Narrow: map[string]string{"other": "{0} km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0} mét vuông"},
+		Short:  map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+		Narrow: map[string]string{"other": "{0} m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0} dặm vuông"},
+		Short:  map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+		Narrow: map[string]string{"other": "{0} mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0} st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0} terabit", "per": "{0}/terabit"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0} terabyte", "per": "{0}/terabyte"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0} tuần", "per": "{0}/tuần"},
+		Short:  map[string]string{"other": "{0} tuần", "per": "{0}/tuần"},
+		Narrow: map[string]string{"other": "{0} tuần", "per": "{0}/tuần"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0} yard", "per": "{0}/yard"},
+		Short:  map[string]string{"other": "{0} yd", "per": "{0}/yd"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0} năm", "per": "{0}/năm"},
+		Short:  map[string]string{"other": "{0} năm", "per": "{0}/năm"},
+		Narrow: map[string]string{"other": "{0} năm", "per": "{0}/năm"},
+	},
+}
@@ -5,6 +5,7 @@ import (
 
 	hc "github.com/dejurin/humanizecompact"
 	locale "github.com/dejurin/humanizecompact/locales/zh"
+	"github.com/govalues/decimal"
 	"golang.org/x/text/language"
 )
 
@@ -83,3 +84,69 @@ func TestHumanizeZhOptionShort(t *testing.T) {
 		}
 	}
 }
+
+// TestHumanizeZhPrecisionPolicy checks that exact compact numbers keep two
+// fraction digits, with and without a region subtag.
+func TestHumanizeZhPrecisionPolicy(t *testing.T) {
+	tests := []struct {
+		tag      language.Tag
+		number   string
+		expected string
+	}{
+		{language.Chinese, "12500", "1.25万"},
+		{language.Chinese, "12345", "12345"},
+		{language.MustParse("zh-CN"), "12500", "1.25万"},
+		{language.MustParse("zh-CN"), "12345", "12345"},
+		{language.MustParse("zh-CN"), "120000", "12万"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimal(decimal.MustParse(tt.number), tt.tag)
+		if err != nil {
+			t.Errorf("[POLICY] %s number %q => unexpected error: %v", tt.tag, tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[POLICY] %s number %q => got %q, want %q", tt.tag, tt.number, res, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeZhNumberingSystem(t *testing.T) {
+	tests := []struct {
+		tag       string
+		number    string
+		composite bool
+		expected  string
+	}{
+		{"zh-u-nu-hanidec", "12000", false, "一.二万"},
+		{"zh-u-nu-hans", "12000", false, "一点二万"},
+		{"zh-u-nu-hans", "123456789", true, "一亿二千三百四十五万六千七百八十九"},
+		{"zh-u-nu-hansfin", "12345", true, "壹万贰仟叁佰肆拾伍"},
+		{"zh-u-nu-hant", "12000", false, "一點二萬"},
+		{"zh-u-nu-hant", "120000000", false, "一點二億"},
+		{"zh-u-nu-hantfin", "12345", true, "壹萬貳仟參佰肆拾伍"},
+		{"zh-u-nu-hantfin", "-2500", false, "-貳仟伍佰"},
+	}
+
+	h := hc.New(locales, hc.Short, fallback)
+
+	for _, tt := range tests {
+		opts := hc.Options{Composite: tt.composite, CompactThreshold: 10000}
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), language.MustParse(tt.tag), opts)
+		if err != nil {
+			t.Errorf("[NU] number %q => unexpected error: %v", tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[NU] number %q (%s) => got %q, want %q", tt.number, tt.tag, res, tt.expected)
+		}
+	}
+
+	res, _, err := h.FormatDecimal(decimal.MustParse("10000"), language.MustParse("zh-u-nu-hantfin"))
+	if err != nil || res != "壹萬" {
+		t.Errorf("[NU] decimal %q => got %q, %v, want %q", "10000", res, err, "壹萬")
+	}
+}
@@ -24,6 +24,23 @@ func (l Locale) PluralForm(r decimal.Decimal, v string) string {
 	return "other"
 }
 
+// CLDR ordinal rules: every number is "other".
+func (l Locale) OrdinalForm(n decimal.Decimal) string {
+	return "other"
+}
+
+// CompactFractionDigits allows two fraction digits in exact compact
+// numbers, since the 10^4-based scales are coarser, e.g. "1.25万".
+func (l Locale) CompactFractionDigits() int {
+	return 2
+}
+
+// CompositeSeparator is empty, since Chinese writes the parts of composite
+// numbers without spaces, e.g. "1亿2345万6789".
+func (l Locale) CompositeSeparator() string {
+	return ""
+}
+
 var Data hc.Locale = Locale{
 	localeCode: language.Chinese,
 	data: hc.CldrData{
@@ -59,5 +76,80 @@ var Data hc.Locale = Locale{
 				"100000000000000-count-other": "000万亿",
 			},
 		},
+		CurrencyFormat: hc.CurrencyFormat{
+			Standard:   "¤#,##0.00",
+			Accounting: "¤#,##0.00;(¤#,##0.00)",
+			Short: map[string]string{
+				"1000-count-other":            "0",
+				"10000-count-other":           "¤0万",
+				"100000-count-other":          "¤00万",
+				"1000000-count-other":         "¤000万",
+				"10000000-count-other":        "¤0000万",
+				"100000000-count-other":       "¤0亿",
+				"1000000000-count-other":      "¤00亿",
+				"10000000000-count-other":     "¤000亿",
+				"100000000000-count-other":    "¤0000亿",
+				"1000000000000-count-other":   "¤0万亿",
+				"10000000000000-count-other":  "¤00万亿",
+				"100000000000000-count-other": "¤000万亿",
+			},
+			Symbols: map[string]string{
+				"AUD": "AU$",
+				"BRL": "R$",
+				"CAD": "CA$",
+				"CNY": "¥",
+				"EUR": "€",
+				"GBP": "£",
+				"ILS": "₪",
+				"INR": "₹",
+				"JPY": "JP¥",
+				"KRW": "₩",
+				"MXN": "MX$",
+				"USD": "US$",
+				"VND": "₫",
+			},
+		},
+		PercentFormat: "#,##0%",
+		Symbols: hc.NumberSymbols{
+			PlusSign:    "+",
+			MinusSign:   "-",
+			PercentSign: "%",
+			PerMille:    "‰",
+		},
+		Units: units,
+		UnitList: hc.ListPatterns{
+			Long: hc.ListPattern{
+				Pair:   "{0}{1}",
+				Start:  "{0}{1}",
+				Middle: "{0}{1}",
+				End:    "{0}{1}",
+			},
+			Short: hc.ListPattern{
+				Pair:   "{0}{1}",
+				Start:  "{0}{1}",
+				Middle: "{0}{1}",
+				End:    "{0}{1}",
+			},
+			Narrow: hc.ListPattern{
+				Pair:   "{0}{1}",
+				Start:  "{0}{1}",
+				Middle: "{0}{1}",
+				End:    "{0}{1}",
+			},
+		},
+		RelativeTime: relativeTime,
+		Ordinal:      map[string]string{"other": "第{0}"},
+		RBNF:         rbnf,
+		NumberingSystems: map[string]string{
+			"hans":    "spellout-cardinal",
+			"hansfin": "spellout-cardinal-financial",
+			"hant":    "spellout-cardinal-hant",
+			"hantfin": "spellout-cardinal-financial-hant",
+		},
+		MiscPatterns: hc.MiscPatterns{
+			Range:         "{0}-{1}",
+			Approximately: "~{0}",
+			AtLeast:       "{0}+",
+		},
 	},
 }
@@ -0,0 +1,325 @@
+package locale
+
+// rbnf holds the CLDR rule-based number format rules in the ICU syntax:
+// the spell-out rule sets, followed by those of zh-Hant renamed with a
+// "-hant" suffix for the traditional numbering systems.
+var rbnf = `%spellout-numbering:
+0: 〇;
+1: 一;
+2: 二;
+3: 三;
+4: 四;
+5: 五;
+6: 六;
+7: 七;
+8: 八;
+9: 九;
+10: 十;
+11: 十>%spellout-numbering>;
+20: <%spellout-numbering<十;
+21: <%spellout-numbering<十>%spellout-numbering>;
+100: <%spellout-numbering<百;
+101: <%spellout-numbering<百>%%number2>;
+1000: <%spellout-numbering<千;
+1001: <%spellout-numbering<千>%%number3>;
+10000: <%spellout-numbering<万;
+10001: <%spellout-numbering<万>%%number4>;
+100000000: <%spellout-numbering<亿;
+100000001: <%spellout-numbering<亿>%%number5>;
+1000000000000: <%spellout-numbering<兆;
+1000000000001: <%spellout-numbering<兆>%%number8>;
+10000000000000000: <%spellout-numbering<京;
+10000000000000001: <%spellout-numbering<京>%%number13>;
+1000000000000000000: =#,##0=;
+-x: 负>%spellout-numbering>;
+x.x: <%spellout-numbering<点>>>;
+%%number2:
+1: 〇=%spellout-numbering=;
+10: 一=%spellout-numbering=;
+20: =%spellout-numbering=;
+%%number3:
+1: 〇=%spellout-numbering=;
+10: 〇一=%spellout-numbering=;
+20: 〇=%spellout-numbering=;
+100: =%spellout-numbering=;
+%%number4:
+1: 〇=%spellout-numbering=;
+10: 〇一=%spellout-numbering=;
+20: 〇=%spellout-numbering=;
+1000: =%spellout-numbering=;
+%%number5:
+1: 〇=%spellout-numbering=;
+10: 〇一=%spellout-numbering=;
+20: 〇=%spellout-numbering=;
+10000: =%spellout-numbering=;
+%%number8:
+1: 〇=%spellout-numbering=;
+10: 〇一=%spellout-numbering=;
+20: 〇=%spellout-numbering=;
+10000000: =%spellout-numbering=;
+%%number13:
+1: 〇=%spellout-numbering=;
+10: 〇一=%spellout-numbering=;
+20: 〇=%spellout-numbering=;
+1000000000000: =%spellout-numbering=;
+%spellout-cardinal:
+0: 零;
+1: 一;
+2: 二;
+3: 三;
+4: 四;
+5: 五;
+6: 六;
+7: 七;
+8: 八;
+9: 九;
+10: =%spellout-numbering=;
+100: <%spellout-cardinal<百;
+101: <%spellout-cardinal<百>%%cardinal2>;
+1000: <%spellout-cardinal<千;
+1001: <%spellout-cardinal<千>%%cardinal3>;
+10000: <%spellout-cardinal<万;
+10001: <%spellout-cardinal<万>%%cardinal4>;
+100000000: <%spellout-cardinal<亿;
+100000001: <%spellout-cardinal<亿>%%cardinal5>;
+1000000000000: <%spellout-cardinal<兆;
+1000000000001: <%spellout-cardinal<兆>%%cardinal8>;
+10000000000000000: <%spellout-cardinal<京;
+10000000000000001: <%spellout-cardinal<京>%%cardinal13>;
+1000000000000000000: =#,##0=;
+-x: 负>%spellout-cardinal>;
+x.x: <%spellout-cardinal<点>>>;
+%%cardinal2:
+1: 零=%spellout-numbering=;
+10: 一=%spellout-numbering=;
+20: =%spellout-numbering=;
+%%cardinal3:
+1: 零=%spellout-numbering=;
+10: 零一=%spellout-cardinal=;
+20: 零=%spellout-cardinal=;
+100: =%spellout-cardinal=;
+%%cardinal4:
+1: 零=%spellout-numbering=;
+10: 零一=%spellout-cardinal=;
+20: 零=%spellout-cardinal=;
+1000: =%spellout-cardinal=;
+%%cardinal5:
+1: 零=%spellout-numbering=;
+10: 零一=%spellout-cardinal=;
+20: 零=%spellout-cardinal=;
+10000: =%spellout-cardinal=;
+%%cardinal8:
+1: 零=%spellout-numbering=;
+10: 零一=%spellout-cardinal=;
+20: 零=%spellout-cardinal=;
+10000000: =%spellout-cardinal=;
+%%cardinal13:
+1: 零=%spellout-numbering=;
+10: 零一=%spellout-cardinal=;
+20: 零=%spellout-cardinal=;
+1000000000000: =%spellout-cardinal=;
+%spellout-cardinal-financial:
+0: 零;
+1: 壹;
+2: 贰;
+3: 叁;
+4: 肆;
+5: 伍;
+6: 陆;
+7: 柒;
+8: 捌;
+9: 玖;
+10: 拾;
+11: 拾>%spellout-cardinal-financial>;
+20: <%spellout-cardinal-financial<拾;
+21: <%spellout-cardinal-financial<拾>%spellout-cardinal-financial>;
+100: <%spellout-cardinal-financial<佰;
+101: <%spellout-cardinal-financial<佰>%%financialnumber2>;
+1000: <%spellout-cardinal-financial<仟;
+1001: <%spellout-cardinal-financial<仟>%%financialnumber3>;
+10000: <%spellout-cardinal-financial<万;
+10001: <%spellout-cardinal-financial<万>%%financialnumber4>;
+100000000: <%spellout-cardinal-financial<亿;
+100000001: <%spellout-cardinal-financial<亿>%%financialnumber5>;
+1000000000000: <%spellout-cardinal-financial<兆;
+1000000000001: <%spellout-cardinal-financial<兆>%%financialnumber8>;
+10000000000000000: <%spellout-cardinal-financial<京;
+10000000000000001: <%spellout-cardinal-financial<京>%%financialnumber13>;
+1000000000000000000: =#,##0=;
+-x: 负>%spellout-cardinal-financial>;
+x.x: <%spellout-cardinal-financial<点>%spellout-cardinal-financial>;
+%%financialnumber2:
+1: 零=%spellout-cardinal-financial=;
+10: 壹=%spellout-cardinal-financial=;
+20: =%spellout-cardinal-financial=;
+%%financialnumber3:
+1: 零=%spellout-cardinal-financial=;
+10: 零壹=%spellout-cardinal-financial=;
+20: 零=%spellout-cardinal-financial=;
+100: =%spellout-cardinal-financial=;
+%%financialnumber4:
+1: 零=%spellout-cardinal-financial=;
+10: 零壹=%spellout-cardinal-financial=;
+20: 零=%spellout-cardinal-financial=;
+1000: =%spellout-cardinal-financial=;
+%%financialnumber5:
+1: 零=%spellout-cardinal-financial=;
+10: 零壹=%spellout-cardinal-financial=;
+20: 零=%spellout-cardinal-financial=;
+10000: =%spellout-cardinal-financial=;
+%%financialnumber8:
+1: 零=%spellout-cardinal-financial=;
+10: 零壹=%spellout-cardinal-financial=;
+20: 零=%spellout-cardinal-financial=;
+10000000: =%spellout-cardinal-financial=;
+%%financialnumber13:
+1: 零=%spellout-cardinal-financial=;
+10: 零壹=%spellout-cardinal-financial=;
+20: 零=%spellout-cardinal-financial=;
+1000000000000: =%spellout-cardinal-financial=;
+%spellout-numbering-hant:
+0: 〇;
+1: 一;
+2: 二;
+3: 三;
+4: 四;
+5: 五;
+6: 六;
+7: 七;
+8: 八;
+9: 九;
+10: 十;
+11: 十>%spellout-numbering-hant>;
+20: <%spellout-numbering-hant<十;
+21: <%spellout-numbering-hant<十>%spellout-numbering-hant>;
+100: <%spellout-cardinal-hant<百;
+101: <%spellout-cardinal-hant<百>%%cardinal2-hant>;
+1000: <%spellout-cardinal-hant<千;
+1001: <%spellout-cardinal-hant<千>%%cardinal3-hant>;
+10000: <%spellout-cardinal-hant<萬;
+10001: <%spellout-cardinal-hant<萬>%%cardinal4-hant>;
+100000000: <%spellout-cardinal-hant<億;
+100000001: <%spellout-cardinal-hant<億>%%cardinal5-hant>;
+1000000000000: <%spellout-cardinal-hant<兆;
+1000000000001: <%spellout-cardinal-hant<兆>%%cardinal8-hant>;
+10000000000000000: <%spellout-cardinal-hant<京;
+10000000000000001: <%spellout-cardinal-hant<京>%%cardinal13-hant>;
+1000000000000000000: =#,##0=;
+-x: 負>%spellout-numbering-hant>;
+x.x: <%spellout-cardinal-hant<點>%spellout-numbering-hant>;
+%spellout-cardinal-financial-hant:
+0: 零;
+1: 壹;
+2: 貳;
+3: 參;
+4: 肆;
+5: 伍;
+6: 陸;
+7: 柒;
+8: 捌;
+9: 玖;
+10: 拾;
+11: 拾>%spellout-cardinal-financial-hant>;
+20: <%spellout-cardinal-financial-hant<拾;
+21: <%spellout-cardinal-financial-hant<拾>%spellout-cardinal-financial-hant>;
+100: <%spellout-cardinal-financial-hant<佰;
+101: <%spellout-cardinal-financial-hant<佰>%%financialnumber2-hant>;
+1000: <%spellout-cardinal-financial-hant<仟;
+1001: <%spellout-cardinal-financial-hant<仟>%%financialnumber3-hant>;
+10000: <%spellout-cardinal-financial-hant<萬;
+10001: <%spellout-cardinal-financial-hant<萬>%%financialnumber4-hant>;
+100000000: <%spellout-cardinal-financial-hant<億;
+100000001: <%spellout-cardinal-financial-hant<億>%%financialnumber5-hant>;
+1000000000000: <%spellout-cardinal-financial-hant<兆;
+1000000000001: <%spellout-cardinal-financial-hant<兆>%%financialnumber8-hant>;
+10000000000000000: <%spellout-cardinal-financial-hant<京;
+10000000000000001: <%spellout-cardinal-financial-hant<京>%%financialnumber13-hant>;
+1000000000000000000: =#,##0=;
+-x: 負>%spellout-cardinal-financial-hant>;
+x.x: <%spellout-cardinal-financial-hant<點>%spellout-cardinal-financial-hant>;
+%%financialnumber2-hant:
+1: 零=%spellout-cardinal-financial-hant=;
+10: 壹=%spellout-cardinal-financial-hant=;
+20: =%spellout-cardinal-financial-hant=;
+%%financialnumber3-hant:
+1: 零=%spellout-cardinal-financial-hant=;
+10: 零壹=%spellout-cardinal-financial-hant=;
+20: 零=%spellout-cardinal-financial-hant=;
+100: =%spellout-cardinal-financial-hant=;
+%%financialnumber4-hant:
+1: 零=%spellout-cardinal-financial-hant=;
+10: 零壹=%spellout-cardinal-financial-hant=;
+20: 零=%spellout-cardinal-financial-hant=;
+1000: =%spellout-cardinal-financial-hant=;
+%%financialnumber5-hant:
+1: 零=%spellout-cardinal-financial-hant=;
+10: 零壹=%spellout-cardinal-financial-hant=;
+20: 零=%spellout-cardinal-financial-hant=;
+10000: =%spellout-cardinal-financial-hant=;
+%%financialnumber8-hant:
+1: 零=%spellout-cardinal-financial-hant=;
+10: 零壹=%spellout-cardinal-financial-hant=;
+20: 零=%spellout-cardinal-financial-hant=;
+10000000: =%spellout-cardinal-financial-hant=;
+%%financialnumber13-hant:
+1: 零=%spellout-cardinal-financial-hant=;
+10: 零壹=%spellout-cardinal-financial-hant=;
+20: 零=%spellout-cardinal-financial-hant=;
+1000000000000: =%spellout-cardinal-financial-hant=;
+%spellout-cardinal-hant:
+0: 零;
+1: 一;
+2: 二;
+3: 三;
+4: 四;
+5: 五;
+6: 六;
+7: 七;
+8: 八;
+9: 九;
+10: =%spellout-numbering-hant=;
+100: <%spellout-cardinal-hant<百;
+101: <%spellout-cardinal-hant<百>%%cardinal2-hant>;
+1000: <%spellout-cardinal-hant<千;
+1001: <%spellout-cardinal-hant<千>%%cardinal3-hant>;
+10000: <%spellout-cardinal-hant<萬;
+10001: <%spellout-cardinal-hant<萬>%%cardinal4-hant>;
+100000000: <%spellout-cardinal-hant<億;
+100000001: <%spellout-cardinal-hant<億>%%cardinal5-hant>;
+1000000000000: <%spellout-cardinal-hant<兆;
+1000000000001: <%spellout-cardinal-hant<兆>%%cardinal8-hant>;
+10000000000000000: <%spellout-cardinal-hant<京;
+10000000000000001: <%spellout-cardinal-hant<京>%%cardinal13-hant>;
+1000000000000000000: =#,##0=;
+-x: 負>%spellout-cardinal-hant>;
+x.x: <%spellout-cardinal-hant<點>%spellout-cardinal-hant>;
+%%cardinal2-hant:
+1: 零=%spellout-numbering-hant=;
+10: 一=%spellout-numbering-hant=;
+20: =%spellout-numbering-hant=;
+%%cardinal3-hant:
+1: 零=%spellout-numbering-hant=;
+10: 零一=%spellout-cardinal-hant=;
+20: 零=%spellout-cardinal-hant=;
+100: =%spellout-cardinal-hant=;
+%%cardinal4-hant:
+1: 零=%spellout-numbering-hant=;
+10: 零一=%spellout-cardinal-hant=;
+20: 零=%spellout-cardinal-hant=;
+1000: =%spellout-cardinal-hant=;
+%%cardinal5-hant:
+1: 零=%spellout-numbering-hant=;
+10: 零一=%spellout-cardinal-hant=;
+20: 零=%spellout-cardinal-hant=;
+10000: =%spellout-cardinal-hant=;
+%%cardinal8-hant:
+1: 零=%spellout-numbering-hant=;
+10: 零一=%spellout-cardinal-hant=;
+20: 零=%spellout-cardinal-hant=;
+10000000: =%spellout-cardinal-hant=;
+%%cardinal13-hant:
+1: 零=%spellout-numbering-hant=;
+10: 零一=%spellout-cardinal-hant=;
+20: 零=%spellout-cardinal-hant=;
+1000000000000: =%spellout-cardinal-hant=;
+`
@@ -0,0 +1,143 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// relativeTime holds the CLDR relative time patterns keyed by unit.
+var relativeTime = map[string]hc.RelativeTimeUnit{
+	"year": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}年后"},
+			Past:     map[string]string{"other": "{0}年前"},
+			Relative: map[string]string{"-1": "去年", "0": "今年", "1": "明年"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}年后"},
+			Past:     map[string]string{"other": "{0}年前"},
+			Relative: map[string]string{"-1": "去年", "0": "今年", "1": "明年"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}年后"},
+			Past:     map[string]string{"other": "{0}年前"},
+			Relative: map[string]string{"-1": "去年", "0": "今年", "1": "明年"},
+		},
+	},
+	"quarter": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}个季度后"},
+			Past:     map[string]string{"other": "{0}个季度前"},
+			Relative: map[string]string{"-1": "上季度", "0": "本季度", "1": "下季度"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}个季度后"},
+			Past:     map[string]string{"other": "{0}个季度前"},
+			Relative: map[string]string{"-1": "上季度", "0": "本季度", "1": "下季度"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}个季度后"},
+			Past:     map[string]string{"other": "{0}个季度前"},
+			Relative: map[string]string{"-1": "上季度", "0": "本季度", "1": "下季度"},
+		},
+	},
+	"month": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}个月后"},
+			Past:     map[string]string{"other": "{0}个月前"},
+			Relative: map[string]string{"-1": "上个月", "0": "本月", "1": "下个月"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}个月后"},
+			Past:     map[string]string{"other": "{0}个月前"},
+			Relative: map[string]string{"-1": "上个月", "0": "本月", "1": "下个月"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}个月后"},
+			Past:     map[string]string{"other": "{0}个月前"},
+			Relative: map[string]string{"-1": "上个月", "0": "本月", "1": "下个月"},
+		},
+	},
+	"week": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}周后"},
+			Past:     map[string]string{"other": "{0}周前"},
+			Relative: map[string]string{"-1": "上周", "0": "本周", "1": "下周"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}周后"},
+			Past:     map[string]string{"other": "{0}周前"},
+			Relative: map[string]string{"-1": "上周", "0": "本周", "1": "下周"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}周后"},
+			Past:     map[string]string{"other": "{0}周前"},
+			Relative: map[string]string{"-1": "上周", "0": "本周", "1": "下周"},
+		},
+	},
+	"day": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}天后"},
+			Past:     map[string]string{"other": "{0}天前"},
+			Relative: map[string]string{"-2": "前天", "-1": "昨天", "0": "今天", "1": "明天", "2": "后天"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}天后"},
+			Past:     map[string]string{"other": "{0}天前"},
+			Relative: map[string]string{"-2": "前天", "-1": "昨天", "0": "今天", "1": "明天", "2": "后天"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}天后"},
+			Past:     map[string]string{"other": "{0}天前"},
+			Relative: map[string]string{"-2": "前天", "-1": "昨天", "0": "今天", "1": "明天", "2": "后天"},
+		},
+	},
+	"hour": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}小时后"},
+			Past:     map[string]string{"other": "{0}小时前"},
+			Relative: map[string]string{"0": "这一时间 / 此时"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}小时后"},
+			Past:     map[string]string{"other": "{0}小时前"},
+			Relative: map[string]string{"0": "这一时间 / 此时"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}小时后"},
+			Past:     map[string]string{"other": "{0}小时前"},
+			Relative: map[string]string{"0": "这一时间 / 此时"},
+		},
+	},
+	"minute": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}分钟后"},
+			Past:     map[string]string{"other": "{0}分钟前"},
+			Relative: map[string]string{"0": "此刻"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}分钟后"},
+			Past:     map[string]string{"other": "{0}分钟前"},
+			Relative: map[string]string{"0": "此刻"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}分钟后"},
+			Past:     map[string]string{"other": "{0}分钟前"},
+			Relative: map[string]string{"0": "此刻"},
+		},
+	},
+	"second": {
+		Long: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}秒钟后"},
+			Past:     map[string]string{"other": "{0}秒钟前"},
+			Relative: map[string]string{"0": "现在"},
+		},
+		Short: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}秒后"},
+			Past:     map[string]string{"other": "{0}秒前"},
+			Relative: map[string]string{"0": "现在"},
+		},
+		Narrow: hc.RelativeTimePatterns{
+			Future:   map[string]string{"other": "{0}秒后"},
+			Past:     map[string]string{"other": "{0}秒前"},
+			Relative: map[string]string{"0": "现在"},
+		},
+	},
+}
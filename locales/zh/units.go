@@ -0,0 +1,282 @@
+package locale
+
+import hc "github.com/dejurin/humanizecompact"
+
+// units holds the CLDR unit patterns keyed by unit identifier.
+var units = map[string]hc.UnitPatterns{
+	"acre": {
+		Long:   map[string]string{"other": "{0}英亩", "per": "每英亩{0}"},
+		Short:  map[string]string{"other": "{0}英亩", "per": "{0}/英亩"},
+		Narrow: map[string]string{"other": "{0}ac", "per": "{0}/ac"},
+	},
+	"bit": {
+		Long:   map[string]string{"other": "{0}比特", "per": "每比特{0}"},
+		Short:  map[string]string{"other": "{0} b", "per": "{0}/b"},
+		Narrow: map[string]string{"other": "{0} b", "per": "{0}/b"},
+	},
+	"byte": {
+		Long:   map[string]string{"other": "{0}字节", "per": "每字节{0}"},
+		Short:  map[string]string{"other": "{0} B", "per": "{0}/B"},
+		Narrow: map[string]string{"other": "{0} B", "per": "{0}/B"},
+	},
+	"celsius": {
+		Long:   map[string]string{"other": "{0}摄氏度", "per": "每摄氏度{0}"},
+		Short:  map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+		Narrow: map[string]string{"other": "{0}°C", "per": "{0}/°C"},
+	},
+	"centimeter": {
+		Long:   map[string]string{"other": "{0}厘米", "per": "{0}/厘米"},
+		Short:  map[string]string{"other": "{0}厘米", "per": "{0}/厘米"},
+		Narrow: map[string]string{"other": "{0}厘米", "per": "{0}/cm"},
+	},
+	"day": {
+		Long:   map[string]string{"other": "{0}天", "per": "{0}/天"},
+		Short:  map[string]string{"other": "{0}天", "per": "{0}/天"},
+		Narrow: map[string]string{"other": "{0}天", "per": "{0}/天"},
+	},
+	"degree": {
+		Long:   map[string]string{"other": "{0}度", "per": "每度{0}"},
+		Short:  map[string]string{"other": "{0}°", "per": "{0}/°"},
+		Narrow: map[string]string{"other": "{0}°", "per": "{0}/°"},
+	},
+	"fahrenheit": {
+		Long:   map[string]string{"other": "{0}华氏度", "per": "每华氏度{0}"},
+		Short:  map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+		Narrow: map[string]string{"other": "{0}°F", "per": "{0}/°F"},
+	},
+	"fluid-ounce": {
+		Long:   map[string]string{"other": "{0}液盎司", "per": "每液盎司{0}"},
+		Short:  map[string]string{"other": "{0}液盎司", "per": "{0}/液盎司"},
+		Narrow: map[string]string{"other": "{0}fl oz", "per": "{0}/fl oz"},
+	},
+	"foot": {
+		Long:   map[string]string{"other": "{0}英尺", "per": "{0}/英尺"},
+		Short:  map[string]string{"other": "{0}英尺", "per": "{0}/英尺"},
+		Narrow: map[string]string{"other": "{0}′", "per": "{0}/ft"},
+	},
+	"gallon": {
+		Long:   map[string]string{"other": "{0}加仑", "per": "{0}/加仑"},
+		Short:  map[string]string{"other": "{0}加仑", "per": "{0}/加仑"},
+		Narrow: map[string]string{"other": "{0}gal", "per": "{0}/gal"},
+	},
+	"gigabit": {
+		Long:   map[string]string{"other": "{0}吉比特", "per": "每吉比特{0}"},
+		Short:  map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+		Narrow: map[string]string{"other": "{0} Gb", "per": "{0}/Gb"},
+	},
+	"gigabyte": {
+		Long:   map[string]string{"other": "{0}吉字节", "per": "每吉字节{0}"},
+		Short:  map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+		Narrow: map[string]string{"other": "{0} GB", "per": "{0}/GB"},
+	},
+	"gram": {
+		Long:   map[string]string{"other": "{0}克", "per": "每克{0}"},
+		Short:  map[string]string{"other": "{0} g", "per": "{0}/g"},
+		Narrow: map[string]string{"other": "{0}g", "per": "{0}/g"},
+	},
+	"hectare": {
+		Long:   map[string]string{"other": "{0}公顷", "per": "每公顷{0}"},
+		Short:  map[string]string{"other": "{0}公顷", "per": "{0}/公顷"},
+		Narrow: map[string]string{"other": "{0}ha", "per": "{0}/ha"},
+	},
+	"hour": {
+		Long:   map[string]string{"other": "{0}小时", "per": "{0}/小时"},
+		Short:  map[string]string{"other": "{0}小时", "per": "{0}/小时"},
+		Narrow: map[string]string{"other": "{0}小时", "per": "{0}/小时"},
+	},
+	"inch": {
+		Long:   map[string]string{"other": "{0}英寸", "per": "{0}/英寸"},
+		Short:  map[string]string{"other": "{0}英寸", "per": "{0}/英寸"},
+		Narrow: map[string]string{"other": "{0}″", "per": "{0}/in"},
+	},
+	"kilobit": {
+		Long:   map[string]string{"other": "{0}千比特", "per": "每千比特{0}"},
+		Short:  map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+		Narrow: map[string]string{"other": "{0} kb", "per": "{0}/kb"},
+	},
+	"kilobyte": {
+		Long:   map[string]string{"other": "{0}千字节", "per": "每千字节{0}"},
+		Short:  map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+		Narrow: map[string]string{"other": "{0} kB", "per": "{0}/kB"},
+	},
+	"kilogram": {
+		Long:   map[string]string{"other": "{0}千克", "per": "每千克{0}"},
+		Short:  map[string]string{"other": "{0} kg", "per": "{0}/kg"},
+		Narrow: map[string]string{"other": "{0}kg", "per": "{0}/kg"},
+	},
+	"kilometer": {
+		Long:   map[string]string{"other": "{0}公里", "per": "{0}/公里"},
+		Short:  map[string]string{"other": "{0}公里", "per": "{0}/公里"},
+		Narrow: map[string]string{"other": "{0}公里", "per": "{0}/km"},
+	},
+	"kilometer-per-hour": {
+		Long:   map[string]string{"other": "每小时{0}公里"},
+		Short:  map[string]string{"other": "{0} km/h"},
+		Narrow: map[string]string{"other": "{0}km/h"},
+	},
+	"liter": {
+		Long:   map[string]string{"other": "{0}升", "per": "{0}/升"},
+		Short:  map[string]string{"other": "{0}升", "per": "{0}/升"},
+		Narrow: map[string]string{"other": "{0}升", "per": "{0}/L"},
+	},
+	"liter-per-kilometer": {
+		Long:   map[string]string{"other": "每公里{0}升"},
+		Short:  map[string]string{"other": "{0} L/km"},
+		Narrow: map[string]string{"other": "{0}L/km"},
+	},
+	"megabit": {
+		Long:   map[string]string{"other": "{0}兆比特", "per": "每兆比特{0}"},
+		Short:  map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+		Narrow: map[string]string{"other": "{0} Mb", "per": "{0}/Mb"},
+	},
+	"megabyte": {
+		Long:   map[string]string{"other": "{0}兆字节", "per": "每兆字节{0}"},
+		Short:  map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+		Narrow: map[string]string{"other": "{0} MB", "per": "{0}/MB"},
+	},
+	"meter": {
+		Long:   map[string]string{"other": "{0}米", "per": "{0}/米"},
+		Short:  map[string]string{"other": "{0}米", "per": "{0}/米"},
+		Narrow: map[string]string{"other": "{0}米", "per": "{0}/m"},
+	},
+	"meter-per-second": {
+		Long:   map[string]string{"other": "每秒{0}米"},
+		Short:  map[string]string{"other": "{0} m/s"},
+		Narrow: map[string]string{"other": "{0}m/s"},
+	},
+	"microsecond": {
+		Long:   map[string]string{"other": "{0}微秒", "per": "每微秒{0}"},
+		Short:  map[string]string{"other": "{0}微秒", "per": "{0}/微秒"},
+		Narrow: map[string]string{"other": "{0}μs", "per": "{0}/μs"},
+	},
+	"mile": {
+		Long:   map[string]string{"other": "{0}英里", "per": "每英里{0}"},
+		Short:  map[string]string{"other": "{0}英里", "per": "{0}/英里"},
+		Narrow: map[string]string{"other": "{0}mi", "per": "{0}/mi"},
+	},
+	"mile-per-gallon": {
+		Long:   map[string]string{"other": "每加仑{0}英里"},
+		Short:  map[string]string{"other": "{0} mpg US"},
+		Narrow: map[string]string{"other": "{0}mpg"},
+	},
+	"mile-per-hour": {
+		Long:   map[string]string{"other": "每小时{0}英里"},
+		Short:  map[string]string{"other": "{0} mi/h"},
+		Narrow: map[string]string{"other": "{0}mph"},
+	},
+	"mile-scandinavian": {
+		Long:   map[string]string{"other": "{0}斯堪的纳维亚英里", "per": "每斯堪的纳维亚英里{0}"},
+		Short:  map[string]string{"other": "{0}斯堪的纳维亚英里", "per": "{0}/斯堪的纳维亚英里"},
+		Narrow: map[string]string{"other": "{0}smi", "per": "{0}/smi"},
+	},
+	"milliliter": {
+		Long:   map[string]string{"other": "{0}毫升", "per": "每毫升{0}"},
+		Short:  map[string]string{"other": "{0}毫升", "per": "{0}/毫升"},
+		Narrow: map[string]string{"other": "{0}mL", "per": "{0}/mL"},
+	},
+	"millimeter": {
+		Long:   map[string]string{"other": "{0}毫米", "per": "每毫米{0}"},
+		Short:  map[string]string{"other": "{0}毫米", "per": "{0}/毫米"},
+		Narrow: map[string]string{"other": "{0}毫米", "per": "{0}/毫米"},
+	},
+	"millisecond": {
+		Long:   map[string]string{"other": "{0}毫秒", "per": "每毫秒{0}"},
+		Short:  map[string]string{"other": "{0}毫秒", "per": "{0}/毫秒"},
+		Narrow: map[string]string{"other": "{0}ms", "per": "{0}/ms"},
+	},
+	"minute": {
+		Long:   map[string]string{"other": "{0}分钟", "per": "{0}/分钟"},
+		Short:  map[string]string{"other": "{0}分钟", "per": "{0}/分钟"},
+		Narrow: map[string]string{"other": "{0}分钟", "per": "{0}/分钟"},
+	},
+	"month": {
+		Long:   map[string]string{"other": "{0}个月", "per": "{0}/月"},
+		Short:  map[string]string{"other": "{0}个月", "per": "{0}/月"},
+		Narrow: map[string]string{"other": "{0}个月", "per": "{0}/月"},
+	},
+	"nanosecond": {
+		Long:   map[string]string{"other": "{0}纳秒", "per": "每纳秒{0}"},
+		Short:  map[string]string{"other": "{0}纳秒", "per": "{0}/纳秒"},
+		Narrow: map[string]string{"other": "{0}ns", "per": "{0}/ns"},
+	},
+	"ounce": {
+		Long:   map[string]string{"other": "{0}盎司", "per": "每盎司{0}"},
+		Short:  map[string]string{"other": "{0} oz", "per": "{0}/oz"},
+		Narrow: map[string]string{"other": "{0}oz", "per": "{0}/oz"},
+	},
+	"per": {
+		Long:   map[string]string{"compound": "{0}/{1}"},
+		Short:  map[string]string{"compound": "{0}/{1}"},
+		Narrow: map[string]string{"compound": "{0}/{1}"},
+	},
+	"petabyte": {
+		Long:   map[string]string{"other": "{0}拍字节", "per": "每拍字节{0}"},
+		Short:  map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+		Narrow: map[string]string{"other": "{0} PB", "per": "{0}/PB"},
+	},
+	"pound": {
+		Long:   map[string]string{"other": "{0}磅", "per": "每磅{0}"},
+		Short:  map[string]string{"other": "{0} lb", "per": "{0}/lb"},
+		Narrow: map[string]string{"other": "{0}#", "per": "{0}/lb"},
+	},
+	"second": {
+		Long:   map[string]string{"other": "{0}秒钟", "per": "每秒{0}"},
+		Short:  map[string]string{"other": "{0}秒"},
+		Narrow: map[string]string{"other": "{0}秒"},
+	},
+	"square-centimeter": {
+		Long:   map[string]string{"other": "{0}平方厘米", "per": "{0}/平方厘米"},
+		Short:  map[string]string{"other": "{0}平方厘米", "per": "{0}/平方厘米"},
+		Narrow: map[string]string{"other": "{0}cm²", "per": "{0}/cm²"},
+	},
+	"square-foot": {
+		Long:   map[string]string{"other": "{0}平方英尺"},
+		Short:  map[string]string{"other": "{0}平方英尺"},
+		Narrow: map[string]string{"other": "{0}ft²"},
+	},
+	"square-kilometer": {
+		Long:   map[string]string{"other": "{0}平方公里", "per": "{0}/平方公里"},
+		Short:  map[string]string{"other": "{0}平方公里", "per": "{0}/平方公里"},
+		Narrow: map[string]string{"other": "{0}km²", "per": "{0}/km²"},
+	},
+	"square-meter": {
+		Long:   map[string]string{"other": "{0}平方米", "per": "{0}/平方米"},
+		Short:  map[string]string{"other": "{0}平方米", "per": "{0}/平方米"},
+		Narrow: map[string]string{"other": "{0}m²", "per": "{0}/m²"},
+	},
+	"square-mile": {
+		Long:   map[string]string{"other": "{0}平方英里", "per": "{0}/平方英里"},
+		Short:  map[string]string{"other": "{0}平方英里", "per": "{0}/平方英里"},
+		Narrow: map[string]string{"other": "{0}mi²", "per": "{0}/mi²"},
+	},
+	"stone": {
+		Long:   map[string]string{"other": "{0}英石", "per": "每英石{0}"},
+		Short:  map[string]string{"other": "{0} st", "per": "{0}/st"},
+		Narrow: map[string]string{"other": "{0}st", "per": "{0}/st"},
+	},
+	"terabit": {
+		Long:   map[string]string{"other": "{0}太比特", "per": "每太比特{0}"},
+		Short:  map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+		Narrow: map[string]string{"other": "{0} Tb", "per": "{0}/Tb"},
+	},
+	"terabyte": {
+		Long:   map[string]string{"other": "{0}太字节", "per": "每太字节{0}"},
+		Short:  map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+		Narrow: map[string]string{"other": "{0} TB", "per": "{0}/TB"},
+	},
+	"week": {
+		Long:   map[string]string{"other": "{0}周", "per": "{0}/周"},
+		Short:  map[string]string{"other": "{0}周", "per": "{0}/周"},
+		Narrow: map[string]string{"other": "{0}周", "per": "{0}/周"},
+	},
+	"yard": {
+		Long:   map[string]string{"other": "{0}码", "per": "每码{0}"},
+		Short:  map[string]string{"other": "{0}码", "per": "{0}/码"},
+		Narrow: map[string]string{"other": "{0}yd", "per": "{0}/yd"},
+	},
+	"year": {
+		Long:   map[string]string{"other": "{0}年", "per": "{0}/年"},
+		Short:  map[string]string{"other": "{0}年", "per": "{0}/年"},
+		Narrow: map[string]string{"other": "{0}年", "per": "{0}/年"},
+	},
+}
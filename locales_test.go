@@ -0,0 +1,147 @@
+package humanizecompact_test
+
+import (
+	"testing"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+
+	hc "github.com/dejurin/humanizecompact"
+	locale_ar "github.com/dejurin/humanizecompact/locales/ar"
+	locale_bg "github.com/dejurin/humanizecompact/locales/bg"
+	locale_cs "github.com/dejurin/humanizecompact/locales/cs"
+	locale_da "github.com/dejurin/humanizecompact/locales/da"
+	locale_de "github.com/dejurin/humanizecompact/locales/de"
+	locale_en "github.com/dejurin/humanizecompact/locales/en"
+	locale_es "github.com/dejurin/humanizecompact/locales/es"
+	locale_fa "github.com/dejurin/humanizecompact/locales/fa"
+	locale_fr "github.com/dejurin/humanizecompact/locales/fr"
+	locale_he "github.com/dejurin/humanizecompact/locales/he"
+	locale_hu "github.com/dejurin/humanizecompact/locales/hu"
+	locale_id "github.com/dejurin/humanizecompact/locales/id"
+	locale_it "github.com/dejurin/humanizecompact/locales/it"
+	locale_ja "github.com/dejurin/humanizecompact/locales/ja"
+	locale_ko "github.com/dejurin/humanizecompact/locales/ko"
+	locale_pl "github.com/dejurin/humanizecompact/locales/pl"
+	locale_pt "github.com/dejurin/humanizecompact/locales/pt"
+	locale_ro "github.com/dejurin/humanizecompact/locales/ro"
+	locale_ru "github.com/dejurin/humanizecompact/locales/ru"
+	locale_sv "github.com/dejurin/humanizecompact/locales/sv"
+	locale_th "github.com/dejurin/humanizecompact/locales/th"
+	locale_tr "github.com/dejurin/humanizecompact/locales/tr"
+	locale_uk "github.com/dejurin/humanizecompact/locales/uk"
+	locale_vi "github.com/dejurin/humanizecompact/locales/vi"
+	locale_zh "github.com/dejurin/humanizecompact/locales/zh"
+)
+
+func fallback(number string) string {
+	return number
+}
+
+// bundledLocales holds every bundled locale by code.
+var bundledLocales = map[string]hc.Locale{
+	"ar": locale_ar.Data,
+	"bg": locale_bg.Data,
+	"cs": locale_cs.Data,
+	"da": locale_da.Data,
+	"de": locale_de.Data,
+	"en": locale_en.Data,
+	"es": locale_es.Data,
+	"fa": locale_fa.Data,
+	"fr": locale_fr.Data,
+	"he": locale_he.Data,
+	"hu": locale_hu.Data,
+	"id": locale_id.Data,
+	"it": locale_it.Data,
+	"ja": locale_ja.Data,
+	"ko": locale_ko.Data,
+	"pl": locale_pl.Data,
+	"pt": locale_pt.Data,
+	"ro": locale_ro.Data,
+	"ru": locale_ru.Data,
+	"sv": locale_sv.Data,
+	"th": locale_th.Data,
+	"tr": locale_tr.Data,
+	"uk": locale_uk.Data,
+	"vi": locale_vi.Data,
+	"zh": locale_zh.Data,
+}
+
+// TestHumanizeSignAccounting checks the accounting sign display, which
+// follows the accounting currency pattern of each locale.
+func TestHumanizeSignAccounting(t *testing.T) {
+	tests := []struct {
+		locale   string
+		number   string
+		expected string
+	}{
+		{"ar", "1234567", "١٫٢\u00A0مليون"},
+		{"ar", "-1234567", "(\u061C١٫٢\u00A0مليون)"},
+		{"bg", "1234567", "1,2\u00A0млн."},
+		{"bg", "-1234567", "(1,2\u00A0млн.)"},
+		{"cs", "1234567", "1,2\u00A0mil."},
+		{"cs", "-1234567", "-1,2\u00A0mil."},
+		{"da", "1234567", "1,2\u00A0mio."},
+		{"da", "-1234567", "-1,2\u00A0mio."},
+		{"de", "1234567", "1,2\u00A0Mio."},
+		{"de", "-1234567", "-1,2\u00A0Mio."},
+		{"en", "1234567", "1.2M"},
+		{"en", "-1234567", "(1.2M)"},
+		{"es", "1234567", "1,2\u00A0M"},
+		{"es", "-1234567", "-1,2\u00A0M"},
+		{"fa", "1234567", "۱٫۲\u00A0میلیون"},
+		{"fa", "-1234567", "\u200E(۱٫۲\u00A0میلیون)"},
+		{"fr", "1234567", "1,2\u00A0M"},
+		{"fr", "-1234567", "(1,2\u00A0M)"},
+		{"he", "1234567", "1.2M\u200f"},
+		{"he", "-1234567", "\u200e-1.2M\u200f"},
+		{"hu", "1234567", "1,2\u00A0M"},
+		{"hu", "-1234567", "-1,2\u00A0M"},
+		{"id", "1234567", "1,2\u00A0jt"},
+		{"id", "-1234567", "-1,2\u00A0jt"},
+		{"it", "1234567", "1,2\u00A0Mln"},
+		{"it", "-1234567", "-1,2\u00A0Mln"},
+		{"ja", "1234567", "123.5万"},
+		{"ja", "-1234567", "(123.5万)"},
+		{"ko", "1234567", "123.5만"},
+		{"ko", "-1234567", "(123.5만)"},
+		{"pl", "1234567", "1,2\u00A0mln"},
+		{"pl", "-1234567", "(1,2\u00A0mln)"},
+		{"pt", "1234567", "1,2\u00A0mi"},
+		{"pt", "-1234567", "-1,2\u00A0mi"},
+		{"ro", "1234567", "1,2\u00A0mil."},
+		{"ro", "-1234567", "(1,2\u00A0mil.)"},
+		{"ru", "1234567", "1,2\u00A0млн"},
+		{"ru", "-1234567", "-1,2\u00A0млн"},
+		{"sv", "1234567", "1,2\u00A0mn"},
+		{"sv", "-1234567", "−1,2\u00A0mn"},
+		{"th", "1234567", "1.2M"},
+		{"th", "-1234567", "(1.2M)"},
+		{"tr", "1234567", "1,2\u00A0Mn"},
+		{"tr", "-1234567", "(1,2\u00A0Mn)"},
+		{"uk", "1234567", "1,2\u00A0млн"},
+		{"uk", "-1234567", "-1,2\u00A0млн"},
+		{"vi", "1234567", "1,2\u00A0Tr"},
+		{"vi", "-1234567", "-1,2\u00A0Tr"},
+		{"zh", "1234567", "123.5万"},
+		{"zh", "-1234567", "(123.5万)"},
+	}
+
+	locales := make(map[language.Tag]hc.Locale, len(bundledLocales))
+	for _, loc := range bundledLocales {
+		locales[loc.Code()] = loc
+	}
+	h := hc.New(locales, hc.Short, fallback)
+	opts := hc.Options{Precision: hc.FractionDigits(0, 1), SignDisplay: hc.SignAccounting}
+
+	for _, tt := range tests {
+		res, _, err := h.FormatDecimalOptions(decimal.MustParse(tt.number), bundledLocales[tt.locale].Code(), opts)
+		if err != nil {
+			t.Errorf("[SIGN] %s number %q => unexpected error: %v", tt.locale, tt.number, err)
+			continue
+		}
+		if res != tt.expected {
+			t.Errorf("[SIGN] %s number %q => got %q, want %q", tt.locale, tt.number, res, tt.expected)
+		}
+	}
+}
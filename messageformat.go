@@ -0,0 +1,452 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// Message is a parsed ICU MessageFormat pattern. The supported subset
+// covers simple arguments ("{name}"), number arguments ("{n, number}",
+// "{n, number, integer}", "{n, number, percent}", "{n, number,
+// compact-short}" and "{n, number, compact-long}"), and the plural,
+// selectordinal and select arguments with their "#" placeholder, explicit
+// "=N" cases and plural offsets. Apostrophes quote syntax characters as in
+// ICU: "'{'" is a literal brace and "”" a literal apostrophe.
+type Message struct {
+	nodes []msgNode
+}
+
+// msgNode is a piece of a message: literal text, the "#" placeholder or
+// an argument.
+type msgNode struct {
+	text  string
+	pound bool
+	arg   *msgArg
+}
+
+// msgArg is a "{name, kind, style}" argument. Plural, selectordinal and
+// select arguments hold their cases in pattern order.
+type msgArg struct {
+	name   string
+	kind   string
+	style  string
+	offset decimal.Decimal
+	cases  []msgCase
+}
+
+// msgCase is a "key {message}" case of a complex argument.
+type msgCase struct {
+	key string
+	msg *Message
+}
+
+// MessageSyntaxError is returned when a MessageFormat pattern cannot be
+// parsed.
+type MessageSyntaxError struct {
+	Pattern string
+	Offset  int
+	Err     string
+}
+
+// Error implements the error interface.
+func (e MessageSyntaxError) Error() string {
+	return fmt.Sprintf("message %q: %s at offset %d", e.Pattern, e.Err, e.Offset)
+}
+
+// ParseMessage parses an ICU MessageFormat pattern, e.g.
+// "{count, plural, one {# follower} other {# followers}}".
+func ParseMessage(pattern string) (*Message, error) {
+	mp := msgParser{s: pattern}
+	m, err := mp.message(false)
+	if err != nil {
+		return nil, err
+	}
+	if mp.pos < len(mp.s) {
+		return nil, mp.errorf("unexpected %q", mp.s[mp.pos])
+	}
+	return m, nil
+}
+
+// msgParser is a recursive descent parser of MessageFormat patterns.
+type msgParser struct {
+	s   string
+	pos int
+}
+
+func (mp *msgParser) errorf(format string, a ...any) error {
+	return MessageSyntaxError{Pattern: mp.s, Offset: mp.pos, Err: fmt.Sprintf(format, a...)}
+}
+
+// message parses nodes up to the closing brace of the enclosing argument
+// or the end of the pattern. inPlural enables the "#" placeholder.
+func (mp *msgParser) message(inPlural bool) (*Message, error) {
+	m := &Message{}
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			m.nodes = append(m.nodes, msgNode{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for mp.pos < len(mp.s) {
+		c := mp.s[mp.pos]
+		switch {
+		case c == '\'':
+			mp.quoted(&text, inPlural)
+
+		case c == '{':
+			flush()
+			arg, err := mp.argument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			m.nodes = append(m.nodes, msgNode{arg: arg})
+
+		case c == '}':
+			flush()
+			return m, nil
+
+		case c == '#' && inPlural:
+			flush()
+			m.nodes = append(m.nodes, msgNode{pound: true})
+			mp.pos++
+
+		default:
+			text.WriteByte(c)
+			mp.pos++
+		}
+	}
+	flush()
+	return m, nil
+}
+
+// quoted handles an apostrophe: "”" is a literal apostrophe, and an
+// apostrophe before a syntax character quotes the text up to the next
+// single apostrophe. Any other apostrophe is literal.
+func (mp *msgParser) quoted(text *strings.Builder, inPlural bool) {
+	mp.pos++
+	if mp.pos < len(mp.s) && mp.s[mp.pos] == '\'' {
+		text.WriteByte('\'')
+		mp.pos++
+		return
+	}
+	if mp.pos >= len(mp.s) || !(strings.IndexByte("{}|", mp.s[mp.pos]) >= 0 || (inPlural && mp.s[mp.pos] == '#')) {
+		text.WriteByte('\'')
+		return
+	}
+	for mp.pos < len(mp.s) {
+		if mp.s[mp.pos] == '\'' {
+			if mp.pos+1 < len(mp.s) && mp.s[mp.pos+1] == '\'' {
+				text.WriteByte('\'')
+				mp.pos += 2
+				continue
+			}
+			mp.pos++
+			return
+		}
+		text.WriteByte(mp.s[mp.pos])
+		mp.pos++
+	}
+}
+
+// argument parses "{name}", "{name, kind}" or "{name, kind, style}".
+func (mp *msgParser) argument(inPlural bool) (*msgArg, error) {
+	mp.pos++ // '{'
+	arg := &msgArg{name: mp.word()}
+	if arg.name == "" {
+		return nil, mp.errorf("missing argument name")
+	}
+	if mp.consume('}') {
+		return arg, nil
+	}
+	if !mp.consume(',') {
+		return nil, mp.errorf("expected ',' or '}'")
+	}
+
+	arg.kind = mp.word()
+	switch arg.kind {
+	case "number":
+		if mp.consume('}') {
+			return arg, nil
+		}
+		if !mp.consume(',') {
+			return nil, mp.errorf("expected ',' or '}'")
+		}
+		end := strings.IndexByte(mp.s[mp.pos:], '}')
+		if end < 0 {
+			return nil, mp.errorf("unterminated argument")
+		}
+		arg.style = strings.TrimPrefix(strings.TrimSpace(mp.s[mp.pos:mp.pos+end]), "::")
+		switch arg.style {
+		case "integer", "percent", "compact-short", "compact-long":
+		default:
+			return nil, mp.errorf("unsupported number style %q", arg.style)
+		}
+		mp.pos += end + 1
+		return arg, nil
+
+	case "plural", "selectordinal", "select":
+		if !mp.consume(',') {
+			return nil, mp.errorf("expected ','")
+		}
+		if err := mp.cases(arg, inPlural); err != nil {
+			return nil, err
+		}
+		return arg, nil
+	}
+	return nil, mp.errorf("unsupported argument type %q", arg.kind)
+}
+
+// cases parses the "key {message}" cases of a complex argument up to its
+// closing brace.
+func (mp *msgParser) cases(arg *msgArg, inPlural bool) error {
+	if arg.kind != "select" {
+		inPlural = true
+		mp.space()
+		if strings.HasPrefix(mp.s[mp.pos:], "offset:") {
+			mp.pos += len("offset:")
+			offset, err := decimal.Parse(mp.word())
+			if err != nil {
+				return mp.errorf("invalid offset: %v", err)
+			}
+			arg.offset = offset
+		}
+	}
+
+	hasOther := false
+	for {
+		if mp.consume('}') {
+			break
+		}
+		key := mp.word()
+		if key == "" {
+			return mp.errorf("missing case key")
+		}
+		if !mp.consume('{') {
+			return mp.errorf("expected '{' after case %q", key)
+		}
+		msg, err := mp.message(inPlural)
+		if err != nil {
+			return err
+		}
+		if !mp.consume('}') {
+			return mp.errorf("unterminated case %q", key)
+		}
+		arg.cases = append(arg.cases, msgCase{key: key, msg: msg})
+		hasOther = hasOther || key == "other"
+	}
+
+	if !hasOther {
+		return mp.errorf("argument %q has no other case", arg.name)
+	}
+	return nil
+}
+
+// word skips white space and returns the following run of characters up to
+// white space or a syntax character.
+func (mp *msgParser) word() string {
+	mp.space()
+	start := mp.pos
+	for mp.pos < len(mp.s) && strings.IndexByte(" \t\r\n{},", mp.s[mp.pos]) < 0 {
+		mp.pos++
+	}
+	return mp.s[start:mp.pos]
+}
+
+// consume skips white space and the byte c, reporting whether c was found.
+func (mp *msgParser) consume(c byte) bool {
+	mp.space()
+	if mp.pos < len(mp.s) && mp.s[mp.pos] == c {
+		mp.pos++
+		return true
+	}
+	return false
+}
+
+func (mp *msgParser) space() {
+	for mp.pos < len(mp.s) && strings.IndexByte(" \t\r\n", mp.s[mp.pos]) >= 0 {
+		mp.pos++
+	}
+}
+
+// msgContext carries the state of a FormatMessage call.
+type msgContext struct {
+	h      *Humanizer
+	loc    Locale
+	p      *message.Printer
+	locale language.Tag
+	args   map[string]any
+	opts   Options
+}
+
+// FormatMessage formats m with the named arguments. Numeric arguments may
+// be given as Go numbers, decimal.Decimal or numeric strings.
+//
+// The "#" placeholder of plural arguments is compacted with the decimal
+// patterns of the configured Option, e.g. "1.2K followers", and the case is
+// selected with the locale's PluralForm on the displayed number, as in
+// FormatUnit, so the plural case always agrees with the rendering. When
+// opts has no precision, compact numbers are rounded to one fraction digit.
+// Selectordinal arguments use the OrdinalLocale rules and are never
+// compacted.
+func (h *Humanizer) FormatMessage(m *Message, args map[string]any, locale language.Tag, opts Options) (string, error) {
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.Precision.IsExact() {
+		opts.Precision = FractionDigits(0, 1)
+	}
+	ctx := msgContext{h: h, loc: loc, p: message.NewPrinter(locale), locale: locale, args: args, opts: opts}
+
+	var b strings.Builder
+	if err := ctx.format(&b, m, ""); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// format writes m to b. pound is the rendering of the "#" placeholder of
+// the innermost plural argument.
+func (ctx msgContext) format(b *strings.Builder, m *Message, pound string) error {
+	for _, node := range m.nodes {
+		switch {
+		case node.pound:
+			b.WriteString(pound)
+		case node.arg != nil:
+			if err := ctx.argument(b, node.arg, pound); err != nil {
+				return err
+			}
+		default:
+			b.WriteString(node.text)
+		}
+	}
+	return nil
+}
+
+func (ctx msgContext) argument(b *strings.Builder, arg *msgArg, pound string) error {
+	value, ok := ctx.args[arg.name]
+	if !ok {
+		return fmt.Errorf("message argument %q not found", arg.name)
+	}
+
+	if arg.kind == "select" {
+		key := fmt.Sprint(value)
+		return ctx.format(b, arg.selectCase(key), pound)
+	}
+
+	if s, ok := value.(string); ok && arg.kind == "" {
+		b.WriteString(s)
+		return nil
+	}
+
+	v, err := msgDecimal(value)
+	if err != nil {
+		return fmt.Errorf("message argument %q: %w", arg.name, err)
+	}
+
+	switch arg.kind {
+	case "plural", "selectordinal":
+		return ctx.plural(b, arg, v)
+	case "number":
+		s, err := ctx.number(v, arg.style)
+		if err != nil {
+			return err
+		}
+		b.WriteString(s)
+	default:
+		b.WriteString(applySign(formatNumber(ctx.p, v.Abs(), Precision{}), v.Sign(), ctx.loc, SignAuto))
+	}
+	return nil
+}
+
+// plural formats a plural or selectordinal argument: an "=N" case matching
+// v exactly wins, otherwise the case of the plural form of v minus the
+// offset, falling back to "other".
+func (ctx msgContext) plural(b *strings.Builder, arg *msgArg, v decimal.Decimal) error {
+	rest, err := v.Sub(arg.offset)
+	if err != nil {
+		return err
+	}
+
+	var num, form string
+	if arg.kind == "selectordinal" {
+		num = applySign(formatNumber(ctx.p, rest.Abs(), Precision{}), rest.Sign(), ctx.loc, SignAuto)
+		form = "other"
+		if ol, ok := ctx.loc.(OrdinalLocale); ok && rest.IsInt() {
+			form = ol.OrdinalForm(rest.Abs())
+		}
+	} else {
+		num, form = ctx.h.displayNumber(ctx.loc, ctx.p, rest, "", ctx.opts)
+	}
+
+	for _, c := range arg.cases {
+		if exact, ok := strings.CutPrefix(c.key, "="); ok {
+			if n, err := decimal.Parse(exact); err == nil && n.Cmp(v) == 0 {
+				return ctx.format(b, c.msg, num)
+			}
+		}
+	}
+	return ctx.format(b, arg.selectCase(form), num)
+}
+
+// selectCase returns the message of the case key, or of the "other" case.
+func (arg *msgArg) selectCase(key string) *Message {
+	var other *Message
+	for _, c := range arg.cases {
+		if c.key == key {
+			return c.msg
+		}
+		if c.key == "other" {
+			other = c.msg
+		}
+	}
+	return other
+}
+
+// number formats a number argument in the given style.
+func (ctx msgContext) number(v decimal.Decimal, style string) (string, error) {
+	switch style {
+	case "integer":
+		d := roundDecimal(v.Abs(), 0, ctx.opts.Rounding)
+		return applySign(formatNumber(ctx.p, d, FractionDigits(0, 0)), v.Sign()*d.Sign(), ctx.loc, SignAuto), nil
+	case "percent":
+		return ctx.h.FormatPercent(v.String(), ctx.locale, Options{})
+	case "compact-short", "compact-long":
+		df := decimalFormatStyle(ctx.loc, Short)
+		if style == "compact-long" {
+			df = decimalFormatStyle(ctx.loc, Long)
+		}
+		displayed := ctx.opts.round(v.Abs())
+		num := formatNumber(ctx.p, displayed, ctx.opts.Precision)
+		if c, ok := compactDecimal(ctx.loc, df, v.Abs(), ctx.opts); ok {
+			num = c.format(ctx.p, ctx.opts.Precision)
+			displayed = c.value()
+		}
+		return applySign(num, v.Sign()*displayed.Sign(), ctx.loc, ctx.opts.SignDisplay), nil
+	}
+	return applySign(formatNumber(ctx.p, v.Abs(), Precision{}), v.Sign(), ctx.loc, SignAuto), nil
+}
+
+// msgDecimal converts a numeric message argument to a decimal.
+func msgDecimal(value any) (decimal.Decimal, error) {
+	switch v := value.(type) {
+	case decimal.Decimal:
+		return v, nil
+	case int:
+		return decimal.New(int64(v), 0)
+	case int64:
+		return decimal.New(v, 0)
+	case float64:
+		return decimal.NewFromFloat64(v)
+	case string:
+		return decimal.Parse(v)
+	}
+	return decimal.Parse(fmt.Sprint(value))
+}
@@ -0,0 +1,125 @@
+package humanizecompact
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// numericSystems holds the digits of the numeric numbering systems that
+// can be selected by the -u-nu- extension for any locale.
+var numericSystems = map[string][]rune{
+	"hanidec": []rune("〇一二三四五六七八九"),
+}
+
+// traditionalScales writes the scale characters of the compact patterns in
+// traditional Chinese for the numbering systems that use it, e.g. "一點二萬"
+// rather than "一點二万" with "hant".
+var traditionalScales = map[string]*strings.Replacer{
+	"hant":    strings.NewReplacer("万", "萬", "亿", "億"),
+	"hantfin": strings.NewReplacer("万", "萬", "亿", "億"),
+}
+
+// applyNumberingSystem rewrites the numbers of the formatted text s in the
+// numbering system selected by the -u-nu- extension of tag. Numeric systems
+// replace the digits, e.g. "一.二万" with "hanidec"; algorithmic systems of
+// CldrData.NumberingSystems spell each number with their rule set, e.g.
+// "一・二万" with "jpan" or "壱万弐千" with "jpanfin", and the traditional
+// systems also write the scales in traditional characters. Other numbering
+// systems leave s unchanged.
+func (h *Humanizer) applyNumberingSystem(s string, loc Locale, p *message.Printer, tag language.Tag) string {
+	nu := tag.TypeForKey("nu")
+	if nu == "" {
+		return s
+	}
+	if digits, ok := numericSystems[nu]; ok {
+		return strings.Map(func(r rune) rune {
+			if r >= '0' && r <= '9' {
+				return digits[r-'0']
+			}
+			return r
+		}, s)
+	}
+
+	set, ok := loc.Data().NumberingSystems[nu]
+	if !ok {
+		return s
+	}
+	rbf, err := h.ruleBasedFormat(loc)
+	if err != nil {
+		return s
+	}
+	f := newRBNFFormatter(rbf, loc, p)
+	s = replaceNumbers(s, p, func(d decimal.Decimal) (string, error) {
+		return f.format(d, set)
+	})
+	if r, ok := traditionalScales[nu]; ok {
+		s = r.Replace(s)
+	}
+	return s
+}
+
+// replaceNumbers replaces each number of s, written with the separators of
+// the printer's locale, by its rendering with fn. Numbers fn fails on are
+// kept.
+func replaceNumbers(s string, p *message.Printer, fn func(decimal.Decimal) (string, error)) string {
+	group, point := numberSeparators(p)
+	isDigit := func(i int) bool { return i < len(s) && s[i] >= '0' && s[i] <= '9' }
+
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if !isDigit(i) {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			b.WriteRune(r)
+			i += size
+			continue
+		}
+
+		// A number is a run of digits with separators between digits.
+		var num strings.Builder
+		j := i
+		for j < len(s) {
+			if isDigit(j) {
+				num.WriteByte(s[j])
+				j++
+				continue
+			}
+			r, size := utf8.DecodeRuneInString(s[j:])
+			if (r != group && r != point) || !isDigit(j+size) {
+				break
+			}
+			if r == point {
+				num.WriteByte('.')
+			}
+			j += size
+		}
+
+		out := s[i:j]
+		if d, err := decimal.Parse(num.String()); err == nil {
+			if text, err := fn(d); err == nil {
+				out = text
+			}
+		}
+		b.WriteString(out)
+		i = j
+	}
+	return b.String()
+}
+
+// numberSeparators returns the grouping separator and the decimal separator
+// of the printer's locale.
+func numberSeparators(p *message.Printer) (group, point rune) {
+	sample, _ := decimal.New(12345, 1)
+	runes := []rune(formatNumber(p, sample, FractionDigits(1, 1)))
+	if len(runes) < 2 {
+		return 0, '.'
+	}
+	point = runes[len(runes)-2]
+	if len(runes) > 6 {
+		group = runes[1]
+	}
+	return group, point
+}
@@ -0,0 +1,400 @@
+package humanizecompact
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// Options refines a single formatting call. The zero value selects the
+// defaults of each method.
+type Options struct {
+	// CurrencyDisplay selects how currencies are shown by FormatCurrency.
+	CurrencyDisplay CurrencyDisplay
+
+	// Precision controls how the displayed number is rounded. The zero
+	// value keeps the exact-representation rules of FormatDecimal.
+	Precision Precision
+
+	// Rounding selects the rounding mode used by Precision.
+	Rounding RoundingMode
+
+	// SignDisplay controls when the plus and minus signs are shown.
+	SignDisplay SignDisplay
+
+	// MaxUnits is the number of units FormatDuration may combine, e.g. 2
+	// for "1 hr, 30 min". Zero and one show a single unit. With Composite
+	// it is the number of parts kept, and zero keeps them all.
+	MaxUnits int
+
+	// Numeric selects whether FormatRelative may use special words such
+	// as "yesterday".
+	Numeric NumericDisplay
+
+	// SpellSmallNumbers spells out integers up to this magnitude with the
+	// Long style, e.g. 5 for "five days". Zero disables spelling out.
+	// Units spell numbers in agreement with their CLDR grammatical gender,
+	// e.g. "одна минута"; nouns of unknown gender, such as those of
+	// FormatCount and FormatRelative, are not spelled out in locales whose
+	// cardinals are gendered unless SpellOutRuleSet is set.
+	SpellSmallNumbers int
+
+	// SpellOutRuleSet is the rule set used by SpellSmallNumbers, e.g.
+	// "spellout-cardinal-feminine" for a feminine noun. Empty selects it
+	// from the gender of the unit, or "spellout-numbering" for numbers.
+	SpellOutRuleSet string
+
+	// CompactThreshold is the smallest magnitude that is compacted, e.g.
+	// 10000 to show "1,234" but "12K". Smaller numbers are shown in full
+	// with the grouping separators of the locale. Zero uses the threshold
+	// of the locale.
+	CompactThreshold int64
+
+	// FixedScale forces the compact pattern of 10^FixedScale, e.g. 6 to
+	// show every number in millions ("0.95M" rather than "950K") so the
+	// cells of a column share a unit. Without a precision the number is
+	// rounded to two fraction digits. Zero selects the scale by value.
+	// See Humanizer.CommonScaleName.
+	FixedScale int
+
+	// Selection selects how the compact pattern is chosen. The zero value
+	// keeps the smallest ratio among the scales of the locale.
+	Selection PatternSelection
+
+	// Composite shows numbers as a sum of the scales of the locale rather
+	// than rounded in one scale, e.g. "1億2345万6789" instead of "1.2億", or
+	// "1 million 234 thousand 567" with the Long option. When MaxUnits
+	// drops parts, the last part is rounded with Precision, or to an
+	// integer without one.
+	Composite bool
+
+	// Approximately prefixes the locale's approximately sign, e.g. "~1.2M",
+	// when rounding makes the displayed number differ from the value.
+	Approximately bool
+
+	// Cap limits the displayed value of counters: values above it are
+	// shown as Cap with the locale's at-least pattern, e.g. "99K+" for a
+	// cap of 99,000. When set, numbers are rounded with RoundFloor so the
+	// display never exceeds the value. A cap that cannot be compacted is
+	// shown in full, e.g. "999+", rather than with the fallback. Zero
+	// disables capping.
+	Cap int64
+}
+
+// Precision describes how many digits of a number are displayed.
+// The zero value means the number must be displayed exactly.
+type Precision struct {
+	set         bool
+	minFraction int
+	maxFraction int
+
+	// minSignificant and maxSignificant are zero unless the precision
+	// counts significant digits. With both fraction and significant
+	// digits, the one keeping more digits applies.
+	minSignificant int
+	maxSignificant int
+	fraction       bool
+}
+
+// FractionDigits returns a Precision that rounds to at most max fraction
+// digits and pads with zeros to at least min fraction digits.
+func FractionDigits(min, max int) Precision {
+	if max < min {
+		max = min
+	}
+	return Precision{set: true, minFraction: min, maxFraction: max, fraction: true}
+}
+
+// SignificantDigits returns a Precision that rounds to at most max
+// significant digits and pads with zeros to at least min significant
+// digits, e.g. "1.2K", "12K" and "120K" for two significant digits.
+func SignificantDigits(min, max int) Precision {
+	if min < 1 {
+		min = 1
+	}
+	if max < min {
+		max = min
+	}
+	return Precision{set: true, minSignificant: min, maxSignificant: max}
+}
+
+// CompactDigits returns the default precision of ICU compact notation:
+// numbers are rounded to integers but keep at least two significant
+// digits, e.g. "1.2K", "12K" and "123K".
+func CompactDigits() Precision {
+	return Precision{set: true, minSignificant: 1, maxSignificant: 2, fraction: true}
+}
+
+// scale returns the number of fraction digits d is rounded to. It is
+// negative when significant digits round d to tens or more.
+func (p Precision) scale(d decimal.Decimal) int {
+	if p.maxSignificant == 0 {
+		return p.maxFraction
+	}
+	scale := p.maxSignificant - exponent(d)
+	if p.fraction {
+		scale = max(scale, p.maxFraction)
+	}
+	return scale
+}
+
+// fractionBounds returns the minimum and maximum number of fraction digits
+// displayed for the rounded number d.
+func (p Precision) fractionBounds(d decimal.Decimal) (int, int) {
+	if p.maxSignificant == 0 {
+		return p.minFraction, p.maxFraction
+	}
+	minFrac := p.minSignificant - exponent(d)
+	if p.fraction {
+		minFrac = max(minFrac, p.minFraction)
+	}
+	return max(minFrac, 0), max(p.scale(d), 0)
+}
+
+// exponent returns the number of digits of d before the decimal point,
+// counting the zeros after the point of numbers below one as negative
+// digits, e.g. 3 for 123.4 and -1 for 0.012.
+func exponent(d decimal.Decimal) int {
+	d = d.Trim(0)
+	if d.IsZero() {
+		return 1
+	}
+	return d.Prec() - d.Scale()
+}
+
+// IsExact reports whether p is the zero Precision, i.e. the number is
+// displayed only when it can be represented without rounding.
+func (p Precision) IsExact() bool {
+	return !p.set
+}
+
+// RoundingMode selects how a number is rounded to the requested precision.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest neighbour, ties to even.
+	RoundHalfEven RoundingMode = iota
+
+	// RoundHalfUp rounds to the nearest neighbour, ties away from zero.
+	RoundHalfUp
+
+	// RoundDown rounds towards zero.
+	RoundDown
+
+	// RoundUp rounds away from zero.
+	RoundUp
+
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+)
+
+// SignDisplay controls when the sign of a number is shown. Numbers left to
+// the fallback function are signed too: with a mode other than SignAuto
+// the fallback receives the absolute value, e.g. "12" for "+12".
+type SignDisplay int
+
+const (
+	// SignAuto shows the minus sign for negative numbers only.
+	SignAuto SignDisplay = iota
+
+	// SignAlways shows the plus sign for positive numbers and zero.
+	SignAlways
+
+	// SignExceptZero shows the plus sign for positive numbers but no
+	// sign for zero.
+	SignExceptZero
+
+	// SignNever shows no sign at all.
+	SignNever
+
+	// SignAccounting wraps negative numbers in the locale's accounting
+	// form, e.g. "(1.2K)", and shows no sign for other numbers. Locales
+	// without a parenthesized accounting form use the minus sign.
+	SignAccounting
+)
+
+// NumberSymbols contains the locale-specific number symbols according to
+// the CLDR specification.
+type NumberSymbols struct {
+	PlusSign    string
+	MinusSign   string
+	PercentSign string
+	PerMille    string
+}
+
+// roundDecimal rounds d to the given number of fraction digits using mode.
+func roundDecimal(d decimal.Decimal, scale int, mode RoundingMode) decimal.Decimal {
+	switch mode {
+	case RoundDown:
+		return d.Trunc(scale)
+	case RoundUp:
+		if d.IsNeg() {
+			return d.Floor(scale)
+		}
+		return d.Ceil(scale)
+	case RoundFloor:
+		return d.Floor(scale)
+	case RoundCeiling:
+		return d.Ceil(scale)
+	case RoundHalfUp:
+		t := d.Trunc(scale)
+		rest, err := d.Sub(t)
+		if err != nil {
+			return d.Round(scale)
+		}
+		half, _ := decimal.New(5, scale+1)
+		if rest.Abs().Cmp(half) >= 0 {
+			if d.IsNeg() {
+				return d.Floor(scale)
+			}
+			return d.Ceil(scale)
+		}
+		return t
+	default:
+		return d.Round(scale)
+	}
+}
+
+// round applies the precision of opts to d. The zero Precision leaves
+// d unchanged.
+func (o Options) round(d decimal.Decimal) decimal.Decimal {
+	if o.Precision.IsExact() {
+		return d
+	}
+	scale := o.Precision.scale(d)
+	if scale >= 0 {
+		return roundDecimal(d, scale, o.Rounding).Trim(0)
+	}
+
+	// Round to tens or more by rounding the quotient to an integer.
+	unit, _ := decimal.New(1, 0)
+	ten, _ := decimal.New(10, 0)
+	for i := scale; i < 0; i++ {
+		next, err := unit.Mul(ten)
+		if err != nil {
+			return d
+		}
+		unit = next
+	}
+	q, err := d.Quo(unit)
+	if err != nil {
+		return d
+	}
+	r, err := roundDecimal(q, 0, o.Rounding).Mul(unit)
+	if err != nil {
+		return d
+	}
+	return r.Trim(0)
+}
+
+// belowThreshold reports whether the non-negative value v is below the
+// compaction threshold of opts, or of loc when opts has none.
+func belowThreshold(loc Locale, v decimal.Decimal, opts Options) bool {
+	threshold := opts.CompactThreshold
+	if threshold == 0 {
+		threshold = loc.Data().CompactThreshold
+	}
+	if threshold <= 0 {
+		return false
+	}
+	limit, _ := decimal.New(threshold, 0)
+	return v.Cmp(limit) < 0
+}
+
+// capped applies the Cap of o to d. It returns the value to display, the
+// options to display it with and whether d exceeded the cap.
+func (o Options) capped(d decimal.Decimal) (decimal.Decimal, Options, bool) {
+	if o.Cap <= 0 {
+		return d, o, false
+	}
+	o.Rounding = RoundFloor
+	limit, _ := decimal.New(o.Cap, 0)
+	if d.Cmp(limit) <= 0 {
+		return d, o, false
+	}
+	o.Approximately = false
+	return limit, o, true
+}
+
+// formatNumber renders the non-negative number d with the digits and
+// separators of the printer's locale. Without a precision all fraction
+// digits of d are kept.
+func formatNumber(p *message.Printer, d decimal.Decimal, prec Precision, opts ...number.Option) string {
+	minFrac, maxFrac := 0, d.Scale()
+	if !prec.IsExact() {
+		minFrac, maxFrac = prec.fractionBounds(d)
+	}
+	f, _ := d.Float64()
+	opts = append(opts, number.MinFractionDigits(minFrac), number.MaxFractionDigits(maxFrac))
+	return p.Sprint(number.Decimal(f, opts...))
+}
+
+// groupedNumber renders the non-negative number d like formatNumber but
+// omits the grouping separators when the integer part is shorter than the
+// minimum grouping digits of loc allow, e.g. Spanish "1234".
+func groupedNumber(loc Locale, p *message.Printer, d decimal.Decimal, prec Precision) string {
+	if minGroup := loc.Data().MinimumGroupingDigits; minGroup > 1 && exponent(d) < 3+minGroup {
+		return formatNumber(p, d, prec, number.NoSeparator())
+	}
+	return formatNumber(p, d, prec)
+}
+
+// applySign prefixes s with the sign of loc selected by mode. The sign is
+// derived from the displayed value, so a negative number rounded to zero is
+// zero.
+func applySign(s string, sign int, loc Locale, mode SignDisplay) string {
+	sym := loc.Data().Symbols
+	minus, plus := sym.MinusSign, sym.PlusSign
+	if minus == "" {
+		minus = "-"
+	}
+	if plus == "" {
+		plus = "+"
+	}
+
+	switch {
+	case mode == SignNever:
+		return s
+	case sign < 0 && mode == SignAccounting:
+		if prefix, suffix, ok := accountingAffixes(loc.Data().CurrencyFormat.Accounting); ok {
+			return prefix + s + suffix
+		}
+		return minus + s
+	case sign < 0:
+		return minus + s
+	case sign > 0 && (mode == SignAlways || mode == SignExceptZero):
+		return plus + s
+	case sign == 0 && mode == SignAlways:
+		return plus + s
+	}
+	return s
+}
+
+// accountingAffixes returns the text around the number in the negative
+// subpattern of a CLDR accounting pattern, e.g. "(" and ")" for
+// "¤#,##0.00;(¤#,##0.00)". The currency sign is dropped so the affixes can
+// wrap any formatted number. It reports false when the pattern has no
+// parenthesized negative subpattern.
+func accountingAffixes(pattern string) (prefix, suffix string, ok bool) {
+	_, neg, found := strings.Cut(pattern, ";")
+	if !found || !strings.Contains(neg, "(") {
+		return "", "", false
+	}
+	start := strings.IndexAny(neg, "#0")
+	if start < 0 {
+		return "", "", false
+	}
+	end := start
+	for end < len(neg) && strings.IndexByte("#0,.", neg[end]) >= 0 {
+		end++
+	}
+	prefix = strings.TrimRightFunc(strings.Replace(neg[:start], "¤", "", 1), unicode.IsSpace)
+	suffix = strings.TrimLeftFunc(strings.Replace(neg[end:], "¤", "", 1), unicode.IsSpace)
+	return prefix, suffix, true
+}
@@ -0,0 +1,77 @@
+package humanizecompact
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// OrdinalLocale is an optional Locale capability providing the CLDR
+// ordinal plural rules, which select the ordinal pattern of a rank, e.g.
+// "two" for 22 in English ("22nd"). Locales that do not implement it use
+// the "other" pattern for every rank.
+type OrdinalLocale interface {
+	// OrdinalForm returns the ordinal plural category of the integer n,
+	// e.g. "one", "two", "few" or "other".
+	OrdinalForm(n decimal.Decimal) string
+}
+
+// OrdinalSuffixLocale is an optional Locale capability for locales whose
+// ordinal suffix depends on the digits of a rank rather than on a plural
+// form, following the CLDR digits-ordinal rules, e.g. Bulgarian "1-ви",
+// "2-ри" and "100-тен". FormatOrdinal uses it for locales without ordinal
+// patterns.
+type OrdinalSuffixLocale interface {
+	// OrdinalSuffix returns the suffix of the non-negative integer n,
+	// e.g. "ви" for 21.
+	OrdinalSuffix(n decimal.Decimal) string
+}
+
+// FormatOrdinal formats the integer value as an ordinal rank using the
+// locale's ordinal patterns, e.g. "22nd", "3e", "1.", "第1" or "1-й".
+//
+// Locales whose ordinal suffix depends on the digits rather than on a plural
+// form, such as Bulgarian ("1-ви", "2-ри", "100-тен"), implement
+// OrdinalSuffixLocale instead of providing patterns.
+//
+// Ordinals are never compacted: a compact rank such as "1.2K-th" does not
+// identify a position, so large ranks are rendered in full with the grouping
+// separators of the locale, e.g. "1,234,567th".
+func (h *Humanizer) FormatOrdinal(value string, locale language.Tag, opts Options) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+	if !valDec.IsInt() {
+		return "", InvalidNumberError{Value: value, Err: errors.New("ordinal is not an integer")}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	p := message.NewPrinter(locale)
+	num := formatNumber(p, valDec.Abs().Trunc(0), FractionDigits(0, 0))
+
+	patterns := loc.Data().Ordinal
+	if len(patterns) == 0 {
+		sl, ok := loc.(OrdinalSuffixLocale)
+		if !ok {
+			return "", fmt.Errorf("ordinal patterns not found for locale %q", locale)
+		}
+		num += "-" + sl.OrdinalSuffix(valDec.Abs())
+		return applySign(num, valDec.Sign(), loc, opts.SignDisplay), nil
+	}
+
+	form := "other"
+	if ol, ok := loc.(OrdinalLocale); ok {
+		form = ol.OrdinalForm(valDec.Abs())
+	}
+
+	num = applySign(num, valDec.Sign(), loc, opts.SignDisplay)
+	return unitPattern(patterns, form, num), nil
+}
@@ -0,0 +1,92 @@
+package humanizecompact
+
+import (
+	"strings"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// percentUnit describes one of the per-N signs supported by formatPercent.
+type percentUnit struct {
+	factor int64
+	symbol func(NumberSymbols) string
+}
+
+var (
+	percentSign    = percentUnit{100, func(s NumberSymbols) string { return orDefault(s.PercentSign, "%") }}
+	perMilleSign   = percentUnit{1000, func(s NumberSymbols) string { return orDefault(s.PerMille, "‰") }}
+	basisPointSign = percentUnit{10000, func(NumberSymbols) string { return "‱" }}
+)
+
+// FormatPercent formats the ratio value as a percentage, e.g. "12.5%" for
+// "0.125". Percentages of a thousand and more are compacted with the short
+// decimal patterns of the locale, e.g. "1.2K%".
+func (h *Humanizer) FormatPercent(value string, locale language.Tag, opts Options) (string, error) {
+	return h.formatPercent(value, locale, opts, percentSign)
+}
+
+// FormatPermille formats the ratio value per mille, e.g. "125‰" for
+// "0.125".
+func (h *Humanizer) FormatPermille(value string, locale language.Tag, opts Options) (string, error) {
+	return h.formatPercent(value, locale, opts, perMilleSign)
+}
+
+// FormatBasisPoints formats the ratio value in basis points (per ten
+// thousand), e.g. "25‱" for "0.0025".
+func (h *Humanizer) FormatBasisPoints(value string, locale language.Tag, opts Options) (string, error) {
+	return h.formatPercent(value, locale, opts, basisPointSign)
+}
+
+func (h *Humanizer) formatPercent(value string, locale language.Tag, opts Options, unit percentUnit) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	factor, _ := decimal.New(unit.factor, 0)
+	scaled, err := valDec.Mul(factor)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	data := loc.Data()
+	p := message.NewPrinter(locale)
+
+	var num string
+	displayed := opts.round(scaled.Abs())
+	if c, ok := compactDecimal(loc, data.Short.DecimalFormat, scaled.Abs(), opts); ok {
+		num = c.format(p, opts.Precision)
+	} else {
+		num = formatNumber(p, displayed, opts.Precision)
+	}
+
+	pattern := data.PercentFormat
+	if pattern == "" {
+		pattern = "#,##0%"
+	}
+	out := replaceNumberPattern(pattern, num)
+	out = strings.Replace(out, "%", unit.symbol(data.Symbols), 1)
+
+	// As in ICU, the sign comes from the value, so -0.0001 is "-0%", except
+	// that SignExceptZero treats a value rounded to zero as zero.
+	sign := valDec.Sign()
+	if displayed.IsZero() && opts.SignDisplay == SignExceptZero {
+		sign = 0
+	}
+	return applySign(out, sign, loc, opts.SignDisplay), nil
+}
+
+// orDefault returns s, or def when s is empty.
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
@@ -0,0 +1,159 @@
+package humanizecompact
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// MiscPatterns contains the CLDR miscellaneous number patterns.
+type MiscPatterns struct {
+	// Range joins the ends of a range, e.g. "{0}–{1}".
+	Range string
+
+	// Approximately marks a number as approximate, e.g. "~{0}".
+	Approximately string
+
+	// AtLeast marks a number as a lower bound, e.g. "{0}+" or "Más de {0}".
+	AtLeast string
+}
+
+// rangeEnd is one end of a range rendered with the decimal patterns.
+type rangeEnd struct {
+	bare      string // the number without sign and compact pattern
+	num       string // the signed number without the compact pattern
+	tmpl      string // the compact pattern, empty when not compacted
+	displayed decimal.Decimal
+	form      string
+}
+
+// text returns the full rendering of the end.
+func (e rangeEnd) text() string {
+	if e.tmpl == "" {
+		return e.num
+	}
+	return replacePlaceholder(e.tmpl, e.num)
+}
+
+// FormatRange formats the range from lo to hi with the locale's CLDR range
+// pattern, e.g. "1–5K", "1K – 2M" or "1–5 тыс.". Both ends are compacted
+// with the decimal patterns of the configured Option; when opts has no
+// precision they are rounded to one fraction digit.
+//
+// Reversed ends are swapped, so 5000 to 1000 is also "1–5K". When both
+// ends have the same sign and use the same compact pattern, the pattern is
+// shown once ("1–5K" rather than "1K–5K"). Otherwise, as in ICU, spaces are added
+// around the range sign when the first end has a suffix or a sign. Ends
+// that display the same value are rendered once, with the CLDR
+// approximately pattern unless the values were equal before rounding,
+// e.g. "~1.2K".
+func (h *Humanizer) FormatRange(lo, hi string, locale language.Tag, opts Options) (string, error) {
+	out, _, err := h.formatRange(lo, hi, locale, opts)
+	return out, err
+}
+
+// FormatCountRange is like FormatRange but is followed by the form of noun
+// agreeing with the range, e.g. "1–5K followers". The plural form of the
+// range is derived from the forms of its ends with the CLDR plural ranges,
+// which mostly select the form of the upper end.
+func (h *Humanizer) FormatCountRange(lo, hi string, noun Noun, locale language.Tag, opts Options) (string, error) {
+	out, form, err := h.formatRange(lo, hi, locale, opts)
+	if err != nil {
+		return "", err
+	}
+	return nounPattern(noun, form, out), nil
+}
+
+// formatRange returns the rendering of the range and its plural form.
+func (h *Humanizer) formatRange(lo, hi string, locale language.Tag, opts Options) (string, string, error) {
+	loDec, err := decimal.Parse(lo)
+	if err != nil {
+		return "", "", InvalidNumberError{Value: lo, Err: err}
+	}
+	hiDec, err := decimal.Parse(hi)
+	if err != nil {
+		return "", "", InvalidNumberError{Value: hi, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", "", err
+	}
+	if loDec.Cmp(hiDec) > 0 {
+		loDec, hiDec = hiDec, loDec
+	}
+
+	if opts.Precision.IsExact() {
+		opts.Precision = FractionDigits(0, 1)
+	}
+	p := message.NewPrinter(locale)
+	first := h.rangeEnd(loc, p, loDec, opts)
+	second := h.rangeEnd(loc, p, hiDec, opts)
+
+	misc := loc.Data().MiscPatterns
+	if first.text() == second.text() {
+		if loDec.Cmp(hiDec) == 0 {
+			return first.text(), first.form, nil
+		}
+		return approximately(loc, first.text()), first.form, nil
+	}
+
+	form := pluralRange(loc, first.form, second.form)
+	pattern := orDefault(misc.Range, "{0}–{1}")
+	if first.tmpl != "" && first.tmpl == second.tmpl && loDec.IsNeg() == hiDec.IsNeg() {
+		return replacePlaceholder(first.tmpl, joinRange(pattern, first.num, second.num, first.num != first.bare)), form, nil
+	}
+	return joinRange(pattern, first.text(), second.text(), first.text() != first.bare), form, nil
+}
+
+// rangeEnd renders v compactly when possible.
+func (h *Humanizer) rangeEnd(loc Locale, p *message.Printer, v decimal.Decimal, opts Options) rangeEnd {
+	end := rangeEnd{displayed: opts.round(v.Abs())}
+	if c, ok := compactDecimal(loc, h.decimalFormat(loc), v.Abs(), opts); ok {
+		end.bare = formatNumber(p, c.ratio, opts.Precision)
+		end.tmpl = c.tmpl
+		end.displayed = c.value()
+	} else {
+		end.bare = formatNumber(p, end.displayed, opts.Precision)
+	}
+	end.num = applySign(end.bare, v.Sign()*end.displayed.Sign(), loc, opts.SignDisplay)
+	end.form = loc.PluralForm(end.displayed, end.displayed.String())
+	return end
+}
+
+// joinRange substitutes a and b into the range pattern. With spaced set,
+// spaces are added around the range sign unless the pattern has them.
+func joinRange(pattern, a, b string, spaced bool) string {
+	if spaced {
+		if i := strings.Index(pattern, "{0}") + len("{0}"); i < len(pattern) && !startsWithSpace(pattern[i:]) {
+			pattern = pattern[:i] + " " + pattern[i:]
+		}
+		if i := strings.Index(pattern, "{1}"); i > 0 && !endsWithSpace(pattern[:i]) {
+			pattern = pattern[:i] + " " + pattern[i:]
+		}
+	}
+	return strings.NewReplacer("{0}", a, "{1}", b).Replace(pattern)
+}
+
+func startsWithSpace(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsSpace(r)
+}
+
+func endsWithSpace(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsSpace(r)
+}
+
+// pluralRange returns the plural form of a range whose ends have the plural
+// forms start and end.
+func pluralRange(loc Locale, start, end string) string {
+	if form, ok := loc.Data().PluralRanges[start+"-"+end]; ok {
+		return form
+	}
+	return end
+}
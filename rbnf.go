@@ -0,0 +1,718 @@
+package humanizecompact
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// ruleBasedFormat is a parsed set of CLDR rule-based number format (RBNF)
+// rules, e.g. the spell-out rules of a locale. The rules use the ICU
+// syntax: a rule set starts with a "%name:" line and holds one
+// "descriptor: body;" rule per line.
+type ruleBasedFormat struct {
+	sets map[string]*ruleSet
+}
+
+// ruleSet is a named list of rules. Normal rules are sorted by base value.
+type ruleSet struct {
+	name     string
+	rules    []*rbnfRule
+	negative *rbnfRule // "-x"
+	improper *rbnfRule // "x.x"
+	comma    *rbnfRule // "x,x", used by locales with a decimal comma
+	proper   *rbnfRule // "0.x"
+
+	// fraction is set for rule sets that format the fractional part of a
+	// number as a numerator over the base value of their rules.
+	fraction bool
+}
+
+// rbnfRule is a single rule of a rule set.
+type rbnfRule struct {
+	base    int64
+	divisor int64
+	index   int
+	parts   []rbnfPart
+}
+
+// rbnfPart is a piece of a rule body: literal text, a substitution, a
+// plural choice or an optional group written in brackets.
+type rbnfPart struct {
+	text     string
+	sub      *rbnfSubstitution
+	plural   *rbnfPlural
+	optional []rbnfPart
+}
+
+// rbnfSubstitution is a "<<", ">>" or "==" token, optionally naming a
+// rule set ("<%spellout-cardinal<") or a decimal pattern ("=#,##0=").
+type rbnfSubstitution struct {
+	kind    byte
+	ruleSet string
+	pattern string
+	bypass  bool // ">>>": the preceding rule, or fraction digits unspaced
+	zeros   bool // "<%set<<": a numerator with its leading zeros spelled
+}
+
+// rbnfPlural is a "$(cardinal,one{...}other{...})$" choice.
+type rbnfPlural struct {
+	ordinal bool
+	forms   map[string]string
+}
+
+// errNoRule is returned when no rule of a rule set applies to a number.
+var errNoRule = errors.New("no rule applies")
+
+// parseRuleBasedFormat parses rules written in the ICU RBNF syntax.
+func parseRuleBasedFormat(rules string) (*ruleBasedFormat, error) {
+	rbf := &ruleBasedFormat{sets: make(map[string]*ruleSet)}
+
+	var cur *ruleSet
+	for _, line := range strings.Split(rules, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "%") && strings.HasSuffix(line, ":") {
+			name := strings.TrimLeft(strings.TrimSuffix(line, ":"), "%")
+			cur = &ruleSet{name: name}
+			rbf.sets[name] = cur
+			continue
+		}
+		if cur == nil {
+			return nil, fmt.Errorf("rbnf: rule %q outside of a rule set", line)
+		}
+
+		desc, body, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("rbnf: malformed rule %q", line)
+		}
+		body = strings.TrimSuffix(strings.TrimLeft(body, " "), ";")
+		body = strings.TrimPrefix(body, "'")
+		parts, err := parseRuleBody(body)
+		if err != nil {
+			return nil, fmt.Errorf("rbnf: rule %q: %w", line, err)
+		}
+
+		rule := &rbnfRule{parts: parts, divisor: 1}
+		switch desc {
+		case "-x":
+			cur.negative = rule
+		case "x.x":
+			cur.improper = rule
+		case "x,x":
+			cur.comma = rule
+		case "0.x", "0,x":
+			if cur.proper == nil {
+				cur.proper = rule
+			}
+		case "Inf", "NaN", "x.0", "x,0":
+			// Not reachable with decimal values.
+		default:
+			if err := rule.parseDescriptor(desc); err != nil {
+				return nil, fmt.Errorf("rbnf: rule %q: %w", line, err)
+			}
+			rule.index = len(cur.rules)
+			cur.rules = append(cur.rules, rule)
+		}
+	}
+
+	// The fractional part of "x.x" and "0.x" rules naming another rule set
+	// is formatted by that set as a fraction.
+	for _, rs := range rbf.sets {
+		for _, rule := range []*rbnfRule{rs.improper, rs.comma, rs.proper} {
+			if rule == nil {
+				continue
+			}
+			for _, part := range rule.parts {
+				if part.sub != nil && part.sub.kind == '>' && part.sub.ruleSet != "" && part.sub.ruleSet != rs.name {
+					if fs, ok := rbf.sets[part.sub.ruleSet]; ok {
+						fs.fraction = true
+					}
+				}
+			}
+		}
+	}
+
+	for _, rs := range rbf.sets {
+		sort.SliceStable(rs.rules, func(i, j int) bool { return rs.rules[i].base < rs.rules[j].base })
+		for i, rule := range rs.rules {
+			rule.index = i
+		}
+	}
+
+	return rbf, nil
+}
+
+// parseDescriptor parses a numeric rule descriptor such as "1000",
+// "1010/100" (explicit radix) or "1000>" (divisor lowered by a power).
+func (r *rbnfRule) parseDescriptor(desc string) error {
+	lower := len(desc) - len(strings.TrimRight(desc, ">"))
+	desc = strings.TrimRight(desc, ">")
+
+	radix := int64(10)
+	if b, rd, ok := strings.Cut(desc, "/"); ok {
+		v, err := strconv.ParseInt(rd, 10, 64)
+		if err != nil || v < 2 {
+			return fmt.Errorf("invalid radix %q", rd)
+		}
+		radix, desc = v, b
+	}
+
+	base, err := strconv.ParseInt(strings.ReplaceAll(desc, ",", ""), 10, 64)
+	if err != nil || base < 0 {
+		return fmt.Errorf("invalid base value %q", desc)
+	}
+	r.base = base
+
+	exponent := 0
+	for d := radix; d <= base && d > 0; d *= radix {
+		exponent++
+		if d > base/radix {
+			break
+		}
+	}
+	exponent = max(exponent-lower, 0)
+	for i := 0; i < exponent; i++ {
+		r.divisor *= radix
+	}
+	return nil
+}
+
+// parseRuleBody splits a rule body into its parts.
+func parseRuleBody(body string) ([]rbnfPart, error) {
+	var parts []rbnfPart
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			parts = append(parts, rbnfPart{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(body); {
+		c := body[i]
+		switch {
+		case c == '[':
+			end := strings.IndexByte(body[i:], ']')
+			if end < 0 {
+				return nil, errors.New("unbalanced bracket")
+			}
+			inner, err := parseRuleBody(body[i+1 : i+end])
+			if err != nil {
+				return nil, err
+			}
+			flush()
+			parts = append(parts, rbnfPart{optional: inner})
+			i += end + 1
+
+		case strings.HasPrefix(body[i:], "$("):
+			end := strings.Index(body[i:], ")$")
+			if end < 0 {
+				return nil, errors.New("unterminated plural")
+			}
+			pl, err := parsePlural(body[i+2 : i+end])
+			if err != nil {
+				return nil, err
+			}
+			flush()
+			parts = append(parts, rbnfPart{plural: pl})
+			i += end + 2
+
+		case c == '<' || c == '>' || c == '=':
+			end := strings.IndexByte(body[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated substitution %q", body[i:])
+			}
+			sub := &rbnfSubstitution{kind: c}
+			desc := body[i+1 : i+1+end]
+			i += end + 2
+			if c != '=' && i < len(body) && body[i] == c {
+				sub.bypass = c == '>'
+				sub.zeros = c == '<'
+				i++
+			}
+			if strings.HasPrefix(desc, "%") {
+				sub.ruleSet = strings.TrimLeft(desc, "%")
+			} else {
+				sub.pattern = desc
+			}
+			flush()
+			parts = append(parts, rbnfPart{sub: sub})
+
+		default:
+			text.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+	return parts, nil
+}
+
+// parsePlural parses "cardinal,one{...}other{...}".
+func parsePlural(s string) (*rbnfPlural, error) {
+	kind, forms, ok := strings.Cut(s, ",")
+	if !ok {
+		return nil, fmt.Errorf("malformed plural %q", s)
+	}
+	pl := &rbnfPlural{ordinal: kind == "ordinal", forms: make(map[string]string)}
+	for forms != "" {
+		open := strings.IndexByte(forms, '{')
+		end := strings.IndexByte(forms, '}')
+		if open < 0 || end < open {
+			return nil, fmt.Errorf("malformed plural %q", s)
+		}
+		pl.forms[strings.TrimSpace(forms[:open])] = forms[open+1 : end]
+		forms = forms[end+1:]
+	}
+	return pl, nil
+}
+
+// rbnfFormatter formats numbers with a ruleBasedFormat for a locale.
+type rbnfFormatter struct {
+	rbf   *ruleBasedFormat
+	loc   Locale
+	p     *message.Printer
+	comma bool // the locale uses a decimal comma
+}
+
+// newRBNFFormatter returns a formatter of rbf for loc.
+func newRBNFFormatter(rbf *ruleBasedFormat, loc Locale, p *message.Printer) rbnfFormatter {
+	half, _ := decimal.New(15, 1)
+	comma := strings.Contains(formatNumber(p, half, FractionDigits(1, 1)), ",")
+	return rbnfFormatter{rbf: rbf, loc: loc, p: p, comma: comma}
+}
+
+// format formats d with the named rule set.
+func (f rbnfFormatter) format(d decimal.Decimal, set string) (string, error) {
+	rs, ok := f.rbf.sets[strings.TrimLeft(set, "%")]
+	if !ok {
+		return "", fmt.Errorf("rbnf: rule set %q not found", set)
+	}
+	return f.formatSet(d, rs)
+}
+
+// formatSet formats d with rs. Negative numbers use the "-x" rule and
+// non-integers the fraction rules; without them, the rule of the rounded
+// magnitude is applied and passes d on through its "==" substitutions.
+func (f rbnfFormatter) formatSet(d decimal.Decimal, rs *ruleSet) (string, error) {
+	if d.IsNeg() && rs.negative != nil {
+		return f.render(rs.negative, rs, d.Neg(), 1)
+	}
+
+	if !d.IsInt() {
+		if rule := rs.fractionRule(d, f.comma); rule != nil {
+			return f.renderFraction(rule, rs, d)
+		}
+	}
+
+	n, _, ok := roundDecimal(d.Abs(), 0, RoundHalfUp).Int64(0)
+	if !ok {
+		return "", errNoRule
+	}
+	rule, err := rs.find(n)
+	if err != nil {
+		return "", err
+	}
+	return f.render(rule, rs, d, rule.divisor)
+}
+
+// fractionRule returns the rule of rs formatting the non-integer d: "0.x"
+// below one, otherwise "x.x", or "x,x" for locales with a decimal comma.
+func (rs *ruleSet) fractionRule(d decimal.Decimal, comma bool) *rbnfRule {
+	if rs.proper != nil && d.Abs().Cmp(decimal.One) < 0 {
+		return rs.proper
+	}
+	if rs.comma != nil && (comma || rs.improper == nil) {
+		return rs.comma
+	}
+	return rs.improper
+}
+
+// find returns the rule of rs applying to n: the last rule whose base value
+// does not exceed n. A rule with a ">>" substitution whose base value is not
+// a multiple of its divisor rolls back to the previous rule when n is.
+func (rs *ruleSet) find(n int64) (*rbnfRule, error) {
+	i := sort.Search(len(rs.rules), func(i int) bool { return rs.rules[i].base > n }) - 1
+	if i < 0 {
+		return nil, errNoRule
+	}
+	rule := rs.rules[i]
+	if i > 0 && rule.hasModulus() && n%rule.divisor == 0 && rule.base%rule.divisor != 0 {
+		rule = rs.rules[i-1]
+	}
+	return rule, nil
+}
+
+// hasModulus reports whether r contains a ">>" substitution.
+func (r *rbnfRule) hasModulus() bool {
+	for _, part := range r.parts {
+		if part.sub != nil && part.sub.kind == '>' {
+			return true
+		}
+		for _, opt := range part.optional {
+			if opt.sub != nil && opt.sub.kind == '>' {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// render applies the rule r of rs to d. The "<<" and ">>" substitutions
+// receive the quotient and remainder of the rounded magnitude of d by
+// divisor; "==" receives d itself.
+func (f rbnfFormatter) render(r *rbnfRule, rs *ruleSet, d decimal.Decimal, divisor int64) (string, error) {
+	n, _, _ := roundDecimal(d.Abs(), 0, RoundHalfUp).Int64(0)
+	var b strings.Builder
+	if err := f.renderParts(&b, r.parts, r, rs, d, n, divisor); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func (f rbnfFormatter) renderParts(b *strings.Builder, parts []rbnfPart, r *rbnfRule, rs *ruleSet, d decimal.Decimal, n, divisor int64) error {
+	for _, part := range parts {
+		switch {
+		case part.sub != nil:
+			sub := part.sub
+			var v decimal.Decimal
+			switch {
+			case sub.kind == '<':
+				v, _ = decimal.New(n/divisor, 0)
+			case sub.kind == '>' && r != rs.negative:
+				v, _ = decimal.New(n%divisor, 0)
+			default:
+				v = d
+			}
+			if sub.bypass && r.index > 0 && r != rs.negative {
+				prev := rs.rules[r.index-1]
+				out, err := f.render(prev, rs, v, prev.divisor)
+				if err != nil {
+					return err
+				}
+				b.WriteString(out)
+				continue
+			}
+			out, err := f.substitute(sub, rs, v)
+			if err != nil {
+				return err
+			}
+			b.WriteString(out)
+
+		case part.plural != nil:
+			q, _ := decimal.New(n/divisor, 0)
+			b.WriteString(f.pluralForm(part.plural, q))
+
+		case part.optional != nil:
+			if n%divisor == 0 {
+				continue
+			}
+			if err := f.renderParts(b, part.optional, r, rs, d, n, divisor); err != nil {
+				return err
+			}
+
+		default:
+			b.WriteString(part.text)
+		}
+	}
+	return nil
+}
+
+// renderFraction applies an "x.x" or "0.x" rule to the non-integer d.
+func (f rbnfFormatter) renderFraction(r *rbnfRule, rs *ruleSet, d decimal.Decimal) (string, error) {
+	intPart := d.Trunc(0)
+	frac, err := d.Sub(intPart)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, part := range r.parts {
+		switch {
+		case part.sub != nil && part.sub.kind == '<':
+			out, err := f.substitute(part.sub, rs, intPart)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(out)
+
+		case part.sub != nil && part.sub.kind == '>':
+			out, err := f.fractionDigits(part.sub, rs, frac.Abs())
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(out)
+
+		case part.sub != nil:
+			out, err := f.substitute(part.sub, rs, d)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(out)
+
+		case part.plural != nil:
+			b.WriteString(f.pluralForm(part.plural, intPart))
+
+		default:
+			b.WriteString(part.text)
+		}
+	}
+	return b.String(), nil
+}
+
+// fractionDigits formats the fractional part frac of a number. A fraction
+// rule set renders it as a numerator over the smallest base value that
+// represents it exactly ("twenty-five hundredths"); otherwise the digits
+// are spelled one by one ("two five").
+func (f rbnfFormatter) fractionDigits(sub *rbnfSubstitution, rs *ruleSet, frac decimal.Decimal) (string, error) {
+	set := rs
+	if sub.ruleSet != "" {
+		s, ok := f.rbf.sets[sub.ruleSet]
+		if !ok {
+			return "", fmt.Errorf("rbnf: rule set %q not found", sub.ruleSet)
+		}
+		set = s
+	}
+
+	frac = frac.Trim(0)
+	if set.fraction && len(set.rules) > 0 {
+		rule := set.rules[len(set.rules)-1]
+		for _, candidate := range set.rules {
+			if candidate.base > 0 && frac.Scale() < decimalDigits(candidate.base) {
+				rule = candidate
+				break
+			}
+		}
+		return f.renderNumerator(rule, set, frac)
+	}
+
+	sep := " "
+	if sub.bypass {
+		sep = ""
+	}
+	digits := strings.TrimPrefix(frac.String(), "0.")
+	words := make([]string, 0, len(digits))
+	for _, c := range digits {
+		dig, _ := decimal.New(int64(c-'0'), 0)
+		out, err := f.formatSet(dig, set)
+		if err != nil {
+			return "", err
+		}
+		words = append(words, out)
+	}
+	return strings.Join(words, sep), nil
+}
+
+// renderNumerator applies the rule r of the fraction rule set rs to frac,
+// as the numerator of frac over the base value of r. A substitution closed
+// by "<<" spells the leading zeros of the numerator, e.g. "zero pięć" for
+// 0.05.
+func (f rbnfFormatter) renderNumerator(r *rbnfRule, rs *ruleSet, frac decimal.Decimal) (string, error) {
+	base, _ := decimal.New(r.base, 0)
+	num, err := frac.Mul(base)
+	if err != nil {
+		return "", err
+	}
+	num = num.Round(0)
+	n, _, _ := num.Int64(0)
+
+	var b strings.Builder
+	for _, part := range r.parts {
+		if part.sub != nil && part.sub.zeros {
+			for z := n * 10; z > 0 && z < r.base; z *= 10 {
+				out, err := f.substitute(part.sub, rs, decimal.Zero)
+				if err != nil {
+					return "", err
+				}
+				b.WriteString(out + " ")
+			}
+		}
+		if err := f.renderParts(&b, []rbnfPart{part}, r, rs, num, n, 1); err != nil {
+			return "", err
+		}
+	}
+	return b.String(), nil
+}
+
+// substitute formats v for a substitution, using its rule set, its decimal
+// pattern or the current rule set.
+func (f rbnfFormatter) substitute(sub *rbnfSubstitution, rs *ruleSet, v decimal.Decimal) (string, error) {
+	switch {
+	case sub.ruleSet != "":
+		return f.format(v, sub.ruleSet)
+	case sub.pattern != "":
+		maxFrac := 0
+		if _, frac, ok := strings.Cut(sub.pattern, "."); ok {
+			maxFrac = len(frac)
+		}
+		num := v.Abs().Round(0).String()
+		if strings.Contains(sub.pattern, ",") || maxFrac > 0 {
+			num = formatNumber(f.p, v.Abs(), FractionDigits(0, maxFrac))
+		}
+		if v.IsNeg() {
+			num = "-" + num
+		}
+		return num, nil
+	default:
+		return f.formatSet(v, rs)
+	}
+}
+
+// pluralForm selects the text of a plural choice for v.
+func (f rbnfFormatter) pluralForm(pl *rbnfPlural, v decimal.Decimal) string {
+	form := "other"
+	if pl.ordinal {
+		if ol, ok := f.loc.(OrdinalLocale); ok {
+			form = ol.OrdinalForm(v)
+		}
+	} else {
+		form = f.loc.PluralForm(v, v.String())
+	}
+	if text, ok := pl.forms[form]; ok {
+		return text
+	}
+	return pl.forms["other"]
+}
+
+// decimalDigits returns the number of decimal digits of the positive n.
+func decimalDigits(n int64) int {
+	return len(strconv.FormatInt(n, 10))
+}
+
+// ruleBasedFormat returns the parsed rule-based number format rules of loc.
+func (h *Humanizer) ruleBasedFormat(loc Locale) (*ruleBasedFormat, error) {
+	if rbf, ok := h.rbnf.Load(loc.Code()); ok {
+		return rbf.(*ruleBasedFormat), nil
+	}
+	rules := loc.Data().RBNF
+	if rules == "" {
+		return nil, fmt.Errorf("rbnf rules not found for locale %q", loc.Code())
+	}
+	rbf, err := parseRuleBasedFormat(rules)
+	if err != nil {
+		return nil, err
+	}
+	h.rbnf.Store(loc.Code(), rbf)
+	return rbf, nil
+}
+
+// UnknownRuleSetError is returned when the locale has no rule-based number
+// format rule set of the requested name.
+type UnknownRuleSetError struct {
+	RuleSet string
+	Locale  language.Tag
+}
+
+// Error implements the error interface.
+func (e UnknownRuleSetError) Error() string {
+	return fmt.Sprintf("rule set %q not found for locale %q", e.RuleSet, e.Locale)
+}
+
+// SpellOut spells out value in words with the locale's CLDR rule set, e.g.
+// "one thousand two hundred" or "тысяча двести". The rule set defaults to
+// "spellout-numbering"; gendered variants such as
+// "spellout-cardinal-feminine" are selected by name, with or without the
+// leading "%". Numbers beyond the rules are rendered in digits.
+func (h *Humanizer) SpellOut(value string, locale language.Tag, ruleSet string) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	rbf, err := h.ruleBasedFormat(loc)
+	if err != nil {
+		return "", err
+	}
+
+	if ruleSet == "" {
+		ruleSet = "spellout-numbering"
+	}
+	if _, ok := rbf.sets[strings.TrimLeft(ruleSet, "%")]; !ok {
+		return "", UnknownRuleSetError{RuleSet: ruleSet, Locale: locale}
+	}
+	f := newRBNFFormatter(rbf, loc, message.NewPrinter(locale))
+	out, err := f.format(valDec.Trim(0), ruleSet)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+	return out, nil
+}
+
+// spellSmall spells out the displayed number d with the rule set ruleSet
+// when the Long style is configured and d is an integer within
+// opts.SpellSmallNumbers. An empty rule set disables spelling out.
+func (h *Humanizer) spellSmall(loc Locale, p *message.Printer, d decimal.Decimal, ruleSet string, opts Options) (string, bool) {
+	if h.opt != Long || opts.SpellSmallNumbers <= 0 || !d.IsInt() || ruleSet == "" {
+		return "", false
+	}
+	limit, _ := decimal.New(int64(opts.SpellSmallNumbers), 0)
+	if d.Abs().Cmp(limit) > 0 {
+		return "", false
+	}
+	rbf, err := h.ruleBasedFormat(loc)
+	if err != nil {
+		return "", false
+	}
+	f := newRBNFFormatter(rbf, loc, p)
+	out, err := f.format(d.Abs().Trunc(0), ruleSet)
+	if err != nil {
+		return "", false
+	}
+	return out, true
+}
+
+// genderRuleSets lists the suffixes of the cardinal rule sets agreeing with
+// each CLDR grammatical gender, e.g. "spellout-cardinal-reale" for the
+// Swedish common gender.
+var genderRuleSets = map[string][]string{
+	"masculine": {"masculine"},
+	"inanimate": {"masculine"},
+	"feminine":  {"feminine"},
+	"neuter":    {"neuter"},
+	"common":    {"common", "reale"},
+}
+
+// countingRuleSet returns the rule set spelling out a number that counts a
+// noun of the given CLDR grammatical gender, e.g.
+// "spellout-cardinal-feminine" for Russian минута, or opts.SpellOutRuleSet
+// when set. Locales whose cardinals are not gendered use
+// "spellout-numbering". It returns an empty rule set when the cardinals are
+// gendered and the gender is unknown, since the number might not agree with
+// the noun, e.g. "один минута".
+func (h *Humanizer) countingRuleSet(loc Locale, gender string, opts Options) string {
+	if opts.SpellOutRuleSet != "" {
+		return opts.SpellOutRuleSet
+	}
+	if h.opt != Long || opts.SpellSmallNumbers <= 0 {
+		return ""
+	}
+	rbf, err := h.ruleBasedFormat(loc)
+	if err != nil {
+		return ""
+	}
+	for _, suffix := range genderRuleSets[gender] {
+		if _, ok := rbf.sets["spellout-cardinal-"+suffix]; ok {
+			return "spellout-cardinal-" + suffix
+		}
+	}
+	for _, suffixes := range genderRuleSets {
+		for _, suffix := range suffixes {
+			if _, ok := rbf.sets["spellout-cardinal-"+suffix]; ok {
+				return ""
+			}
+		}
+	}
+	return "spellout-numbering"
+}
@@ -0,0 +1,168 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// RelativeTimePatterns contains the CLDR relative time patterns of a unit
+// in one style. Future and Past are keyed by plural form, e.g.
+// "one": "in {0} day". Relative holds the special words keyed by offset,
+// e.g. "-1": "yesterday".
+type RelativeTimePatterns struct {
+	Future   map[string]string
+	Past     map[string]string
+	Relative map[string]string
+}
+
+// RelativeTimeUnit holds the relative time patterns of a unit for each
+// style.
+type RelativeTimeUnit struct {
+	Long   RelativeTimePatterns
+	Short  RelativeTimePatterns
+	Narrow RelativeTimePatterns
+}
+
+// patterns returns the patterns of the given style. Missing narrow
+// patterns fall back to short ones and missing short patterns to long ones.
+func (u RelativeTimeUnit) patterns(style Option) RelativeTimePatterns {
+	if style == Narrow && len(u.Narrow.Future) > 0 {
+		return u.Narrow
+	}
+	if style != Long && len(u.Short.Future) > 0 {
+		return u.Short
+	}
+	if len(u.Long.Future) > 0 {
+		return u.Long
+	}
+	return u.Short
+}
+
+// NumericDisplay selects whether FormatRelative may replace numbers with
+// special words such as "yesterday".
+type NumericDisplay int
+
+const (
+	// NumericAlways always shows the number, e.g. "1 day ago".
+	NumericAlways NumericDisplay = iota
+
+	// NumericAuto uses the locale's words where available, e.g.
+	// "yesterday", "tomorrow" or "this year".
+	NumericAuto
+)
+
+// relativeUnits lists the units used by FormatRelativeDuration from the
+// largest to the smallest.
+var relativeUnits = []struct {
+	unit string
+	size time.Duration
+}{
+	{"year", meanYear},
+	{"month", meanYear / 12},
+	{"week", 7 * 24 * time.Hour},
+	{"day", 24 * time.Hour},
+	{"hour", time.Hour},
+	{"minute", time.Minute},
+	{"second", time.Second},
+}
+
+// FormatRelative formats value as a relative time in the given CLDR unit
+// ("year", "quarter", "month", "week", "day", "hour", "minute" or
+// "second"), e.g. "in 2 weeks" or "5 минут назад". Negative values are in
+// the past. The number is compacted with the decimal patterns of the
+// configured Option ("in 1.2K years") and the plural form is chosen on the
+// displayed number. With opts.Numeric set to NumericAuto, offsets that have
+// a special word, such as -1 day, are rendered as that word ("yesterday").
+func (h *Humanizer) FormatRelative(value string, unit string, locale language.Tag, opts Options) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+	return h.formatRelative(loc, valDec, valDec.IsNeg(), unit, locale, opts)
+}
+
+// formatRelative formats valDec in unit with the past patterns when past is
+// set and the future patterns otherwise, so that amounts rounded to zero
+// keep their direction.
+func (h *Humanizer) formatRelative(loc Locale, valDec decimal.Decimal, past bool, unit string, locale language.Tag, opts Options) (string, error) {
+	rt, ok := loc.Data().RelativeTime[unit]
+	if !ok {
+		return "", UnknownUnitError{Unit: unit, Locale: locale}
+	}
+	patterns := rt.patterns(h.opt)
+
+	if opts.Numeric == NumericAuto && valDec.IsInt() {
+		if word, ok := patterns.Relative[valDec.Trunc(0).String()]; ok {
+			return word, nil
+		}
+	}
+
+	direction := patterns.Future
+	if past {
+		direction = patterns.Past
+	}
+
+	// The direction is carried by the pattern, so the number is unsigned.
+	opts.SignDisplay = SignNever
+	num, pluralForm := h.displayNumber(loc, message.NewPrinter(locale), valDec, "", opts)
+	return unitPattern(direction, pluralForm, num), nil
+}
+
+// FormatRelativeDuration formats d as a relative time in the largest unit
+// it reaches, from seconds to years, e.g. "3 hours ago" for -3h. When opts
+// has no precision the amount is rounded to an integer. The unit is chosen
+// after rounding, so 59m40s is "in 1 hour", and the direction comes from d,
+// so -400ms is "0 seconds ago".
+func (h *Humanizer) FormatRelativeDuration(d time.Duration, locale language.Tag, opts Options) (string, error) {
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	abs := d
+	if abs < 0 {
+		abs = -abs
+	}
+	i := len(relativeUnits) - 1
+	for j, ru := range relativeUnits {
+		if abs >= ru.size {
+			i = j
+			break
+		}
+	}
+
+	if opts.Precision.IsExact() {
+		opts.Precision = FractionDigits(0, 0)
+	}
+	nanos, _ := decimal.New(int64(d), 0)
+
+	// Move to the next larger unit when rounding reaches it, as
+	// FormatDuration does.
+	var amount decimal.Decimal
+	for {
+		size, _ := decimal.New(int64(relativeUnits[i].size), 0)
+		if amount, err = nanos.Quo(size); err != nil {
+			return "", fmt.Errorf("relative duration %v: %w", d, err)
+		}
+		amount = opts.round(amount)
+		if i == 0 {
+			break
+		}
+		rounded, err := amount.Abs().Mul(size)
+		larger, _ := decimal.New(int64(relativeUnits[i-1].size), 0)
+		if err != nil || rounded.Cmp(larger) < 0 {
+			break
+		}
+		i--
+	}
+	return h.formatRelative(loc, amount, d < 0, relativeUnits[i].unit, locale, opts)
+}
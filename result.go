@@ -0,0 +1,63 @@
+package humanizecompact
+
+import (
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// Result is a formatted number together with the accuracy of its display.
+type Result struct {
+	// Text is the formatted number.
+	Text string
+
+	// Fallback reports whether Text was produced by the fallback function.
+	Fallback bool
+
+	// Approximate reports whether the displayed number differs from the
+	// value, e.g. "1.2M" for 1,234,567.
+	Approximate bool
+
+	// RelativeError is the distance between the displayed number and the
+	// value relative to the value, e.g. 0.028 for "1.2M" and 1,234,567.
+	// It is zero for exact displays and for a zero value.
+	RelativeError float64
+
+	// Capped reports whether the value exceeded Options.Cap, in which case
+	// Text shows the cap as a lower bound, e.g. "99K+".
+	Capped bool
+}
+
+// newResult describes the display text of the magnitude value, shown as
+// displayed. With opts.Approximately, rounded displays are prefixed with the
+// approximately pattern of loc.
+func newResult(loc Locale, text string, value, displayed decimal.Decimal, opts Options) Result {
+	r := Result{Text: text}
+	if displayed.Cmp(value) == 0 {
+		return r
+	}
+
+	r.Approximate = true
+	if !value.IsZero() {
+		diff, err := displayed.Sub(value)
+		if err == nil {
+			if rel, err := diff.Abs().Quo(value); err == nil {
+				r.RelativeError, _ = rel.Float64()
+			}
+		}
+	}
+	if opts.Approximately {
+		r.Text = approximately(loc, text)
+	}
+	return r
+}
+
+// approximately applies the approximately pattern of loc to s, e.g. "~1.2K".
+func approximately(loc Locale, s string) string {
+	return strings.Replace(orDefault(loc.Data().MiscPatterns.Approximately, "~{0}"), "{0}", s, 1)
+}
+
+// atLeast applies the at-least pattern of loc to s, e.g. "99K+".
+func atLeast(loc Locale, s string) string {
+	return strings.Replace(orDefault(loc.Data().MiscPatterns.AtLeast, "{0}+"), "{0}", s, 1)
+}
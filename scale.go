@@ -0,0 +1,112 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+)
+
+// fixedCompact renders the non-negative value v with the pattern of df keyed
+// 10^opts.FixedScale, whatever the magnitude of v, e.g. "0.95M" for 950,000
+// with a scale of 6. Without a precision in opts it is rounded to two
+// fraction digits. The boolean is false when df has no pattern for that
+// scale or the pattern does not compact.
+func fixedCompact(loc Locale, df map[string]string, v decimal.Decimal, opts Options) (compactNumber, bool) {
+	key, ok := pow10(opts.FixedScale)
+	if !ok {
+		return compactNumber{}, false
+	}
+	other := df[fmt.Sprintf("%d-count-other", key)]
+	if extractName(other) == "" {
+		return compactNumber{}, false
+	}
+
+	divisor := key
+	for i := 1; i < strings.Count(other, "0"); i++ {
+		divisor /= 10
+	}
+	divDec, _ := decimal.New(divisor, 0)
+	ratio, err := v.Quo(divDec)
+	if err != nil {
+		return compactNumber{}, false
+	}
+
+	if opts.Precision.IsExact() {
+		opts.Precision = FractionDigits(0, 2)
+	}
+	c := compactNumber{ratio: opts.round(ratio).Trim(0), scale: divisor, ungrouped: true}
+	c.tmpl = pluralPattern(df, key, loc.PluralForm(c.ratio, c.value().String()))
+	return c, c.tmpl != ""
+}
+
+// CommonScaleName returns the scale shared by values for a column or a
+// chart axis, as an exponent for Options.FixedScale, together with the
+// name of that scale in the locale's patterns, e.g. 6 and "M" with the
+// Short option, "млн" in Russian or "million" with the Long option. The
+// name is the one used with the number 1, such as Russian "миллион" rather
+// than "миллионов". CLDR has no caption phrase such as "in millions", so
+// the name is not a caption: the caller places it in its own one. The
+// scale is the largest one of the locale reached by the greatest magnitude
+// among values. When no value reaches a scale, CommonScaleName returns 0
+// and an empty name.
+func (h *Humanizer) CommonScaleName(values []string, locale language.Tag) (int, string, error) {
+	loc, err := h.locale(locale)
+	if err != nil {
+		return 0, "", err
+	}
+
+	var greatest decimal.Decimal
+	for _, value := range values {
+		d, err := decimal.Parse(value)
+		if err != nil {
+			return 0, "", InvalidNumberError{Value: value, Err: err}
+		}
+		if d.Abs().Cmp(greatest) > 0 {
+			greatest = d.Abs()
+		}
+	}
+
+	scale, name := commonScale(loc, h.decimalFormat(loc), greatest)
+	return scale, name, nil
+}
+
+// commonScale returns the exponent and name of the largest scale of df
+// reached by the non-negative value greatest.
+func commonScale(loc Locale, df map[string]string, greatest decimal.Decimal) (int, string) {
+	one, _ := decimal.New(1, 0)
+	scale, name := 0, ""
+	for exp := 1; exp <= 18; exp++ {
+		key, _ := pow10(exp)
+		keyDec, _ := decimal.New(key, 0)
+		if greatest.Cmp(keyDec) < 0 {
+			break
+		}
+		// Only patterns with a single digit start a scale, e.g. "0M"
+		// rather than "00M".
+		other := df[fmt.Sprintf("%d-count-other", key)]
+		if extractName(other) == "" || strings.Count(other, "0") != 1 {
+			continue
+		}
+		tmpl := pluralPattern(df, key, loc.PluralForm(one, one.String()))
+		scale, name = exp, strings.TrimFunc(extractName(tmpl), func(r rune) bool {
+			return unicode.IsSpace(r) || unicode.Is(unicode.Bidi_Control, r)
+		})
+	}
+	return scale, name
+}
+
+// pow10 returns 10^exp. It reports false when the power does not fit in an
+// int64.
+func pow10(exp int) (int64, bool) {
+	if exp < 0 || exp > 18 {
+		return 0, false
+	}
+	p := int64(1)
+	for i := 0; i < exp; i++ {
+		p *= 10
+	}
+	return p, true
+}
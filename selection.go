@@ -0,0 +1,88 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+)
+
+// PatternSelection selects how the compact pattern of a number is chosen.
+type PatternSelection int
+
+const (
+	// SelectSmallestRatio tries every scale of the locale and keeps the one
+	// with the smallest ratio, e.g. "1.2K" for 1200. With an exact
+	// precision only ratios that need no rounding are accepted.
+	SelectSmallestRatio PatternSelection = iota
+
+	// SelectMagnitude selects the CLDR pattern by the magnitude of the
+	// number and divides by the power of ten implied by the zeros of the
+	// pattern, as ICU does. For example, Spanish 12,345,678,900 uses the
+	// "00 mil M" pattern of 10^10 and is shown as "12 mil M". Without a
+	// precision numbers are rounded with CompactDigits, and ratios below
+	// 10,000 are not grouped.
+	SelectMagnitude
+)
+
+// magnitudeCompact compacts the non-negative value v with the pattern of
+// df keyed by the magnitude of v. The boolean is false when the pattern of
+// that magnitude is "0", i.e. the number is not compacted.
+func magnitudeCompact(loc Locale, df map[string]string, v decimal.Decimal, opts Options) (compactNumber, bool) {
+	if opts.Precision.IsExact() {
+		opts.Precision = CompactDigits()
+	}
+
+	mag := exponent(v) - 1
+	c, ok := magnitudePattern(loc, df, v, mag, opts)
+	displayed := opts.round(v)
+	if ok {
+		displayed = c.value()
+	}
+
+	// Rounding may carry into the next magnitude, e.g. 999.96 rounds to
+	// 1000 and 99,996 to 100K, which select the pattern of that magnitude.
+	if exponent(displayed)-1 > mag {
+		if next, ok := magnitudePattern(loc, df, v, mag+1, opts); ok {
+			return next, true
+		}
+	}
+	return c, ok
+}
+
+// magnitudePattern renders v with the pattern of the magnitude mag, using
+// the largest magnitude of df that does not exceed mag.
+func magnitudePattern(loc Locale, df map[string]string, v decimal.Decimal, mag int, opts Options) (compactNumber, bool) {
+	key, pow := int64(0), int64(1)
+	for m := 0; m <= mag && m <= 18; m++ {
+		if df[fmt.Sprintf("%d-count-other", pow)] != "" {
+			key = pow
+		}
+		if m < 18 {
+			pow *= 10
+		}
+	}
+	if key == 0 {
+		return compactNumber{}, false
+	}
+
+	other := df[fmt.Sprintf("%d-count-other", key)]
+	zeros := strings.Count(other, "0")
+	if extractName(other) == "" || zeros == 0 {
+		return compactNumber{}, false
+	}
+
+	divisor := key
+	for i := 1; i < zeros; i++ {
+		divisor /= 10
+	}
+	divDec, _ := decimal.New(divisor, 0)
+	ratio, err := v.Quo(divDec)
+	if err != nil {
+		return compactNumber{}, false
+	}
+
+	c := compactNumber{ratio: opts.round(ratio), scale: divisor, ungrouped: true}
+	c.tmpl = pluralPattern(df, key, loc.PluralForm(c.ratio, c.value().String()))
+	return c, c.tmpl != ""
+}
@@ -0,0 +1,94 @@
+// Generates compact.tsv, the ICU golden vectors of the compact notation
+// tests in icu_test.go, with the Intl.NumberFormat of Node.js:
+//
+//	node testdata/icu/generate.js > testdata/icu/compact.tsv
+//
+// Each line holds a suite, a locale, a style, a sign display, a number and
+// the quoted ICU output. Numbers that ICU leaves uncompacted are omitted.
+const locales = [
+  'ar', 'bg', 'cs', 'da', 'de', 'en', 'es', 'fa', 'fr', 'he', 'hu', 'id', 'it',
+  'ja', 'ko', 'pl', 'pt', 'ro', 'ru', 'sv', 'th', 'tr', 'uk', 'vi', 'zh',
+];
+const styles = ['short', 'long'];
+
+// numberingSystems overrides the default numbering systems of ICU with the
+// ones of golang.org/x/text, which writes Arabic with Arabic-Indic digits.
+const numberingSystems = { ar: 'arab' };
+
+// boundaries returns 0.99996, 0.99994 and 1 times each power of ten from
+// 10^from to 10^to.
+function boundaries(from, to) {
+  const numbers = [];
+  for (let exp = from; exp <= to; exp++) {
+    for (const mantissa of ['99996', '99994', '100000']) {
+      numbers.push((BigInt(mantissa) * 10n ** BigInt(exp)).toString().replace(/(\d{5})$/, '.$1').replace(/\.?0+$/, ''));
+    }
+  }
+  return numbers;
+}
+
+const suites = [
+  // sign checks the sign displays with one fraction digit.
+  {
+    name: 'sign',
+    styles: ['short'],
+    signs: ['auto', 'always', 'exceptZero', 'never'],
+    numbers: ['1234567', '-1234567'],
+    options: { maximumFractionDigits: 1 },
+  },
+  // boundary checks the numbers around each power of ten that round up to
+  // it or stay below it with one fraction digit.
+  {
+    name: 'boundary',
+    styles,
+    signs: ['auto'],
+    numbers: boundaries(3, 16),
+    options: { maximumFractionDigits: 1 },
+  },
+  // magnitude checks the default rounding of ICU compact notation.
+  {
+    name: 'magnitude',
+    styles,
+    signs: ['auto'],
+    numbers: [
+      '999.6', '1000', '1234', '9999', '9999.6', '10000', '12345', '99996',
+      '123456', '999999', '1000000', '1234567', '12345678', '123456789',
+      '1234567890', '12345678900', '123456789000', '1234567890000',
+      '12345678900000', '123456789000000', '1234567890000000',
+      '12345678900000000',
+    ],
+    options: {},
+  },
+];
+
+// uncompacted matches outputs made of digits, separators and signs only.
+const uncompacted = /^[\p{Nd}\p{P}\p{Zs}\p{Cf}+\-−]+$/u;
+
+const quote = (s) => '"' + s.replace(/[\\"]/g, '\\$&').replace(
+  /[^\x20-\x7e]/g,
+  (c) => /[\p{Zs}\p{Cf}]/u.test(c) ? '\\u' + c.charCodeAt(0).toString(16).toUpperCase().padStart(4, '0') : c,
+) + '"';
+
+console.log(`# ${process.version}, ICU ${process.versions.icu}, CLDR ${process.versions.cldr}`);
+for (const suite of suites) {
+  for (const locale of locales) {
+    for (const style of suite.styles) {
+      for (const signDisplay of suite.signs) {
+        const nf = new Intl.NumberFormat(locale, {
+          ...suite.options,
+          notation: 'compact',
+          compactDisplay: style,
+          signDisplay,
+          numberingSystem: numberingSystems[locale],
+        });
+        for (const number of suite.numbers) {
+          const out = nf.format(number);
+          if (uncompacted.test(out)) {
+            continue;
+          }
+          console.log([suite.name, locale, style, signDisplay, number, quote(out)].join('\t'));
+        }
+      }
+    }
+  }
+}
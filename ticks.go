@@ -0,0 +1,170 @@
+package humanizecompact
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// maxTicks bounds the number of ticks FormatTicks may return.
+const maxTicks = 1000
+
+// Tick is a labelled value of a chart axis.
+type Tick struct {
+	Value decimal.Decimal
+	Label string
+}
+
+// FormatTicks returns about count evenly spaced "nice" values covering the
+// range from min to max, with steps of 1, 2, 2.5 or 5 times a power of ten,
+// and labels them compactly, e.g. "0.0", "2.5M", "5.0M" and "7.5M" for 0 to
+// 7,500,000 with 4 ticks. All labels share the scale reached by the largest
+// tick and show the same fraction digits, the ones needed by the step, so
+// they read consistently along the axis. A count below 2 is an error.
+func (h *Humanizer) FormatTicks(min, max string, count int, locale language.Tag) ([]Tick, error) {
+	if count < 2 {
+		return nil, fmt.Errorf("tick count %d is less than 2", count)
+	}
+	lo, err := decimal.Parse(min)
+	if err != nil {
+		return nil, InvalidNumberError{Value: min, Err: err}
+	}
+	hi, err := decimal.Parse(max)
+	if err != nil {
+		return nil, InvalidNumberError{Value: max, Err: err}
+	}
+	if lo.Cmp(hi) > 0 {
+		lo, hi = hi, lo
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return nil, err
+	}
+
+	values, step, err := niceTicks(lo, hi, count)
+	if err != nil {
+		return nil, err
+	}
+
+	greatest := values[0].Abs()
+	if last := values[len(values)-1].Abs(); last.Cmp(greatest) > 0 {
+		greatest = last
+	}
+	scale, _ := commonScale(loc, h.decimalFormat(loc), greatest)
+
+	// The step in the shared scale determines the fraction digits of
+	// every label, e.g. 1 for steps of 2.5M, including "5.0M".
+	divisor, _ := pow10(scale)
+	divDec, _ := decimal.New(divisor, 0)
+	scaledStep, err := step.Quo(divDec)
+	if err != nil {
+		return nil, err
+	}
+	opts := Options{
+		Precision:  FractionDigits(scaledStep.Trim(0).Scale(), scaledStep.Trim(0).Scale()),
+		FixedScale: scale,
+	}
+
+	p := message.NewPrinter(locale)
+	ticks := make([]Tick, 0, len(values))
+	for _, v := range values {
+		var label string
+		if c, ok := compactDecimal(loc, h.decimalFormat(loc), v.Abs(), opts); ok && !v.IsZero() {
+			label = c.format(p, opts.Precision)
+		} else {
+			label = groupedNumber(loc, p, opts.round(v.Abs()), opts.Precision)
+		}
+		label = h.applyNumberingSystem(label, loc, p, locale)
+		ticks = append(ticks, Tick{Value: v.Trim(0), Label: applySign(label, v.Sign(), loc, SignAuto)})
+	}
+	return ticks, nil
+}
+
+// niceTicks returns the values of about count ticks covering lo to hi and
+// the step between them.
+func niceTicks(lo, hi decimal.Decimal, count int) ([]decimal.Decimal, decimal.Decimal, error) {
+	span, err := hi.Sub(lo)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+	if span.IsZero() {
+		return []decimal.Decimal{lo}, decimal.Decimal{}, nil
+	}
+
+	intervals, _ := decimal.New(int64(count-1), 0)
+	raw, err := niceNumber(span, false).Quo(intervals)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+	step := niceNumber(raw, true)
+
+	q, err := lo.Quo(step)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+	first, err := q.Floor(0).Mul(step)
+	if err != nil {
+		return nil, decimal.Decimal{}, err
+	}
+
+	var values []decimal.Decimal
+	for v := first; ; {
+		values = append(values, v)
+		if v.Cmp(hi) >= 0 {
+			break
+		}
+		if len(values) == maxTicks {
+			return nil, decimal.Decimal{}, errors.New("too many ticks")
+		}
+		if v, err = v.Add(step); err != nil {
+			return nil, decimal.Decimal{}, err
+		}
+	}
+	return values, step, nil
+}
+
+// niceNumber returns a number of the form 1, 2, 2.5, 5 or 10 times a power
+// of ten close to the positive number x. With round it is the nearest such
+// number; otherwise the smallest that is not less than x.
+func niceNumber(x decimal.Decimal, round bool) decimal.Decimal {
+	exp := exponent(x) - 1
+	unit := powerOfTen(exp)
+	f, err := x.Quo(unit)
+	if err != nil {
+		return x
+	}
+
+	var nice string
+	switch fv, _ := f.Float64(); {
+	case round && fv < 1.5, !round && fv <= 1:
+		nice = "1"
+	case round && fv < 2.25, !round && fv <= 2:
+		nice = "2"
+	case round && fv < 3.75, !round && fv <= 2.5:
+		nice = "2.5"
+	case round && fv < 7.5, !round && fv <= 5:
+		nice = "5"
+	default:
+		nice = "10"
+	}
+	n, err := decimal.MustParse(nice).Mul(unit)
+	if err != nil {
+		return x
+	}
+	return n.Trim(0)
+}
+
+// powerOfTen returns 10^exp for exponents between -19 and 18.
+func powerOfTen(exp int) decimal.Decimal {
+	if exp < 0 {
+		d, _ := decimal.New(1, min(-exp, decimal.MaxScale))
+		return d
+	}
+	p, _ := pow10(exp)
+	d, _ := decimal.New(p, 0)
+	return d
+}
@@ -0,0 +1,182 @@
+package humanizecompact
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/govalues/decimal"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+// UnitPatterns contains the CLDR patterns of a measurement unit keyed by
+// plural form, e.g. "one": "{0} kilometer". The "{0}" placeholder marks
+// the position of the number. The "per" key holds the pattern used when the
+// unit is the denominator of a compound unit, e.g. "{0}/s"; the special
+// unit "per" holds the generic "compound" pattern, e.g. "{0} per {1}".
+type UnitPatterns struct {
+	Long   map[string]string
+	Short  map[string]string
+	Narrow map[string]string
+
+	// Gender is the CLDR grammatical gender of the unit, e.g. "feminine"
+	// for Russian минута, with which spelled-out numbers agree. It is empty
+	// for locales without grammatical gender.
+	Gender string
+}
+
+// UnknownUnitError is returned when the locale has no patterns for the
+// requested unit.
+type UnknownUnitError struct {
+	Unit   string
+	Locale language.Tag
+}
+
+// Error implements the error interface.
+func (e UnknownUnitError) Error() string {
+	return fmt.Sprintf("unit %q not found for locale %q", e.Unit, e.Locale)
+}
+
+// patterns returns the patterns of the given style. Missing narrow patterns
+// fall back to short ones and missing short patterns to long ones.
+func (u UnitPatterns) patterns(style Option) map[string]string {
+	if style == Narrow && len(u.Narrow) > 0 {
+		return u.Narrow
+	}
+	if style != Long && len(u.Short) > 0 {
+		return u.Short
+	}
+	if len(u.Long) > 0 {
+		return u.Long
+	}
+	return u.Short
+}
+
+// FormatUnit formats value in the given CLDR unit, e.g. "1.2K kilometers"
+// or "3,4 млн км". The number is compacted with the decimal patterns of the
+// configured Option and the plural form of the unit pattern is chosen on the
+// displayed compact number.
+//
+// Compound units such as "kilometer-per-hour" use the locale's precomposed
+// patterns when available and are otherwise built from the numerator and the
+// per pattern of the denominator. A unit without numerator, e.g.
+// "per-second", applies the per pattern to the bare number ("1.2K/s").
+func (h *Humanizer) FormatUnit(value string, unit string, locale language.Tag, opts Options) (string, error) {
+	valDec, err := decimal.Parse(value)
+	if err != nil {
+		return "", InvalidNumberError{Value: value, Err: err}
+	}
+
+	loc, err := h.locale(locale)
+	if err != nil {
+		return "", err
+	}
+
+	return h.formatUnit(loc, message.NewPrinter(locale), valDec, unit, locale, opts)
+}
+
+// formatUnit formats valDec in the given simple or compound unit.
+func (h *Humanizer) formatUnit(loc Locale, p *message.Printer, valDec decimal.Decimal, unit string, locale language.Tag, opts Options) (string, error) {
+	units := loc.Data().Units
+	gender := units[unit].Gender
+	if numerator, _, ok := strings.Cut(unit, "-per-"); ok && gender == "" {
+		gender = units[numerator].Gender
+	}
+	num, pluralForm := h.displayNumber(loc, p, valDec, gender, opts)
+
+	if up, ok := units[unit]; ok {
+		return unitPattern(up.patterns(h.opt), pluralForm, num), nil
+	}
+	if out, ok := compoundUnit(units, unit, h.opt, pluralForm, num); ok {
+		return out, nil
+	}
+	return "", UnknownUnitError{Unit: unit, Locale: locale}
+}
+
+// compoundUnit formats num in a "numerator-per-denominator" unit. The
+// numerator may be empty, as in "per-second".
+func compoundUnit(units map[string]UnitPatterns, unit string, style Option, pluralForm, num string) (string, bool) {
+	numerator, denominator, found := strings.Cut(unit, "-per-")
+	if !found {
+		denominator, found = strings.CutPrefix(unit, "per-")
+		if !found {
+			return "", false
+		}
+		numerator = ""
+	}
+
+	text := num
+	if numerator != "" {
+		up, ok := units[numerator]
+		if !ok {
+			return "", false
+		}
+		text = unitPattern(up.patterns(style), pluralForm, num)
+	}
+	return perUnit(units, denominator, style, text)
+}
+
+// perUnit expresses text per the denominator unit with its per pattern,
+// e.g. "{0}/s", or with the compound pattern of the locale.
+func perUnit(units map[string]UnitPatterns, denominator string, style Option, text string) (string, bool) {
+	den, ok := units[denominator]
+	if !ok {
+		return "", false
+	}
+
+	if per := den.patterns(style)["per"]; per != "" {
+		return strings.Replace(per, "{0}", text, 1), true
+	}
+
+	compound := units["per"].patterns(style)["compound"]
+	if compound == "" {
+		compound = "{0}/{1}"
+	}
+	out := strings.Replace(compound, "{0}", text, 1)
+	return strings.Replace(out, "{1}", unitName(den.patterns(style)), 1), true
+}
+
+// unitName returns the name of a unit, i.e. its singular pattern
+// without the number.
+func unitName(patterns map[string]string) string {
+	tmpl, ok := patterns["one"]
+	if !ok {
+		tmpl = patterns["other"]
+	}
+	return strings.TrimSpace(strings.Replace(tmpl, "{0}", "", 1))
+}
+
+// displayNumber renders valDec in words when opts spells it out, compactly
+// when possible, or in full otherwise, and returns the text together with the plural form of the
+// displayed number. Words agree with a noun of the CLDR grammatical gender,
+// which is empty when unknown.
+func (h *Humanizer) displayNumber(loc Locale, p *message.Printer, valDec decimal.Decimal, gender string, opts Options) (string, string) {
+	valDec, opts, capped := opts.capped(valDec)
+	var num string
+	displayed := opts.round(valDec.Abs())
+	if words, ok := h.spellSmall(loc, p, displayed, h.countingRuleSet(loc, gender, opts), opts); ok {
+		num = words
+	} else if c, ok := compactDecimal(loc, h.decimalFormat(loc), valDec.Abs(), opts); ok {
+		num = c.format(p, opts.Precision)
+		displayed = c.value()
+	} else {
+		num = groupedNumber(loc, p, displayed, opts.Precision)
+	}
+
+	num = applySign(num, valDec.Sign()*displayed.Sign(), loc, opts.SignDisplay)
+	num = newResult(loc, num, valDec.Abs(), displayed, opts).Text
+	if capped {
+		num = atLeast(loc, num)
+	}
+	return num, loc.PluralForm(displayed, displayed.String())
+}
+
+// unitPattern substitutes num into the pattern of the given plural form,
+// falling back to the "other" form.
+func unitPattern(patterns map[string]string, pluralForm, num string) string {
+	tmpl, ok := patterns[pluralForm]
+	if !ok {
+		tmpl = patterns["other"]
+	}
+	return strings.Replace(tmpl, "{0}", num, 1)
+}